@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Any) Get() (any, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Any) MustGet() any {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Any) UnwrapOrElse(defaultValue func() any) any {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Any) All() iter.Seq[any] {
+	return func(yield func(any) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Anys are None, or both contain equal values.
+func (o Any) Equal(other Any) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalAny(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneAny() is less than any value.
+func (o Any) Compare(other Any) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareAny(o.value, other.value)
+	})
+}
+
+// Filter returns the Any itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneAny().
+func (o Any) Filter(predicate func(any) bool) Any {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneAny()
+}
+
+// Or returns the Any itself if it contains a value.
+// Otherwise, returns other.
+func (o Any) Or(other Any) Any {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Any itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Any) OrElse(fn func() Any) Any {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Any value using MessagePack format.
 // - If the value is present, it is encoded as any.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,6 +190,25 @@ func (o Any) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Any", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Any value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as any.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Any) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendAny(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Any", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Any value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneAny)
@@ -135,25 +220,192 @@ func (o Any) EncodeMsgpack(encoder *msgpack.Encoder) error {
 //   - on nil: exists = false, value = default zero value
 //   - on any: exists = true, value = decoded value
 func (o *Any) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Any", err)
+		return newDecodeMsgpackError("Any", "any", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Any", decoder.Skip())
+		return newDecodeMsgpackError("Any", "any", offset, decoder.Skip())
 	case checkAny(code):
-		o.value, err = decodeAny(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val any
+
+		val, err = decodeAny(decoder)
 		if err != nil {
-			return newDecodeError("Any", err)
+			return newDecodeMsgpackError("Any", "any", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Any", code)
+		return newDecodeWithCodeError("Any", "any", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as any.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Any) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueAny(o.value)
+	if err != nil {
+		return nil, newEncodeError("Any", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneAny)
+//   - any: interpreted as a present value (SomeAny)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into any.
+func (o *Any) Scan(src any) error {
+	if src == nil {
+		o.value = zero[any]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanAny(src)
+	if err != nil {
+		return newDecodeError("Any", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Any value using JSON format.
+// - If the value is present, it is encoded as any.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Any) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Any", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Any value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneAny)
+//   - any: interpreted as a present value (SomeAny)
+//
+// Returns an error if the input can't be decoded as any.
+func (o *Any) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[any]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Any", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of any.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Any) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextAny(o.value)
+	if err != nil {
+		return nil, newEncodeError("Any", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneAny)
+//   - text representation of any: interpreted as a present value (SomeAny)
+//
+// Returns an error if the text can't be parsed as any.
+func (o *Any) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[any]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextAny(text)
+	if err != nil {
+		return newDecodeError("Any", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Any.
+// The Any stays None until the flag is passed, the value is parsed
+// as text representation of any. Get of the returned flag.Getter returns the Any.
+//
+// Example:
+//
+//	var opt option.Any
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Any) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextAny([]byte(text))
+			if err != nil {
+				return newDecodeError("Any", err)
+			}
+
+			*o = SomeAny(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
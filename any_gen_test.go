@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestAny_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyAny := option.NoneAny()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyAny.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestAny_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestAny_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeAny("hello").All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, "hello", values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneAny().All()))
+	})
+}
+
+func TestAny_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someAny := option.SomeAny("hello")
+	otherAny := option.SomeAny("bye")
+	emptyAny := option.NoneAny()
+
+	assert.True(t, someAny.Equal(option.SomeAny("hello")))
+	assert.True(t, emptyAny.Equal(option.NoneAny()))
+	assert.False(t, someAny.Equal(otherAny))
+	assert.False(t, someAny.Equal(emptyAny))
+	assert.False(t, emptyAny.Equal(someAny))
+
+	assert.Zero(t, someAny.Compare(option.SomeAny("hello")))
+	assert.Zero(t, emptyAny.Compare(option.NoneAny()))
+	assert.Equal(t, -otherAny.Compare(someAny), someAny.Compare(otherAny))
+	assert.NotZero(t, someAny.Compare(otherAny))
+	assert.Equal(t, 1, someAny.Compare(emptyAny))
+	assert.Equal(t, -1, emptyAny.Compare(someAny))
+}
+
+func TestAny_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		assert.True(t, someAny.Filter(func(any) bool { return true }).IsSome())
+		assert.False(t, someAny.Filter(func(any) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		assert.False(t, emptyAny.Filter(func(any) bool { return true }).IsSome())
+	})
+}
+
+func TestAny_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		other := option.SomeAny("bye")
+		assert.EqualValues(t, "hello", someAny.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		other := option.SomeAny("bye")
+		assert.EqualValues(t, "bye", emptyAny.Or(other).Unwrap())
+	})
+}
+
+func TestAny_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		assert.EqualValues(t, "hello", someAny.OrElse(func() option.Any {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		assert.EqualValues(t, "bye", emptyAny.OrElse(func() option.Any {
+			return option.SomeAny("bye")
+		}).Unwrap())
+	})
+}
+
 func TestAny_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -268,6 +373,214 @@ func TestAny_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestAny_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someAny := option.SomeAny("hello")
+		require.NoError(t, someAny.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someAny.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someAny := option.SomeAny(123)
+		require.NoError(t, someAny.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someAny.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someAny := option.SomeAny(true)
+		require.NoError(t, someAny.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someAny.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_3", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someAny := option.SomeAny(123.456)
+		require.NoError(t, someAny.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someAny.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneAny().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestAny_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		val, err := someAny.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Any
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, "hello", scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		val, err := emptyAny.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeAny("hello")
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestAny_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		data, err := json.Marshal(someAny)
+		require.NoError(t, err)
+
+		var unmarshaled option.Any
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, "hello", unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		data, err := json.Marshal(emptyAny)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeAny("hello")
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Any
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Any", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestAny_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someAny := option.SomeAny("hello")
+		data, err := someAny.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Any
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, "hello", unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyAny := option.NoneAny()
+		data, err := emptyAny.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeAny("hello")
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestAny_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeAny("hello").MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Any
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, "hello", opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Any
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeAny() {
 	opt := option.SomeAny("hello")
 	if opt.IsSome() {
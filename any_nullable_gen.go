@@ -0,0 +1,298 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableAny represents a nullable value of type any with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableAny struct {
+	value any
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableAny]()
+}
+
+// SomeNullableAny creates a NullableAny with the given any value.
+func SomeNullableAny(value any) NullableAny {
+	return NullableAny{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableAny creates a NullableAny, that is explicitly set to null.
+func NullNullableAny() NullableAny {
+	return NullableAny{
+		value: zero[any](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableAny creates a NullableAny, that is not set.
+// It is equal to the zero value of NullableAny.
+func UnsetNullableAny() NullableAny {
+	return NullableAny{
+		value: zero[any](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableAny contains a value.
+func (o NullableAny) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableAny is explicitly set to null.
+func (o NullableAny) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableAny is either null or contains a value.
+func (o NullableAny) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableAny is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableAny) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of any, false).
+func (o NullableAny) Get() (any, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableAny) MustGet() any {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for any.
+func (o NullableAny) Unwrap() any {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableAny) UnwrapOr(defaultValue any) any {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableAny) UnwrapOrElse(defaultValue func() any) any {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableAny to Any. Both Null and Unset are converted to NoneAny().
+func (o NullableAny) Option() Any {
+	if o.state == nullableSome {
+		return SomeAny(o.value)
+	}
+
+	return NoneAny()
+}
+
+// Equal returns true if both NullableAnys have the same state and, if present, equal values.
+func (o NullableAny) Equal(other NullableAny) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalAny(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableAny) Compare(other NullableAny) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareAny(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableAny value using MessagePack format.
+// - If the value is present, it is encoded as any.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableAny) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableAny", encodeAny(encoder, o.value))
+	}
+
+	return newEncodeError("NullableAny", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableAny value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as any.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableAny) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendAny(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableAny", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableAny value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableAny)
+//   - any: interpreted as a present value (SomeNullableAny)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableAny) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableAny", "any", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[any]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableAny", "any", offset, decoder.Skip())
+	case checkAny(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val any
+
+		val, err = decodeAny(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableAny", "any", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableAny", "any", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableAny value using JSON format.
+// - If the value is present, it is encoded as any.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableAny) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableAny", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableAny value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableAny)
+//   - any: interpreted as a present value (SomeNullableAny)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableAny) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[any]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableAny", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of any.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableAny) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextAny(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableAny", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableAny)
+//   - text representation of any: interpreted as a present value (SomeNullableAny)
+func (o *NullableAny) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[any]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextAny(text)
+	if err != nil {
+		return newDecodeError("NullableAny", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
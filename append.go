@@ -0,0 +1,395 @@
+package option
+
+// This file provides append-style MessagePack encoding. Appenders write the same bytes as
+// msgpack.Encoder with default options, but directly into the destination slice, so encoding
+// of primitive values does not allocate, if dst has enough capacity.
+
+import (
+	"encoding/binary"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// AppendMarshaler is the interface implemented by types, that can append their MessagePack
+// representation to a byte slice.
+//
+// AppendMsgpack appends the complete encoded value to dst and returns the extended buffer.
+// The bytes are the same, as EncodeMsgpack writes with the default msgpack.Encoder options.
+type AppendMarshaler interface {
+	AppendMsgpack(dst []byte) ([]byte, error)
+}
+
+// ExtPayloadAppender is the interface implemented by types, that are encoded as MessagePack
+// extensions and can append the payload of the extension to a byte slice.
+//
+// AppendExtPayload appends only the payload to dst, the same way as MarshalMsgpack returns it,
+// and returns the extended buffer. Optional types, generated by gentypes in ext mode, use it to
+// write the payload straight into the destination buffer.
+type ExtPayloadAppender interface {
+	AppendExtPayload(dst []byte) ([]byte, error)
+}
+
+// appendWriter is an io.Writer, that appends everything to its buffer.
+type appendWriter struct {
+	buf []byte
+}
+
+func (w *appendWriter) Write(data []byte) (int, error) {
+	w.buf = append(w.buf, data...)
+
+	return len(data), nil
+}
+
+func (w *appendWriter) WriteByte(c byte) error {
+	w.buf = append(w.buf, c)
+
+	return nil
+}
+
+// appendEncoder is a msgpack.Encoder, that writes to an appendWriter.
+type appendEncoder struct {
+	writer  *appendWriter
+	encoder *msgpack.Encoder
+}
+
+var appendEncoderPool = sync.Pool{ //nolint:gochecknoglobals
+	New: func() any {
+		writer := &appendWriter{buf: nil}
+
+		return &appendEncoder{writer: writer, encoder: msgpack.NewEncoder(writer)}
+	},
+}
+
+// AppendValue appends val, encoded with msgpack.Encoder with default options, to dst and
+// returns the extended buffer. It is a fallback for values without a dedicated appender and
+// is used by the code generated with gentypes.
+func AppendValue(dst []byte, val any) ([]byte, error) {
+	return appendWithEncoder(dst, func(encoder *msgpack.Encoder) error {
+		return encoder.Encode(val)
+	})
+}
+
+// appendWithEncoder appends everything, that encode writes with a pooled msgpack.Encoder, to dst.
+func appendWithEncoder(dst []byte, encode func(encoder *msgpack.Encoder) error) ([]byte, error) {
+	enc, _ := appendEncoderPool.Get().(*appendEncoder)
+
+	enc.writer.buf = dst
+	err := encode(enc.encoder)
+	dst = enc.writer.buf
+
+	enc.writer.buf = nil
+	appendEncoderPool.Put(enc)
+
+	return dst, err
+}
+
+// AppendExtHeader appends a MessagePack extension header with the given code and payload length to dst.
+// It is used by the code generated with gentypes.
+func AppendExtHeader(dst []byte, extCode int8, length int) []byte {
+	switch length {
+	case 1:
+		dst = append(dst, msgpcode.FixExt1)
+	case 2: //nolint:mnd
+		dst = append(dst, msgpcode.FixExt2)
+	case 4: //nolint:mnd
+		dst = append(dst, msgpcode.FixExt4)
+	case 8: //nolint:mnd
+		dst = append(dst, msgpcode.FixExt8)
+	case 16: //nolint:mnd
+		dst = append(dst, msgpcode.FixExt16)
+	default:
+		switch {
+		case length <= math.MaxUint8:
+			dst = append(dst, msgpcode.Ext8, byte(length))
+		case length <= math.MaxUint16:
+			dst = binary.BigEndian.AppendUint16(append(dst, msgpcode.Ext16), uint16(length))
+		default:
+			dst = binary.BigEndian.AppendUint32(append(dst, msgpcode.Ext32), uint32(length)) //nolint:gosec
+		}
+	}
+
+	return append(dst, byte(extCode))
+}
+
+// InsertExtHeader inserts a MessagePack extension header with the given code before the payload,
+// that was appended to dst starting at offset. It is used by the code generated with gentypes
+// to write payloads of ExtPayloadAppender implementations straight into the destination buffer.
+func InsertExtHeader(dst []byte, offset int, extCode int8) []byte {
+	var header [6]byte
+
+	return slices.Insert(dst, offset, AppendExtHeader(header[:0], extCode, len(dst)-offset)...)
+}
+
+func appendNil(dst []byte) []byte {
+	return append(dst, msgpcode.Nil)
+}
+
+func appendBool(dst []byte, val bool) ([]byte, error) {
+	if val {
+		return append(dst, msgpcode.True), nil
+	}
+
+	return append(dst, msgpcode.False), nil
+}
+
+// appendCompactUint appends the unsigned integer using the most compact representation, like
+// msgpack.Encoder.EncodeUint does.
+func appendCompactUint(dst []byte, val uint64) []byte {
+	switch {
+	case val <= uint64(msgpcode.PosFixedNumHigh):
+		return append(dst, byte(val))
+	case val <= math.MaxUint8:
+		return append(dst, msgpcode.Uint8, byte(val))
+	case val <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, msgpcode.Uint16), uint16(val))
+	case val <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, msgpcode.Uint32), uint32(val))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, msgpcode.Uint64), val)
+	}
+}
+
+// appendCompactInt appends the integer using the most compact representation, like
+// msgpack.Encoder.EncodeInt does. Non-negative values are encoded as unsigned ones.
+func appendCompactInt(dst []byte, val int64) []byte {
+	if val >= 0 {
+		return appendCompactUint(dst, uint64(val))
+	}
+
+	return appendMsgpackInt(dst, val)
+}
+
+func appendInt(dst []byte, val int) ([]byte, error) {
+	return appendCompactInt(dst, int64(val)), nil
+}
+
+func appendInt8(dst []byte, val int8) ([]byte, error) {
+	return append(dst, msgpcode.Int8, byte(val)), nil
+}
+
+func appendInt16(dst []byte, val int16) ([]byte, error) {
+	return binary.BigEndian.AppendUint16(append(dst, msgpcode.Int16), uint16(val)), nil //nolint:gosec
+}
+
+func appendInt32(dst []byte, val int32) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(append(dst, msgpcode.Int32), uint32(val)), nil //nolint:gosec
+}
+
+func appendInt64(dst []byte, val int64) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(append(dst, msgpcode.Int64), uint64(val)), nil //nolint:gosec
+}
+
+func appendUint(dst []byte, val uint) ([]byte, error) {
+	return appendCompactUint(dst, uint64(val)), nil
+}
+
+func appendUint8(dst []byte, val uint8) ([]byte, error) {
+	return append(dst, msgpcode.Uint8, val), nil
+}
+
+func appendUint16(dst []byte, val uint16) ([]byte, error) {
+	return binary.BigEndian.AppendUint16(append(dst, msgpcode.Uint16), val), nil
+}
+
+func appendUint32(dst []byte, val uint32) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(append(dst, msgpcode.Uint32), val), nil
+}
+
+func appendUint64(dst []byte, val uint64) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(append(dst, msgpcode.Uint64), val), nil
+}
+
+func appendByte(dst []byte, val byte) ([]byte, error) {
+	return appendUint8(dst, val)
+}
+
+func appendFloat32(dst []byte, val float32) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(append(dst, msgpcode.Float), math.Float32bits(val)), nil
+}
+
+func appendFloat64(dst []byte, val float64) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(append(dst, msgpcode.Double), math.Float64bits(val)), nil
+}
+
+func appendString(dst []byte, val string) ([]byte, error) {
+	length := len(val)
+
+	switch {
+	case length < 32: //nolint:mnd
+		dst = append(dst, msgpcode.FixedStrLow|byte(length))
+	case length <= math.MaxUint8:
+		dst = append(dst, msgpcode.Str8, byte(length))
+	case length <= math.MaxUint16:
+		dst = binary.BigEndian.AppendUint16(append(dst, msgpcode.Str16), uint16(length))
+	default:
+		dst = binary.BigEndian.AppendUint32(append(dst, msgpcode.Str32), uint32(length)) //nolint:gosec
+	}
+
+	return append(dst, val...), nil
+}
+
+// appendBytes appends the byte slice as MessagePack binary. A nil slice is encoded as nil,
+// like msgpack.Encoder.EncodeBytes does.
+func appendBytes(dst []byte, val []byte) ([]byte, error) {
+	if val == nil {
+		return appendNil(dst), nil
+	}
+
+	length := len(val)
+
+	switch {
+	case length <= math.MaxUint8:
+		dst = append(dst, msgpcode.Bin8, byte(length))
+	case length <= math.MaxUint16:
+		dst = binary.BigEndian.AppendUint16(append(dst, msgpcode.Bin16), uint16(length))
+	default:
+		dst = binary.BigEndian.AppendUint32(append(dst, msgpcode.Bin32), uint32(length)) //nolint:gosec
+	}
+
+	return append(dst, val...), nil
+}
+
+// timeExtCode is the code of the MessagePack timestamp extension.
+const timeExtCode = -1
+
+// appendTime appends the time as a MessagePack timestamp extension, like msgpack.Encoder.EncodeTime does.
+func appendTime(dst []byte, val time.Time) ([]byte, error) {
+	secs := uint64(val.Unix()) //nolint:gosec
+	nsec := uint64(val.Nanosecond())
+
+	if secs>>34 == 0 {
+		data := nsec<<34 | secs
+		if data&0xffffffff00000000 == 0 {
+			return binary.BigEndian.AppendUint32(AppendExtHeader(dst, timeExtCode, 4), uint32(data)), nil //nolint:mnd
+		}
+
+		return binary.BigEndian.AppendUint64(AppendExtHeader(dst, timeExtCode, 8), data), nil //nolint:mnd
+	}
+
+	dst = binary.BigEndian.AppendUint32(AppendExtHeader(dst, timeExtCode, 12), uint32(nsec)) //nolint:mnd
+
+	return binary.BigEndian.AppendUint64(dst, secs), nil
+}
+
+func appendDuration(dst []byte, val time.Duration) ([]byte, error) {
+	return appendCompactInt(dst, int64(val)), nil
+}
+
+func appendDecimal(dst []byte, val DecimalValue) ([]byte, error) {
+	return InsertExtHeader(appendDecimalPayload(dst, val), len(dst), DecimalExtCode), nil
+}
+
+func appendUUID(dst []byte, val uuid.UUID) ([]byte, error) {
+	return appendUUIDPayload(AppendExtHeader(dst, UUIDExtCode, len(val)), val), nil
+}
+
+func appendDatetime(dst []byte, val time.Time) ([]byte, error) {
+	return InsertExtHeader(appendDatetimePayload(dst, val), len(dst), DatetimeExtCode), nil
+}
+
+func appendInterval(dst []byte, val IntervalValue) ([]byte, error) {
+	out, err := appendIntervalPayload(dst, val)
+	if err != nil {
+		return dst, err
+	}
+
+	return InsertExtHeader(out, len(dst), IntervalExtCode), nil
+}
+
+// appendAny appends the value with a dedicated appender, if there is one for its type.
+// Other values are encoded with msgpack.Encoder.
+func appendAny(dst []byte, val any) ([]byte, error) {
+	if val == nil {
+		return appendNil(dst), nil
+	}
+
+	if marshaler, ok := convertToAppendMarshaler(val); ok {
+		return marshaler.AppendMsgpack(dst)
+	}
+
+	return appendPrimitive(dst, val, func() ([]byte, error) { return AppendValue(dst, val) })
+}
+
+// convertToAppendMarshaler checks whether the value could be appended with its AppendMsgpack method
+// instead of msgpack.Encoder. Only msgpack.CustomEncoder implementations are accepted, since
+// other types are encoded by msgpack.Encoder with reflection, that AppendMsgpack may not follow.
+func convertToAppendMarshaler(v any) (AppendMarshaler, bool) {
+	if _, ok := v.(msgpack.CustomEncoder); !ok {
+		return nil, false
+	}
+
+	marshaler, ok := v.(AppendMarshaler)
+
+	return marshaler, ok
+}
+
+// appendPrimitive appends the value with a dedicated appender for its type. The types and their
+// encodings are the same, as used by msgpack.Encoder for values of these types. Other values are
+// appended with the fallback function.
+func appendPrimitive(dst []byte, val any, fallback func() ([]byte, error)) ([]byte, error) {
+	switch v := val.(type) {
+	case int:
+		return appendInt(dst, v)
+	case int8:
+		return appendInt8(dst, v)
+	case int16:
+		return appendInt16(dst, v)
+	case int32:
+		return appendInt32(dst, v)
+	case int64:
+		return appendInt64(dst, v)
+	case uint:
+		return appendUint(dst, v)
+	case uint8:
+		return appendUint8(dst, v)
+	case uint16:
+		return appendUint16(dst, v)
+	case uint32:
+		return appendUint32(dst, v)
+	case uint64:
+		return appendUint64(dst, v)
+	case float32:
+		return appendFloat32(dst, v)
+	case float64:
+		return appendFloat64(dst, v)
+	case string:
+		return appendString(dst, v)
+	case []byte:
+		return appendBytes(dst, v)
+	case bool:
+		return appendBool(dst, v)
+	case time.Time:
+		return appendTime(dst, v)
+	case time.Duration:
+		return appendInt64(dst, int64(v))
+	default:
+		return fallback()
+	}
+}
+
+// appendGeneric appends the value in the same way as Generic[T].EncodeMsgpack encodes it.
+func appendGeneric[T any](dst []byte, val T) ([]byte, error) {
+	if codec := getGenericCodec[T](); codec != nil && codec.registered {
+		return appendWithEncoder(dst, func(encoder *msgpack.Encoder) error {
+			return codec.encode(encoder, val)
+		})
+	}
+
+	return appendPrimitive(dst, val, func() ([]byte, error) { return appendGenericSlow(dst, val) })
+}
+
+// appendGenericSlow appends the value, that has no dedicated appender, using its AppendMsgpack
+// method or msgpack.Encoder.
+func appendGenericSlow[T any](dst []byte, val T) ([]byte, error) {
+	if marshaler, ok := convertToAppendMarshaler(&val); ok {
+		return marshaler.AppendMsgpack(dst)
+	}
+
+	return AppendValue(dst, &val)
+}
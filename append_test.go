@@ -0,0 +1,152 @@
+package option_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+type appendTestStruct struct {
+	Name  string
+	Value int
+}
+
+// requireAppendEqualsEncode checks, that AppendMsgpack appends the same bytes, as EncodeMsgpack writes.
+func requireAppendEqualsEncode(t *testing.T, value interface {
+	msgpack.CustomEncoder
+	option.AppendMarshaler
+},
+) {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	require.NoError(t, value.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+	prefix := []byte{0x92, 0x01}
+
+	data, err := value.AppendMsgpack(append([]byte{}, prefix...))
+	require.NoError(t, err)
+	assert.Equal(t, append(prefix, buf.Bytes()...), data)
+}
+
+func TestGeneric_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	longString := strings.Repeat("a", 70000)
+
+	values := map[string]interface {
+		msgpack.CustomEncoder
+		option.AppendMarshaler
+	}{
+		"none":            option.None[int](),
+		"int":             option.Some(-100000),
+		"int8":            option.Some(int8(-1)),
+		"int16":           option.Some(int16(300)),
+		"int32":           option.Some(int32(-70000)),
+		"int64":           option.Some(int64(1)),
+		"uint":            option.Some(uint(1 << 40)),
+		"uint8":           option.Some(uint8(200)),
+		"uint16":          option.Some(uint16(1)),
+		"uint32":          option.Some(uint32(70000)),
+		"uint64":          option.Some(uint64(1 << 63)),
+		"float32":         option.Some(float32(1.5)),
+		"float64":         option.Some(-2.25),
+		"short string":    option.Some("abc"),
+		"string":          option.Some(strings.Repeat("a", 200)),
+		"long string":     option.Some(longString),
+		"nil bytes":       option.Some([]byte(nil)),
+		"bytes":           option.Some([]byte(longString)),
+		"bool":            option.Some(true),
+		"time":            option.Some(time.Unix(1700000000, 0)),
+		"time with nsec":  option.Some(time.Unix(1700000000, 123)),
+		"distant time":    option.Some(time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC)),
+		"duration":        option.Some(time.Second),
+		"struct":          option.Some(appendTestStruct{Name: "a", Value: 1}),
+		"slice":           option.Some([]int{1, 2, 3}),
+		"optional":        option.Some(option.SomeInt(42)),
+		"decimal":         option.Some(option.MustParseDecimalValue("-12.34")),
+		"nullable":        option.SomeNullable(uint16(7)),
+		"null nullable":   option.NullNullable[string](),
+		"unset nullable":  option.UnsetNullable[string](),
+		"nullable struct": option.SomeNullable(appendTestStruct{Name: "b", Value: 2}),
+	}
+
+	for name, value := range values {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			requireAppendEqualsEncode(t, value)
+		})
+	}
+}
+
+func TestGeneric_AppendMsgpack_Error(t *testing.T) {
+	t.Parallel()
+
+	data, err := option.Some(func() {}).AppendMsgpack([]byte{0x91})
+	require.Error(t, err)
+	assert.Equal(t, []byte{0x91}, data)
+
+	var encodeErr option.EncodeError
+	require.ErrorAs(t, err, &encodeErr)
+}
+
+func TestAppendMsgpack_Allocations(t *testing.T) { //nolint:paralleltest // AllocsPerRun is not reliable in parallel tests.
+	dst := make([]byte, 0, 64)
+
+	values := map[string]option.AppendMarshaler{
+		"generic int":    option.Some(12),
+		"generic string": option.Some("abc"),
+		"generic time":   option.Some(time.Unix(1700000000, 0)),
+		"int":            option.SomeInt(12),
+		"float64":        option.SomeFloat64(1.5),
+		"string":         option.SomeString("abc"),
+		"bytes":          option.SomeBytes([]byte("abc")),
+		"uuid":           option.SomeUUID([16]byte{1, 2, 3}),
+		"datetime":       option.SomeDatetime(time.Unix(1700000000, 0)),
+		"none":           option.NoneInt(),
+	}
+
+	for name, value := range values {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = value.AppendMsgpack(dst[:0])
+		})
+		assert.Zero(t, allocs, name)
+	}
+}
+
+func TestAppendExtHeader(t *testing.T) {
+	t.Parallel()
+
+	for _, length := range []int{1, 2, 3, 4, 8, 16, 17, 300, 70000} {
+		var buf bytes.Buffer
+
+		require.NoError(t, msgpack.NewEncoder(&buf).EncodeExtHeader(5, length))
+		assert.Equal(t, buf.Bytes(), option.AppendExtHeader([]byte{}, 5, length), length)
+
+		payload := bytes.Repeat([]byte{0xAB}, length)
+		data := option.InsertExtHeader(append([]byte{0x90}, payload...), 1, 5)
+		assert.Equal(t, append(append([]byte{0x90}, buf.Bytes()...), payload...), data, length)
+	}
+}
+
+func TestAppendValue(t *testing.T) {
+	t.Parallel()
+
+	value := map[string]any{"a": []any{1, "b", nil}}
+
+	expected, err := msgpack.Marshal(value)
+	require.NoError(t, err)
+
+	data, err := option.AppendValue([]byte{0xC0}, value)
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{0xC0}, expected...), data)
+}
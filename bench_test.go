@@ -548,3 +548,53 @@ func BenchmarkEncodeDecodeStruct(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkEncodeAppendInt(b *testing.B) {
+	var buf bytes.Buffer
+	buf.Grow(4096)
+
+	enc := msgpack.GetEncoder()
+	enc.Reset(&buf)
+
+	dst := make([]byte, 0, 4096)
+
+	b.Run("TypedEncode", func(b *testing.B) {
+		for b.Loop() {
+			err := option.SomeInt(42).EncodeMsgpack(enc)
+			if err != nil {
+				b.Errorf("EncodeMsgpack() failed: %v", err)
+			}
+
+			buf.Reset()
+		}
+	})
+
+	b.Run("TypedAppend", func(b *testing.B) {
+		for b.Loop() {
+			_, err := option.SomeInt(42).AppendMsgpack(dst[:0])
+			if err != nil {
+				b.Errorf("AppendMsgpack() failed: %v", err)
+			}
+		}
+	})
+
+	b.Run("GenericEncode", func(b *testing.B) {
+		for b.Loop() {
+			err := option.Some(42).EncodeMsgpack(enc)
+			if err != nil {
+				b.Errorf("EncodeMsgpack() failed: %v", err)
+			}
+
+			buf.Reset()
+		}
+	})
+
+	b.Run("GenericAppend", func(b *testing.B) {
+		for b.Loop() {
+			_, err := option.Some(42).AppendMsgpack(dst[:0])
+			if err != nil {
+				b.Errorf("AppendMsgpack() failed: %v", err)
+			}
+		}
+	})
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Bool) Get() (bool, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Bool) MustGet() bool {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Bool) UnwrapOrElse(defaultValue func() bool) bool {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Bool) All() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Bools are None, or both contain equal values.
+func (o Bool) Equal(other Bool) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalBool(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneBool() is less than any value.
+func (o Bool) Compare(other Bool) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareBool(o.value, other.value)
+	})
+}
+
+// Filter returns the Bool itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneBool().
+func (o Bool) Filter(predicate func(bool) bool) Bool {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneBool()
+}
+
+// Or returns the Bool itself if it contains a value.
+// Otherwise, returns other.
+func (o Bool) Or(other Bool) Bool {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Bool itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Bool) OrElse(fn func() Bool) Bool {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Bool value using MessagePack format.
 // - If the value is present, it is encoded as bool.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,234 @@ func (o Bool) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Bool", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Bool value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as bool.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Bool) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendBool(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Bool", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Bool value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneBool)
 //   - bool: interpreted as a present value (SomeBool)
 //
+// In DecodeModeLenient alternative encodings of bool are accepted as well.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on bool: exists = true, value = decoded value
 func (o *Bool) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Bool", err)
+		return newDecodeMsgpackError("Bool", "bool", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Bool", decoder.Skip())
+		return newDecodeMsgpackError("Bool", "bool", offset, decoder.Skip())
 	case checkBool(code):
-		o.value, err = decodeBool(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val bool
+
+		val, err = decodeBool(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Bool", "bool", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkBoolLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeBoolLenient(decoder)
 		if err != nil {
-			return newDecodeError("Bool", err)
+			return newDecodeMsgpackError("Bool", "bool", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Bool", code)
+		return newDecodeWithCodeError("Bool", "bool", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as bool.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Bool) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueBool(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bool", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneBool)
+//   - bool: interpreted as a present value (SomeBool)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into bool.
+func (o *Bool) Scan(src any) error {
+	if src == nil {
+		o.value = zero[bool]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanBool(src)
+	if err != nil {
+		return newDecodeError("Bool", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Bool value using JSON format.
+// - If the value is present, it is encoded as bool.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Bool) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bool", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Bool value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneBool)
+//   - bool: interpreted as a present value (SomeBool)
+//
+// Returns an error if the input can't be decoded as bool.
+func (o *Bool) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[bool]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Bool", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of bool.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Bool) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextBool(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bool", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneBool)
+//   - text representation of bool: interpreted as a present value (SomeBool)
+//
+// Returns an error if the text can't be parsed as bool.
+func (o *Bool) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[bool]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextBool(text)
+	if err != nil {
+		return newDecodeError("Bool", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Bool.
+// The Bool stays None until the flag is passed, the value is parsed
+// as text representation of bool. Get of the returned flag.Getter returns the Bool.
+//
+// Example:
+//
+//	var opt option.Bool
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Bool) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextBool([]byte(text))
+			if err != nil {
+				return newDecodeError("Bool", err)
+			}
+
+			*o = SomeBool(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: true,
 	}
 }
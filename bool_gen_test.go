@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestBool_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyBool := option.NoneBool()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyBool.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestBool_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestBool_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeBool(true).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, true, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneBool().All()))
+	})
+}
+
+func TestBool_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someBool := option.SomeBool(true)
+	otherBool := option.SomeBool(false)
+	emptyBool := option.NoneBool()
+
+	assert.True(t, someBool.Equal(option.SomeBool(true)))
+	assert.True(t, emptyBool.Equal(option.NoneBool()))
+	assert.False(t, someBool.Equal(otherBool))
+	assert.False(t, someBool.Equal(emptyBool))
+	assert.False(t, emptyBool.Equal(someBool))
+
+	assert.Zero(t, someBool.Compare(option.SomeBool(true)))
+	assert.Zero(t, emptyBool.Compare(option.NoneBool()))
+	assert.Equal(t, -otherBool.Compare(someBool), someBool.Compare(otherBool))
+	assert.NotZero(t, someBool.Compare(otherBool))
+	assert.Equal(t, 1, someBool.Compare(emptyBool))
+	assert.Equal(t, -1, emptyBool.Compare(someBool))
+}
+
+func TestBool_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		assert.True(t, someBool.Filter(func(bool) bool { return true }).IsSome())
+		assert.False(t, someBool.Filter(func(bool) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		assert.False(t, emptyBool.Filter(func(bool) bool { return true }).IsSome())
+	})
+}
+
+func TestBool_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		other := option.SomeBool(false)
+		assert.EqualValues(t, true, someBool.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		other := option.SomeBool(false)
+		assert.EqualValues(t, false, emptyBool.Or(other).Unwrap())
+	})
+}
+
+func TestBool_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		assert.EqualValues(t, true, someBool.OrElse(func() option.Bool {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		assert.EqualValues(t, false, emptyBool.OrElse(func() option.Bool {
+			return option.SomeBool(false)
+		}).Unwrap())
+	})
+}
+
 func TestBool_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestBool_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestBool_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someBool := option.SomeBool(true)
+		require.NoError(t, someBool.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someBool.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneBool().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestBool_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		val, err := someBool.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Bool
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, true, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		val, err := emptyBool.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeBool(true)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestBool_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		data, err := json.Marshal(someBool)
+		require.NoError(t, err)
+
+		var unmarshaled option.Bool
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, true, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		data, err := json.Marshal(emptyBool)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeBool(true)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Bool
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Bool", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestBool_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBool := option.SomeBool(true)
+		data, err := someBool.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Bool
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, true, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBool := option.NoneBool()
+		data, err := emptyBool.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeBool(true)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestBool_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeBool(true).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Bool
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, true, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Bool
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeBool() {
 	opt := option.SomeBool(true)
 	if opt.IsSome() {
@@ -0,0 +1,310 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableBool represents a nullable value of type bool with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableBool struct {
+	value bool
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableBool]()
+}
+
+// SomeNullableBool creates a NullableBool with the given bool value.
+func SomeNullableBool(value bool) NullableBool {
+	return NullableBool{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableBool creates a NullableBool, that is explicitly set to null.
+func NullNullableBool() NullableBool {
+	return NullableBool{
+		value: zero[bool](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableBool creates a NullableBool, that is not set.
+// It is equal to the zero value of NullableBool.
+func UnsetNullableBool() NullableBool {
+	return NullableBool{
+		value: zero[bool](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableBool contains a value.
+func (o NullableBool) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableBool is explicitly set to null.
+func (o NullableBool) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableBool is either null or contains a value.
+func (o NullableBool) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableBool is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableBool) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of bool, false).
+func (o NullableBool) Get() (bool, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableBool) MustGet() bool {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for bool.
+func (o NullableBool) Unwrap() bool {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableBool) UnwrapOr(defaultValue bool) bool {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableBool) UnwrapOrElse(defaultValue func() bool) bool {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableBool to Bool. Both Null and Unset are converted to NoneBool().
+func (o NullableBool) Option() Bool {
+	if o.state == nullableSome {
+		return SomeBool(o.value)
+	}
+
+	return NoneBool()
+}
+
+// Equal returns true if both NullableBools have the same state and, if present, equal values.
+func (o NullableBool) Equal(other NullableBool) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalBool(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableBool) Compare(other NullableBool) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareBool(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableBool value using MessagePack format.
+// - If the value is present, it is encoded as bool.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableBool) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableBool", encodeBool(encoder, o.value))
+	}
+
+	return newEncodeError("NullableBool", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableBool value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as bool.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableBool) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendBool(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableBool", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableBool value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableBool)
+//   - bool: interpreted as a present value (SomeNullableBool)
+//
+// In DecodeModeLenient alternative encodings of bool are accepted as well.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableBool) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableBool", "bool", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[bool]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableBool", "bool", offset, decoder.Skip())
+	case checkBool(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val bool
+
+		val, err = decodeBool(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableBool", "bool", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkBoolLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeBoolLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableBool", "bool", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableBool", "bool", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableBool value using JSON format.
+// - If the value is present, it is encoded as bool.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableBool) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableBool", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableBool value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableBool)
+//   - bool: interpreted as a present value (SomeNullableBool)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableBool) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[bool]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableBool", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of bool.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableBool) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextBool(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableBool", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableBool)
+//   - text representation of bool: interpreted as a present value (SomeNullableBool)
+func (o *NullableBool) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[bool]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextBool(text)
+	if err != nil {
+		return newDecodeError("NullableBool", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Byte) Get() (byte, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Byte) MustGet() byte {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Byte) UnwrapOrElse(defaultValue func() byte) byte {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Byte) All() iter.Seq[byte] {
+	return func(yield func(byte) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Bytes are None, or both contain equal values.
+func (o Byte) Equal(other Byte) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneByte() is less than any value.
+func (o Byte) Compare(other Byte) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Byte itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneByte().
+func (o Byte) Filter(predicate func(byte) bool) Byte {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneByte()
+}
+
+// Or returns the Byte itself if it contains a value.
+// Otherwise, returns other.
+func (o Byte) Or(other Byte) Byte {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Byte itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Byte) OrElse(fn func() Byte) Byte {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Byte value using MessagePack format.
 // - If the value is present, it is encoded as byte.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Byte) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Byte", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Byte value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as byte.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Byte) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendByte(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Byte", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Byte value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneByte)
 //   - byte: interpreted as a present value (SomeByte)
 //
+// In DecodeModeLenient alternative encodings of byte are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as byte exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on byte: exists = true, value = decoded value
 func (o *Byte) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Byte", err)
+		return newDecodeMsgpackError("Byte", "byte", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Byte", decoder.Skip())
+		return newDecodeMsgpackError("Byte", "byte", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeByte(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val byte
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeByteStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Byte", "byte", offset, code, err)
+			}
+		} else {
+			val, err = decodeByte(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Byte", "byte", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeByteLenient(decoder)
 		if err != nil {
-			return newDecodeError("Byte", err)
+			return newDecodeMsgpackError("Byte", "byte", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Byte", code)
+		return newDecodeWithCodeError("Byte", "byte", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as byte.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Byte) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueByte(o.value)
+	if err != nil {
+		return nil, newEncodeError("Byte", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneByte)
+//   - byte: interpreted as a present value (SomeByte)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into byte.
+func (o *Byte) Scan(src any) error {
+	if src == nil {
+		o.value = zero[byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanByte(src)
+	if err != nil {
+		return newDecodeError("Byte", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Byte value using JSON format.
+// - If the value is present, it is encoded as byte.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Byte) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Byte", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Byte value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneByte)
+//   - byte: interpreted as a present value (SomeByte)
+//
+// Returns an error if the input can't be decoded as byte.
+func (o *Byte) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Byte", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of byte.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Byte) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextByte(o.value)
+	if err != nil {
+		return nil, newEncodeError("Byte", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneByte)
+//   - text representation of byte: interpreted as a present value (SomeByte)
+//
+// Returns an error if the text can't be parsed as byte.
+func (o *Byte) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextByte(text)
+	if err != nil {
+		return newDecodeError("Byte", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Byte.
+// The Byte stays None until the flag is passed, the value is parsed
+// as text representation of byte. Get of the returned flag.Getter returns the Byte.
+//
+// Example:
+//
+//	var opt option.Byte
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Byte) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextByte([]byte(text))
+			if err != nil {
+				return newDecodeError("Byte", err)
+			}
+
+			*o = SomeByte(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
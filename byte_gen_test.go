@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestByte_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyByte := option.NoneByte()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyByte.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestByte_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestByte_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeByte(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneByte().All()))
+	})
+}
+
+func TestByte_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someByte := option.SomeByte(12)
+	otherByte := option.SomeByte(13)
+	emptyByte := option.NoneByte()
+
+	assert.True(t, someByte.Equal(option.SomeByte(12)))
+	assert.True(t, emptyByte.Equal(option.NoneByte()))
+	assert.False(t, someByte.Equal(otherByte))
+	assert.False(t, someByte.Equal(emptyByte))
+	assert.False(t, emptyByte.Equal(someByte))
+
+	assert.Zero(t, someByte.Compare(option.SomeByte(12)))
+	assert.Zero(t, emptyByte.Compare(option.NoneByte()))
+	assert.Equal(t, -otherByte.Compare(someByte), someByte.Compare(otherByte))
+	assert.NotZero(t, someByte.Compare(otherByte))
+	assert.Equal(t, 1, someByte.Compare(emptyByte))
+	assert.Equal(t, -1, emptyByte.Compare(someByte))
+}
+
+func TestByte_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		assert.True(t, someByte.Filter(func(byte) bool { return true }).IsSome())
+		assert.False(t, someByte.Filter(func(byte) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		assert.False(t, emptyByte.Filter(func(byte) bool { return true }).IsSome())
+	})
+}
+
+func TestByte_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		other := option.SomeByte(13)
+		assert.EqualValues(t, 12, someByte.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		other := option.SomeByte(13)
+		assert.EqualValues(t, 13, emptyByte.Or(other).Unwrap())
+	})
+}
+
+func TestByte_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		assert.EqualValues(t, 12, someByte.OrElse(func() option.Byte {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		assert.EqualValues(t, 13, emptyByte.OrElse(func() option.Byte {
+			return option.SomeByte(13)
+		}).Unwrap())
+	})
+}
+
 func TestByte_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestByte_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestByte_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someByte := option.SomeByte(12)
+		require.NoError(t, someByte.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someByte.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneByte().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestByte_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		val, err := someByte.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Byte
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		val, err := emptyByte.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeByte(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestByte_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		data, err := json.Marshal(someByte)
+		require.NoError(t, err)
+
+		var unmarshaled option.Byte
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		data, err := json.Marshal(emptyByte)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeByte(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Byte
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Byte", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestByte_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someByte := option.SomeByte(12)
+		data, err := someByte.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Byte
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyByte := option.NoneByte()
+		data, err := emptyByte.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeByte(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestByte_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeByte(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Byte
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Byte
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeByte() {
 	opt := option.SomeByte(12)
 	if opt.IsSome() {
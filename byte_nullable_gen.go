@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableByte represents a nullable value of type byte with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableByte struct {
+	value byte
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableByte]()
+}
+
+// SomeNullableByte creates a NullableByte with the given byte value.
+func SomeNullableByte(value byte) NullableByte {
+	return NullableByte{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableByte creates a NullableByte, that is explicitly set to null.
+func NullNullableByte() NullableByte {
+	return NullableByte{
+		value: zero[byte](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableByte creates a NullableByte, that is not set.
+// It is equal to the zero value of NullableByte.
+func UnsetNullableByte() NullableByte {
+	return NullableByte{
+		value: zero[byte](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableByte contains a value.
+func (o NullableByte) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableByte is explicitly set to null.
+func (o NullableByte) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableByte is either null or contains a value.
+func (o NullableByte) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableByte is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableByte) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of byte, false).
+func (o NullableByte) Get() (byte, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableByte) MustGet() byte {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for byte.
+func (o NullableByte) Unwrap() byte {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableByte) UnwrapOr(defaultValue byte) byte {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableByte) UnwrapOrElse(defaultValue func() byte) byte {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableByte to Byte. Both Null and Unset are converted to NoneByte().
+func (o NullableByte) Option() Byte {
+	if o.state == nullableSome {
+		return SomeByte(o.value)
+	}
+
+	return NoneByte()
+}
+
+// Equal returns true if both NullableBytes have the same state and, if present, equal values.
+func (o NullableByte) Equal(other NullableByte) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableByte) Compare(other NullableByte) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableByte value using MessagePack format.
+// - If the value is present, it is encoded as byte.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableByte) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableByte", encodeByte(encoder, o.value))
+	}
+
+	return newEncodeError("NullableByte", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableByte value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as byte.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableByte) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendByte(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableByte", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableByte value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableByte)
+//   - byte: interpreted as a present value (SomeNullableByte)
+//
+// In DecodeModeLenient alternative encodings of byte are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as byte exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableByte) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableByte", "byte", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[byte]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableByte", "byte", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val byte
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeByteStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableByte", "byte", offset, code, err)
+			}
+		} else {
+			val, err = decodeByte(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableByte", "byte", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeByteLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableByte", "byte", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableByte", "byte", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableByte value using JSON format.
+// - If the value is present, it is encoded as byte.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableByte) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableByte", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableByte value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableByte)
+//   - byte: interpreted as a present value (SomeNullableByte)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableByte) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[byte]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableByte", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of byte.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableByte) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextByte(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableByte", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableByte)
+//   - text representation of byte: interpreted as a present value (SomeNullableByte)
+func (o *NullableByte) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[byte]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextByte(text)
+	if err != nil {
+		return newDecodeError("NullableByte", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,218 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"cmp"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestNullableByte_State(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullableByte(12)
+		assert.True(t, some.IsSome())
+		assert.False(t, some.IsNull())
+		assert.True(t, some.IsSet())
+		assert.False(t, some.IsZero())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullableByte()
+		assert.False(t, null.IsSome())
+		assert.True(t, null.IsNull())
+		assert.True(t, null.IsSet())
+		assert.False(t, null.IsZero())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		unset := option.UnsetNullableByte()
+		assert.False(t, unset.IsSome())
+		assert.False(t, unset.IsNull())
+		assert.False(t, unset.IsSet())
+		assert.True(t, unset.IsZero())
+		assert.Equal(t, option.NullableByte{}, unset)
+	})
+}
+
+func TestNullableByte_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullableByte(12)
+		val, ok := some.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, 12, val)
+		assert.EqualValues(t, 12, some.MustGet())
+		assert.EqualValues(t, 12, some.UnwrapOr(13))
+		assert.True(t, some.Option().IsSome())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullableByte()
+		_, ok := null.Get()
+		require.False(t, ok)
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			null.MustGet()
+		})
+		assert.EqualValues(t, 13, null.UnwrapOr(13))
+		assert.False(t, null.Option().IsSome())
+	})
+}
+
+func TestNullableByte_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	ordered := []option.NullableByte{
+		option.UnsetNullableByte(),
+		option.NullNullableByte(),
+		option.SomeNullableByte(12),
+	}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			assert.Equal(t, i == j, a.Equal(b))
+			assert.Equal(t, cmp.Compare(i, j), a.Compare(b))
+		}
+	}
+
+	assert.False(t, ordered[2].Equal(option.SomeNullableByte(13)))
+}
+
+func TestNullableByte_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	for name, value := range map[string]option.NullableByte{
+		"some":  option.SomeNullableByte(12),
+		"null":  option.NullNullableByte(),
+		"unset": option.UnsetNullableByte(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, err := msgpack.Marshal(value)
+			require.NoError(t, err)
+
+			data, err := value.AppendMsgpack(nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestNullableByte_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.NullableByte `msgpack:"field,omitempty"`
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.SomeNullableByte(12)})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.NullNullableByte()})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.UnsetNullableByte()})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x80}, data)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+}
+
+func TestNullableByte_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.NullableByte `json:"field,omitzero"`
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.SomeNullableByte(12)})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.NullNullableByte()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"field":null}`, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.UnsetNullableByte()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.NullableByte
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "NullableByte", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSet())
+	})
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Bytes) Get() ([]byte, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Bytes) MustGet() []byte {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Bytes) UnwrapOrElse(defaultValue func() []byte) []byte {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Bytes) All() iter.Seq[[]byte] {
+	return func(yield func([]byte) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Bytess are None, or both contain equal values.
+func (o Bytes) Equal(other Bytes) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalBytes(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneBytes() is less than any value.
+func (o Bytes) Compare(other Bytes) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareBytes(o.value, other.value)
+	})
+}
+
+// Filter returns the Bytes itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneBytes().
+func (o Bytes) Filter(predicate func([]byte) bool) Bytes {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneBytes()
+}
+
+// Or returns the Bytes itself if it contains a value.
+// Otherwise, returns other.
+func (o Bytes) Or(other Bytes) Bytes {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Bytes itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Bytes) OrElse(fn func() Bytes) Bytes {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Bytes value using MessagePack format.
 // - If the value is present, it is encoded as []byte.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,6 +190,25 @@ func (o Bytes) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Bytes", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Bytes value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as []byte.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Bytes) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendBytes(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Bytes", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Bytes value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneBytes)
@@ -135,25 +220,192 @@ func (o Bytes) EncodeMsgpack(encoder *msgpack.Encoder) error {
 //   - on nil: exists = false, value = default zero value
 //   - on []byte: exists = true, value = decoded value
 func (o *Bytes) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Bytes", err)
+		return newDecodeMsgpackError("Bytes", "[]byte", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Bytes", decoder.Skip())
+		return newDecodeMsgpackError("Bytes", "[]byte", offset, decoder.Skip())
 	case checkBytes(code):
-		o.value, err = decodeBytes(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val []byte
+
+		val, err = decodeBytes(decoder)
 		if err != nil {
-			return newDecodeError("Bytes", err)
+			return newDecodeMsgpackError("Bytes", "[]byte", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Bytes", code)
+		return newDecodeWithCodeError("Bytes", "[]byte", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as []byte.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Bytes) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueBytes(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bytes", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneBytes)
+//   - []byte: interpreted as a present value (SomeBytes)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into []byte.
+func (o *Bytes) Scan(src any) error {
+	if src == nil {
+		o.value = zero[[]byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanBytes(src)
+	if err != nil {
+		return newDecodeError("Bytes", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Bytes value using JSON format.
+// - If the value is present, it is encoded as []byte.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Bytes) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bytes", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Bytes value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneBytes)
+//   - []byte: interpreted as a present value (SomeBytes)
+//
+// Returns an error if the input can't be decoded as []byte.
+func (o *Bytes) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[[]byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Bytes", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of []byte.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Bytes) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextBytes(o.value)
+	if err != nil {
+		return nil, newEncodeError("Bytes", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneBytes)
+//   - text representation of []byte: interpreted as a present value (SomeBytes)
+//
+// Returns an error if the text can't be parsed as []byte.
+func (o *Bytes) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[[]byte]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextBytes(text)
+	if err != nil {
+		return newDecodeError("Bytes", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Bytes.
+// The Bytes stays None until the flag is passed, the value is parsed
+// as text representation of []byte. Get of the returned flag.Getter returns the Bytes.
+//
+// Example:
+//
+//	var opt option.Bytes
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Bytes) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextBytes([]byte(text))
+			if err != nil {
+				return newDecodeError("Bytes", err)
+			}
+
+			*o = SomeBytes(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestBytes_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyBytes := option.NoneBytes()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyBytes.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestBytes_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestBytes_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeBytes([]byte{3, 14, 15}).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, []byte{3, 14, 15}, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneBytes().All()))
+	})
+}
+
+func TestBytes_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someBytes := option.SomeBytes([]byte{3, 14, 15})
+	otherBytes := option.SomeBytes([]byte{3, 14, 15, 9, 26})
+	emptyBytes := option.NoneBytes()
+
+	assert.True(t, someBytes.Equal(option.SomeBytes([]byte{3, 14, 15})))
+	assert.True(t, emptyBytes.Equal(option.NoneBytes()))
+	assert.False(t, someBytes.Equal(otherBytes))
+	assert.False(t, someBytes.Equal(emptyBytes))
+	assert.False(t, emptyBytes.Equal(someBytes))
+
+	assert.Zero(t, someBytes.Compare(option.SomeBytes([]byte{3, 14, 15})))
+	assert.Zero(t, emptyBytes.Compare(option.NoneBytes()))
+	assert.Equal(t, -otherBytes.Compare(someBytes), someBytes.Compare(otherBytes))
+	assert.NotZero(t, someBytes.Compare(otherBytes))
+	assert.Equal(t, 1, someBytes.Compare(emptyBytes))
+	assert.Equal(t, -1, emptyBytes.Compare(someBytes))
+}
+
+func TestBytes_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		assert.True(t, someBytes.Filter(func([]byte) bool { return true }).IsSome())
+		assert.False(t, someBytes.Filter(func([]byte) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		assert.False(t, emptyBytes.Filter(func([]byte) bool { return true }).IsSome())
+	})
+}
+
+func TestBytes_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		other := option.SomeBytes([]byte{3, 14, 15, 9, 26})
+		assert.EqualValues(t, []byte{3, 14, 15}, someBytes.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		other := option.SomeBytes([]byte{3, 14, 15, 9, 26})
+		assert.EqualValues(t, []byte{3, 14, 15, 9, 26}, emptyBytes.Or(other).Unwrap())
+	})
+}
+
+func TestBytes_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		assert.EqualValues(t, []byte{3, 14, 15}, someBytes.OrElse(func() option.Bytes {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		assert.EqualValues(t, []byte{3, 14, 15, 9, 26}, emptyBytes.OrElse(func() option.Bytes {
+			return option.SomeBytes([]byte{3, 14, 15, 9, 26})
+		}).Unwrap())
+	})
+}
+
 func TestBytes_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestBytes_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestBytes_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		require.NoError(t, someBytes.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someBytes.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneBytes().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestBytes_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		val, err := someBytes.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Bytes
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, []byte{3, 14, 15}, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		val, err := emptyBytes.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeBytes([]byte{3, 14, 15})
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestBytes_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		data, err := json.Marshal(someBytes)
+		require.NoError(t, err)
+
+		var unmarshaled option.Bytes
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, []byte{3, 14, 15}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		data, err := json.Marshal(emptyBytes)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeBytes([]byte{3, 14, 15})
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Bytes
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Bytes", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestBytes_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someBytes := option.SomeBytes([]byte{3, 14, 15})
+		data, err := someBytes.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Bytes
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, []byte{3, 14, 15}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyBytes := option.NoneBytes()
+		data, err := emptyBytes.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeBytes([]byte{3, 14, 15})
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestBytes_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeBytes([]byte{3, 14, 15}).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Bytes
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, []byte{3, 14, 15}, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Bytes
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeBytes() {
 	opt := option.SomeBytes([]byte{3, 14, 15})
 	if opt.IsSome() {
@@ -0,0 +1,298 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableBytes represents a nullable value of type []byte with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableBytes struct {
+	value []byte
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableBytes]()
+}
+
+// SomeNullableBytes creates a NullableBytes with the given []byte value.
+func SomeNullableBytes(value []byte) NullableBytes {
+	return NullableBytes{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableBytes creates a NullableBytes, that is explicitly set to null.
+func NullNullableBytes() NullableBytes {
+	return NullableBytes{
+		value: zero[[]byte](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableBytes creates a NullableBytes, that is not set.
+// It is equal to the zero value of NullableBytes.
+func UnsetNullableBytes() NullableBytes {
+	return NullableBytes{
+		value: zero[[]byte](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableBytes contains a value.
+func (o NullableBytes) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableBytes is explicitly set to null.
+func (o NullableBytes) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableBytes is either null or contains a value.
+func (o NullableBytes) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableBytes is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableBytes) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of []byte, false).
+func (o NullableBytes) Get() ([]byte, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableBytes) MustGet() []byte {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for []byte.
+func (o NullableBytes) Unwrap() []byte {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableBytes) UnwrapOr(defaultValue []byte) []byte {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableBytes) UnwrapOrElse(defaultValue func() []byte) []byte {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableBytes to Bytes. Both Null and Unset are converted to NoneBytes().
+func (o NullableBytes) Option() Bytes {
+	if o.state == nullableSome {
+		return SomeBytes(o.value)
+	}
+
+	return NoneBytes()
+}
+
+// Equal returns true if both NullableBytess have the same state and, if present, equal values.
+func (o NullableBytes) Equal(other NullableBytes) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalBytes(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableBytes) Compare(other NullableBytes) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareBytes(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableBytes value using MessagePack format.
+// - If the value is present, it is encoded as []byte.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableBytes) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableBytes", encodeBytes(encoder, o.value))
+	}
+
+	return newEncodeError("NullableBytes", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableBytes value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as []byte.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableBytes) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendBytes(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableBytes", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableBytes value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableBytes)
+//   - []byte: interpreted as a present value (SomeNullableBytes)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableBytes) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableBytes", "[]byte", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[[]byte]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableBytes", "[]byte", offset, decoder.Skip())
+	case checkBytes(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val []byte
+
+		val, err = decodeBytes(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableBytes", "[]byte", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableBytes", "[]byte", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableBytes value using JSON format.
+// - If the value is present, it is encoded as []byte.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableBytes) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableBytes", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableBytes value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableBytes)
+//   - []byte: interpreted as a present value (SomeNullableBytes)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableBytes) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[[]byte]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableBytes", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of []byte.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableBytes) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextBytes(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableBytes", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableBytes)
+//   - text representation of []byte: interpreted as a present value (SomeNullableBytes)
+func (o *NullableBytes) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[[]byte]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextBytes(text)
+	if err != nil {
+		return newDecodeError("NullableBytes", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
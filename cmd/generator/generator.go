@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"slices"
 	"text/template"
+	"unicode"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -25,10 +26,33 @@ var (
 
 type generatorDef struct {
 	Name        string
+	OptionName  string // Name of the optional type, if it differs from the capitalized Name.
 	Type        string
 	DecodeFunc  string
 	EncoderFunc string
 	CheckerFunc string
+	ScanFunc    string
+	ValueFunc   string
+	Imports     []string
+
+	// AppendFunc appends the MessagePack representation of the type to a byte slice.
+	AppendFunc string
+
+	// TextMarshalFunc and TextUnmarshalFunc convert the type to and from its text representation.
+	TextMarshalFunc   string
+	TextUnmarshalFunc string
+
+	// EqualFunc and CompareFunc compare values of the type.
+	EqualFunc   string
+	CompareFunc string
+
+	// StrictDecodeFunc is used to decode the type in DecodeModeStrict.
+	StrictDecodeFunc string
+
+	// LenientCheckerFunc and LenientDecodeFunc are used to decode alternative encodings
+	// of the type in DecodeModeLenient.
+	LenientCheckerFunc string
+	LenientDecodeFunc  string
 
 	TestingValues                []string
 	TestingValueOutputs          []string
@@ -50,7 +74,19 @@ func structToMap(def generatorDef) map[string]any {
 		"Type":        def.Name,
 		"DecodeFunc":  def.DecodeFunc,
 		"EncoderFunc": def.EncoderFunc,
+		"AppendFunc":  def.AppendFunc,
 		"CheckerFunc": def.CheckerFunc,
+		"ScanFunc":    def.ScanFunc,
+		"ValueFunc":   def.ValueFunc,
+
+		"TextMarshalFunc":   def.TextMarshalFunc,
+		"TextUnmarshalFunc": def.TextUnmarshalFunc,
+		"EqualFunc":         def.EqualFunc,
+		"CompareFunc":       def.CompareFunc,
+
+		"StrictDecodeFunc":   def.StrictDecodeFunc,
+		"LenientCheckerFunc": def.LenientCheckerFunc,
+		"LenientDecodeFunc":  def.LenientDecodeFunc,
 
 		"TestingValue":                 testingValue,
 		"TestingValueOutput":           testingValueOutput,
@@ -68,6 +104,16 @@ func structToMap(def generatorDef) map[string]any {
 		out["Type"] = def.Type
 	}
 
+	if def.OptionName != "" {
+		out["Name"] = def.OptionName
+	}
+
+	// Types declared in the option package must be qualified in tests, since they are placed in option_test.
+	out["TestType"] = out["Type"]
+	if typeName, _ := out["Type"].(string); typeName != "" && unicode.IsUpper(rune(typeName[0])) {
+		out["TestType"] = "option." + typeName
+	}
+
 	if def.UnexpectedTestingValueOutput != "" {
 		out["UnexpectedTestingValueOutput"] = def.UnexpectedTestingValueOutput
 	}
@@ -83,11 +129,21 @@ func zeroOutput[T any]() string {
 
 var defaultTypes = []generatorDef{
 	{
-		Name:        "byte",
-		Type:        "byte",
-		DecodeFunc:  "decodeByte",
-		EncoderFunc: "encodeByte",
-		CheckerFunc: "checkNumber",
+		Name:               "byte",
+		Type:               "byte",
+		DecodeFunc:         "decodeByte",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeByteLenient",
+		StrictDecodeFunc:   "decodeByteStrict",
+		EncoderFunc:        "encodeByte",
+		AppendFunc:         "appendByte",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanByte",
+		ValueFunc:          "valueByte",
+		TextMarshalFunc:    "marshalTextByte",
+		TextUnmarshalFunc:  "unmarshalTextByte",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -97,11 +153,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[byte](),
 	},
 	{
-		Name:        "int",
-		Type:        "int",
-		DecodeFunc:  "decodeInt",
-		EncoderFunc: "encodeInt",
-		CheckerFunc: "checkNumber",
+		Name:               "int",
+		Type:               "int",
+		DecodeFunc:         "decodeInt",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeIntLenient",
+		StrictDecodeFunc:   "decodeIntStrict",
+		EncoderFunc:        "encodeInt",
+		AppendFunc:         "appendInt",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanInt",
+		ValueFunc:          "valueInt",
+		TextMarshalFunc:    "marshalTextInt",
+		TextUnmarshalFunc:  "unmarshalTextInt",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -111,11 +177,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[int](),
 	},
 	{
-		Name:        "int8",
-		Type:        "int8",
-		DecodeFunc:  "decodeInt8",
-		EncoderFunc: "encodeInt8",
-		CheckerFunc: "checkNumber",
+		Name:               "int8",
+		Type:               "int8",
+		DecodeFunc:         "decodeInt8",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeInt8Lenient",
+		StrictDecodeFunc:   "decodeInt8Strict",
+		EncoderFunc:        "encodeInt8",
+		AppendFunc:         "appendInt8",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanInt8",
+		ValueFunc:          "valueInt8",
+		TextMarshalFunc:    "marshalTextInt8",
+		TextUnmarshalFunc:  "unmarshalTextInt8",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -125,11 +201,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[int8](),
 	},
 	{
-		Name:        "int16",
-		Type:        "int16",
-		DecodeFunc:  "decodeInt16",
-		EncoderFunc: "encodeInt16",
-		CheckerFunc: "checkNumber",
+		Name:               "int16",
+		Type:               "int16",
+		DecodeFunc:         "decodeInt16",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeInt16Lenient",
+		StrictDecodeFunc:   "decodeInt16Strict",
+		EncoderFunc:        "encodeInt16",
+		AppendFunc:         "appendInt16",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanInt16",
+		ValueFunc:          "valueInt16",
+		TextMarshalFunc:    "marshalTextInt16",
+		TextUnmarshalFunc:  "unmarshalTextInt16",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -139,11 +225,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[int16](),
 	},
 	{
-		Name:        "int32",
-		Type:        "int32",
-		DecodeFunc:  "decodeInt32",
-		EncoderFunc: "encodeInt32",
-		CheckerFunc: "checkNumber",
+		Name:               "int32",
+		Type:               "int32",
+		DecodeFunc:         "decodeInt32",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeInt32Lenient",
+		StrictDecodeFunc:   "decodeInt32Strict",
+		EncoderFunc:        "encodeInt32",
+		AppendFunc:         "appendInt32",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanInt32",
+		ValueFunc:          "valueInt32",
+		TextMarshalFunc:    "marshalTextInt32",
+		TextUnmarshalFunc:  "unmarshalTextInt32",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -153,11 +249,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[int32](),
 	},
 	{
-		Name:        "int64",
-		Type:        "int64",
-		DecodeFunc:  "decodeInt64",
-		EncoderFunc: "encodeInt64",
-		CheckerFunc: "checkNumber",
+		Name:               "int64",
+		Type:               "int64",
+		DecodeFunc:         "decodeInt64",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeInt64Lenient",
+		StrictDecodeFunc:   "decodeInt64Strict",
+		EncoderFunc:        "encodeInt64",
+		AppendFunc:         "appendInt64",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanInt64",
+		ValueFunc:          "valueInt64",
+		TextMarshalFunc:    "marshalTextInt64",
+		TextUnmarshalFunc:  "unmarshalTextInt64",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -167,11 +273,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[int64](),
 	},
 	{
-		Name:        "uint",
-		Type:        "uint",
-		DecodeFunc:  "decodeUint",
-		EncoderFunc: "encodeUint",
-		CheckerFunc: "checkNumber",
+		Name:               "uint",
+		Type:               "uint",
+		DecodeFunc:         "decodeUint",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeUintLenient",
+		StrictDecodeFunc:   "decodeUintStrict",
+		EncoderFunc:        "encodeUint",
+		AppendFunc:         "appendUint",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanUint",
+		ValueFunc:          "valueUint",
+		TextMarshalFunc:    "marshalTextUint",
+		TextUnmarshalFunc:  "unmarshalTextUint",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -181,11 +297,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[uint](),
 	},
 	{
-		Name:        "uint8",
-		Type:        "uint8",
-		DecodeFunc:  "decodeUint8",
-		EncoderFunc: "encodeUint8",
-		CheckerFunc: "checkNumber",
+		Name:               "uint8",
+		Type:               "uint8",
+		DecodeFunc:         "decodeUint8",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeUint8Lenient",
+		StrictDecodeFunc:   "decodeUint8Strict",
+		EncoderFunc:        "encodeUint8",
+		AppendFunc:         "appendUint8",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanUint8",
+		ValueFunc:          "valueUint8",
+		TextMarshalFunc:    "marshalTextUint8",
+		TextUnmarshalFunc:  "unmarshalTextUint8",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -195,11 +321,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[uint8](),
 	},
 	{
-		Name:        "uint16",
-		Type:        "uint16",
-		DecodeFunc:  "decodeUint16",
-		EncoderFunc: "encodeUint16",
-		CheckerFunc: "checkNumber",
+		Name:               "uint16",
+		Type:               "uint16",
+		DecodeFunc:         "decodeUint16",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeUint16Lenient",
+		StrictDecodeFunc:   "decodeUint16Strict",
+		EncoderFunc:        "encodeUint16",
+		AppendFunc:         "appendUint16",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanUint16",
+		ValueFunc:          "valueUint16",
+		TextMarshalFunc:    "marshalTextUint16",
+		TextUnmarshalFunc:  "unmarshalTextUint16",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -209,11 +345,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[uint16](),
 	},
 	{
-		Name:        "uint32",
-		Type:        "uint32",
-		DecodeFunc:  "decodeUint32",
-		EncoderFunc: "encodeUint32",
-		CheckerFunc: "checkNumber",
+		Name:               "uint32",
+		Type:               "uint32",
+		DecodeFunc:         "decodeUint32",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeUint32Lenient",
+		StrictDecodeFunc:   "decodeUint32Strict",
+		EncoderFunc:        "encodeUint32",
+		AppendFunc:         "appendUint32",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanUint32",
+		ValueFunc:          "valueUint32",
+		TextMarshalFunc:    "marshalTextUint32",
+		TextUnmarshalFunc:  "unmarshalTextUint32",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -223,11 +369,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[uint32](),
 	},
 	{
-		Name:        "uint64",
-		Type:        "uint64",
-		DecodeFunc:  "decodeUint64",
-		EncoderFunc: "encodeUint64",
-		CheckerFunc: "checkNumber",
+		Name:               "uint64",
+		Type:               "uint64",
+		DecodeFunc:         "decodeUint64",
+		LenientCheckerFunc: "checkNumberLenient",
+		LenientDecodeFunc:  "decodeUint64Lenient",
+		StrictDecodeFunc:   "decodeUint64Strict",
+		EncoderFunc:        "encodeUint64",
+		AppendFunc:         "appendUint64",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanUint64",
+		ValueFunc:          "valueUint64",
+		TextMarshalFunc:    "marshalTextUint64",
+		TextUnmarshalFunc:  "unmarshalTextUint64",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -237,11 +393,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[uint64](),
 	},
 	{
-		Name:        "float32",
-		Type:        "float32",
-		DecodeFunc:  "decodeFloat32",
-		EncoderFunc: "encodeFloat32",
-		CheckerFunc: "checkFloat",
+		Name:               "float32",
+		Type:               "float32",
+		DecodeFunc:         "decodeFloat32",
+		LenientCheckerFunc: "checkFloatLenient",
+		LenientDecodeFunc:  "decodeFloat32Lenient",
+		StrictDecodeFunc:   "decodeFloat32Strict",
+		EncoderFunc:        "encodeFloat32",
+		AppendFunc:         "appendFloat32",
+		CheckerFunc:        "checkFloat",
+		ScanFunc:           "scanFloat32",
+		ValueFunc:          "valueFloat32",
+		TextMarshalFunc:    "marshalTextFloat32",
+		TextUnmarshalFunc:  "unmarshalTextFloat32",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -251,11 +417,21 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[float32](),
 	},
 	{
-		Name:        "float64",
-		Type:        "float64",
-		DecodeFunc:  "decodeFloat64",
-		EncoderFunc: "encodeFloat64",
-		CheckerFunc: "checkFloat",
+		Name:               "float64",
+		Type:               "float64",
+		DecodeFunc:         "decodeFloat64",
+		LenientCheckerFunc: "checkFloatLenient",
+		LenientDecodeFunc:  "decodeFloat64Lenient",
+		StrictDecodeFunc:   "decodeFloat64Strict",
+		EncoderFunc:        "encodeFloat64",
+		AppendFunc:         "appendFloat64",
+		CheckerFunc:        "checkFloat",
+		ScanFunc:           "scanFloat64",
+		ValueFunc:          "valueFloat64",
+		TextMarshalFunc:    "marshalTextFloat64",
+		TextUnmarshalFunc:  "unmarshalTextFloat64",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"12"},
 		TestingValueOutputs:          []string{"12"},
@@ -265,11 +441,20 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[float64](),
 	},
 	{
-		Name:        "string",
-		Type:        "string",
-		DecodeFunc:  "decodeString",
-		EncoderFunc: "encodeString",
-		CheckerFunc: "checkString",
+		Name:               "string",
+		Type:               "string",
+		DecodeFunc:         "decodeString",
+		LenientCheckerFunc: "checkStringLenient",
+		LenientDecodeFunc:  "decodeStringLenient",
+		EncoderFunc:        "encodeString",
+		AppendFunc:         "appendString",
+		CheckerFunc:        "checkString",
+		ScanFunc:           "scanString",
+		ValueFunc:          "valueString",
+		TextMarshalFunc:    "marshalTextString",
+		TextUnmarshalFunc:  "unmarshalTextString",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
 
 		TestingValues:                []string{"\"hello\""},
 		TestingValueOutputs:          []string{"\"hello\""},
@@ -279,11 +464,18 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[string](),
 	},
 	{
-		Name:        "bytes",
-		Type:        "[]byte",
-		DecodeFunc:  "decodeBytes",
-		EncoderFunc: "encodeBytes",
-		CheckerFunc: "checkBytes",
+		Name:              "bytes",
+		Type:              "[]byte",
+		DecodeFunc:        "decodeBytes",
+		EncoderFunc:       "encodeBytes",
+		AppendFunc:        "appendBytes",
+		CheckerFunc:       "checkBytes",
+		ScanFunc:          "scanBytes",
+		ValueFunc:         "valueBytes",
+		TextMarshalFunc:   "marshalTextBytes",
+		TextUnmarshalFunc: "unmarshalTextBytes",
+		EqualFunc:         "equalBytes",
+		CompareFunc:       "compareBytes",
 
 		TestingValues:                []string{"[]byte{3, 14, 15}"},
 		TestingValueOutputs:          []string{"[]byte{3, 14, 15}"},
@@ -293,11 +485,20 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[[]byte](),
 	},
 	{
-		Name:        "bool",
-		Type:        "bool",
-		DecodeFunc:  "decodeBool",
-		EncoderFunc: "encodeBool",
-		CheckerFunc: "checkBool",
+		Name:               "bool",
+		Type:               "bool",
+		DecodeFunc:         "decodeBool",
+		LenientCheckerFunc: "checkBoolLenient",
+		LenientDecodeFunc:  "decodeBoolLenient",
+		EncoderFunc:        "encodeBool",
+		AppendFunc:         "appendBool",
+		CheckerFunc:        "checkBool",
+		ScanFunc:           "scanBool",
+		ValueFunc:          "valueBool",
+		TextMarshalFunc:    "marshalTextBool",
+		TextUnmarshalFunc:  "unmarshalTextBool",
+		EqualFunc:          "equalBool",
+		CompareFunc:        "compareBool",
 
 		TestingValues:                []string{"true"},
 		TestingValueOutputs:          []string{"true"},
@@ -307,11 +508,187 @@ var defaultTypes = []generatorDef{
 		ZeroTestingValueOutput:       zeroOutput[bool](),
 	},
 	{
-		Name:        "any",
-		Type:        "any",
-		DecodeFunc:  "decodeAny",
-		EncoderFunc: "encodeAny",
-		CheckerFunc: "checkAny",
+		Name:              "decimal",
+		Type:              "DecimalValue",
+		DecodeFunc:        "decodeDecimal",
+		EncoderFunc:       "encodeDecimal",
+		AppendFunc:        "appendDecimal",
+		CheckerFunc:       "checkExt",
+		ScanFunc:          "scanDecimal",
+		ValueFunc:         "valueDecimal",
+		TextMarshalFunc:   "marshalTextDecimal",
+		TextUnmarshalFunc: "unmarshalTextDecimal",
+		EqualFunc:         "equalDecimal",
+		CompareFunc:       "compareDecimal",
+
+		TestingValues: []string{
+			"option.MustParseDecimalValue(\"12.34\")",
+			"option.MustParseDecimalValue(\"-0.001\")",
+			"option.MustParseDecimalValue(\"1234567890123456789012345678901234567.8\")",
+		},
+		TestingValueOutputs: []string{
+			"option.MustParseDecimalValue(\"12.34\")",
+			"option.MustParseDecimalValue(\"-0.001\")",
+			"option.MustParseDecimalValue(\"1234567890123456789012345678901234567.8\")",
+		},
+		ExampleValueOutputs:          []string{"12.34"},
+		UnexpectedTestingValue:       "option.MustParseDecimalValue(\"56.78\")",
+		UnexpectedTestingValueOutput: "56.78",
+		ZeroTestingValueOutput:       "0",
+	},
+	{
+		Name:              "uuid",
+		OptionName:        "UUID",
+		Type:              "uuid.UUID",
+		DecodeFunc:        "decodeUUID",
+		EncoderFunc:       "encodeUUID",
+		AppendFunc:        "appendUUID",
+		CheckerFunc:       "checkExt",
+		ScanFunc:          "scanUUID",
+		ValueFunc:         "valueUUID",
+		TextMarshalFunc:   "marshalTextUUID",
+		TextUnmarshalFunc: "unmarshalTextUUID",
+		EqualFunc:         "equalUUID",
+		CompareFunc:       "compareUUID",
+		Imports:           []string{"github.com/google/uuid"},
+
+		TestingValues:                []string{"uuid.MustParse(\"c8f0fa1f-da29-438c-a040-393f1126ad39\")"},
+		TestingValueOutputs:          []string{"uuid.MustParse(\"c8f0fa1f-da29-438c-a040-393f1126ad39\")"},
+		ExampleValueOutputs:          []string{"c8f0fa1f-da29-438c-a040-393f1126ad39"},
+		UnexpectedTestingValue:       "uuid.MustParse(\"2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a\")",
+		UnexpectedTestingValueOutput: "2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a",
+		ZeroTestingValueOutput:       "00000000-0000-0000-0000-000000000000",
+	},
+	{
+		Name:              "datetime",
+		Type:              "time.Time",
+		DecodeFunc:        "decodeDatetime",
+		EncoderFunc:       "encodeDatetime",
+		AppendFunc:        "appendDatetime",
+		CheckerFunc:       "checkExt",
+		ScanFunc:          "scanDatetime",
+		ValueFunc:         "valueDatetime",
+		TextMarshalFunc:   "marshalTextTime",
+		TextUnmarshalFunc: "unmarshalTextTime",
+		EqualFunc:         "equalTime",
+		CompareFunc:       "compareTime",
+		Imports:           []string{"time"},
+
+		TestingValues: []string{
+			"time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)",
+			"time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC)",
+			"time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)",
+		},
+		TestingValueOutputs: []string{
+			"time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)",
+			"time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC)",
+			"time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)",
+		},
+		ExampleValueOutputs:          []string{"2025-12-02 10:30:00 +0000 UTC"},
+		UnexpectedTestingValue:       "time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)",
+		UnexpectedTestingValueOutput: "2000-01-01 00:00:00 +0000 UTC",
+		ZeroTestingValueOutput:       "0001-01-01 00:00:00 +0000 UTC",
+	},
+	{
+		Name:              "interval",
+		Type:              "IntervalValue",
+		DecodeFunc:        "decodeInterval",
+		EncoderFunc:       "encodeInterval",
+		AppendFunc:        "appendInterval",
+		CheckerFunc:       "checkExt",
+		ScanFunc:          "scanInterval",
+		ValueFunc:         "valueInterval",
+		TextMarshalFunc:   "marshalTextInterval",
+		TextUnmarshalFunc: "unmarshalTextInterval",
+		EqualFunc:         "equalInterval",
+		CompareFunc:       "compareInterval",
+
+		TestingValues: []string{
+			"option.IntervalValue{Year: 1, Month: -2, Hour: 3}",
+			"option.IntervalValue{Nsec: 1000, Adjust: option.IntervalAdjustLast}",
+			"option.IntervalValue{}",
+		},
+		TestingValueOutputs: []string{
+			"option.IntervalValue{Year: 1, Month: -2, Hour: 3}",
+			"option.IntervalValue{Nsec: 1000, Adjust: option.IntervalAdjustLast}",
+			"option.IntervalValue{}",
+		},
+		ExampleValueOutputs:          []string{"+1 years, -2 months, +3 hours"},
+		UnexpectedTestingValue:       "option.IntervalValue{Day: 7}",
+		UnexpectedTestingValueOutput: "+7 days",
+		ZeroTestingValueOutput:       "0 seconds",
+	},
+	{
+		Name:               "time",
+		Type:               "time.Time",
+		DecodeFunc:         "decodeTime",
+		EncoderFunc:        "encodeTime",
+		AppendFunc:         "appendTime",
+		CheckerFunc:        "checkExt",
+		ScanFunc:           "scanDatetime",
+		ValueFunc:          "valueDatetime",
+		TextMarshalFunc:    "marshalTextTime",
+		TextUnmarshalFunc:  "unmarshalTextTime",
+		EqualFunc:          "equalTime",
+		CompareFunc:        "compareTime",
+		LenientCheckerFunc: "checkTimeLenient",
+		LenientDecodeFunc:  "decodeTimeLenient",
+		Imports:            []string{"time"},
+
+		TestingValues: []string{
+			"time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)",
+			"time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC)",
+			"time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)",
+			"time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC)",
+		},
+		TestingValueOutputs: []string{
+			"time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)",
+			"time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC)",
+			"time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC)",
+			"time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC)",
+		},
+		ExampleValueOutputs:          []string{"2025-12-02 10:30:00 +0000 UTC"},
+		UnexpectedTestingValue:       "time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)",
+		UnexpectedTestingValueOutput: "2000-01-01 00:00:00 +0000 UTC",
+		ZeroTestingValueOutput:       "0001-01-01 00:00:00 +0000 UTC",
+	},
+	{
+		Name:               "duration",
+		Type:               "time.Duration",
+		DecodeFunc:         "decodeDuration",
+		EncoderFunc:        "encodeDuration",
+		AppendFunc:         "appendDuration",
+		CheckerFunc:        "checkNumber",
+		ScanFunc:           "scanDuration",
+		ValueFunc:          "valueDuration",
+		TextMarshalFunc:    "marshalTextDuration",
+		TextUnmarshalFunc:  "unmarshalTextDuration",
+		EqualFunc:          "equalOrdered",
+		CompareFunc:        "compareOrdered",
+		LenientCheckerFunc: "checkDurationLenient",
+		LenientDecodeFunc:  "decodeDurationLenient",
+		Imports:            []string{"time"},
+
+		TestingValues:                []string{"90 * time.Second", "-1500 * time.Microsecond", "time.Duration(1<<63 - 1)"},
+		TestingValueOutputs:          []string{"90 * time.Second", "-1500 * time.Microsecond", "time.Duration(1<<63 - 1)"},
+		ExampleValueOutputs:          []string{"1m30s"},
+		UnexpectedTestingValue:       "time.Hour",
+		UnexpectedTestingValueOutput: "1h0m0s",
+		ZeroTestingValueOutput:       "0s",
+	},
+	{
+		Name:              "any",
+		Type:              "any",
+		DecodeFunc:        "decodeAny",
+		EncoderFunc:       "encodeAny",
+		AppendFunc:        "appendAny",
+		CheckerFunc:       "checkAny",
+		ScanFunc:          "scanAny",
+		ValueFunc:         "valueAny",
+		TextMarshalFunc:   "marshalTextAny",
+		TextUnmarshalFunc: "unmarshalTextAny",
+		EqualFunc:         "equalAny",
+		CompareFunc:       "compareAny",
 
 		TestingValues:                []string{"\"hello\"", "123", "true", "123.456"},
 		TestingValueOutputs:          []string{"\"hello\"", "123", "true", "123.456"},
@@ -332,6 +709,10 @@ import (
 	"{{ $import }}"
 	{{ end }}
 
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -401,10 +782,10 @@ func (o {{.Name}}) Get() ({{.Type}}, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o {{.Name}}) MustGet() {{.Type}} {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -440,6 +821,68 @@ func (o {{.Name}}) UnwrapOrElse(defaultValue func() {{.Type}}) {{.Type}} {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o {{.Name}}) All() iter.Seq[{{.Type}}] {
+	return func(yield func({{.Type}}) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both {{.Name}}s are None, or both contain equal values.
+func (o {{.Name}}) Equal(other {{.Name}}) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || {{ .EqualFunc }}(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// None{{.Name}}() is less than any value.
+func (o {{.Name}}) Compare(other {{.Name}}) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return {{ .CompareFunc }}(o.value, other.value)
+	})
+}
+
+// Filter returns the {{.Name}} itself if it contains a value, that satisfies predicate.
+// Otherwise, returns None{{.Name}}().
+func (o {{.Name}}) Filter(predicate func({{.Type}}) bool) {{.Name}} {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return None{{.Name}}()
+}
+
+// Or returns the {{.Name}} itself if it contains a value.
+// Otherwise, returns other.
+func (o {{.Name}}) Or(other {{.Name}}) {{.Name}} {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the {{.Name}} itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o {{.Name}}) OrElse(fn func() {{.Name}}) {{.Name}} {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the {{.Name}} value using MessagePack format.
 // - If the value is present, it is encoded as {{.Type}}.
 // - If the value is absent (None), it is encoded as nil.
@@ -453,10 +896,37 @@ func (o {{.Name}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("{{.Name}}", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the {{.Name}} value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o {{.Name}}) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := {{ .AppendFunc }}(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("{{.Name}}", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a {{.Name}} value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (None{{.Name}})
 //   - {{.Type}}: interpreted as a present value (Some{{.Name}})
+{{- if .LenientDecodeFunc }}
+//
+// In DecodeModeLenient alternative encodings of {{.Type}} are accepted as well.
+{{- end }}
+{{- if .StrictDecodeFunc }}
+//
+// In DecodeModeStrict values, that can't be represented as {{.Type}} exactly, are rejected.
+{{- end }}
 //
 // Returns an error if the input type is unsupported or decoding fails.
 //
@@ -464,26 +934,220 @@ func (o {{.Name}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
 //   - on nil: exists = false, value = default zero value
 //   - on {{.Type}}: exists = true, value = decoded value
 func (o *{{.Name}}) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("{{.Name}}", err)
+		return newDecodeMsgpackError("{{.Name}}", "{{.Type}}", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("{{.Name}}", decoder.Skip())
+		return newDecodeMsgpackError("{{.Name}}", "{{.Type}}", offset, decoder.Skip())
 	case {{ .CheckerFunc }}(code):
-		o.value, err = {{ .DecodeFunc }}(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val {{.Type}}
+		{{- if .StrictDecodeFunc }}
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = {{ .StrictDecodeFunc }}(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("{{.Name}}", "{{.Type}}", offset, code, err)
+			}
+		} else {
+			val, err = {{ .DecodeFunc }}(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("{{.Name}}", "{{.Type}}", offset, err)
+			}
+		}
+		{{- else }}
+
+		val, err = {{ .DecodeFunc }}(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("{{.Name}}", "{{.Type}}", offset, err)
+		}
+		{{- end }}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	{{- if .LenientDecodeFunc }}
+	case {{ .LenientCheckerFunc }}(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := {{ .LenientDecodeFunc }}(decoder)
 		if err != nil {
-			return newDecodeError("{{.Name}}", err)
+			return newDecodeMsgpackError("{{.Name}}", "{{.Type}}", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
+	{{- end }}
 	default:
-		return newDecodeWithCodeError("{{.Name}}", code)
+		return newDecodeWithCodeError("{{.Name}}", "{{.Type}}", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as {{.Type}}.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o {{.Name}}) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := {{ .ValueFunc }}(o.value)
+	if err != nil {
+		return nil, newEncodeError("{{.Name}}", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (None{{.Name}})
+//   - {{.Type}}: interpreted as a present value (Some{{.Name}})
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into {{.Type}}.
+func (o *{{.Name}}) Scan(src any) error {
+	if src == nil {
+		o.value = zero[{{.Type}}]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := {{ .ScanFunc }}(src)
+	if err != nil {
+		return newDecodeError("{{.Name}}", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the {{.Name}} value using JSON format.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o {{.Name}}) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("{{.Name}}", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a {{.Name}} value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (None{{.Name}})
+//   - {{.Type}}: interpreted as a present value (Some{{.Name}})
+//
+// Returns an error if the input can't be decoded as {{.Type}}.
+func (o *{{.Name}}) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[{{.Type}}]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("{{.Name}}", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of {{.Type}}.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o {{.Name}}) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := {{ .TextMarshalFunc }}(o.value)
+	if err != nil {
+		return nil, newEncodeError("{{.Name}}", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (None{{.Name}})
+//   - text representation of {{.Type}}: interpreted as a present value (Some{{.Name}})
+//
+// Returns an error if the text can't be parsed as {{.Type}}.
+func (o *{{.Name}}) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[{{.Type}}]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := {{ .TextUnmarshalFunc }}(text)
+	if err != nil {
+		return newDecodeError("{{.Name}}", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the {{.Name}}.
+// The {{.Name}} stays None until the flag is passed, the value is parsed
+// as text representation of {{.Type}}. Get of the returned flag.Getter returns the {{.Name}}.
+//
+// Example:
+//
+//	var opt option.{{.Name}}
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *{{.Name}}) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := {{ .TextUnmarshalFunc }}([]byte(text))
+			if err != nil {
+				return newDecodeError("{{.Name}}", err)
+			}
+
+			*o = Some{{.Name}}(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: {{ eq .Type "bool" }},
 	}
 }`
 
@@ -498,12 +1162,17 @@ import (
 	{{ end }}
 
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -597,7 +1266,7 @@ func Test{{.Name}}_MustGet(t *testing.T) {
 		t.Parallel()
 
 		empty{{.Name}} := option.None{{.Name}}()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			empty{{.Name}}.MustGet()
 		})
 	})
@@ -648,7 +1317,7 @@ func Test{{.Name}}_UnwrapOrElse(t *testing.T) {
 		t.Parallel()
 
 		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.UnwrapOrElse(func() {{.Type}} {
+		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.UnwrapOrElse(func() {{.TestType}} {
 			return {{.UnexpectedTestingValue}}
 		}))
 	})
@@ -657,12 +1326,112 @@ func Test{{.Name}}_UnwrapOrElse(t *testing.T) {
 		t.Parallel()
 
 		empty{{.Name}} := option.None{{.Name}}()
-		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.UnwrapOrElse(func() {{.Type}} {
+		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.UnwrapOrElse(func() {{.TestType}} {
 			return {{.UnexpectedTestingValue}}
 		}))
 	})
 }
 
+func Test{{.Name}}_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.Some{{.Name}}({{.TestingValue}}).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, {{.TestingValue}}, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.None{{.Name}}().All()))
+	})
+}
+
+func Test{{.Name}}_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+	other{{.Name}} := option.Some{{.Name}}({{.UnexpectedTestingValue}})
+	empty{{.Name}} := option.None{{.Name}}()
+
+	assert.True(t, some{{.Name}}.Equal(option.Some{{.Name}}({{.TestingValue}})))
+	assert.True(t, empty{{.Name}}.Equal(option.None{{.Name}}()))
+	assert.False(t, some{{.Name}}.Equal(other{{.Name}}))
+	assert.False(t, some{{.Name}}.Equal(empty{{.Name}}))
+	assert.False(t, empty{{.Name}}.Equal(some{{.Name}}))
+
+	assert.Zero(t, some{{.Name}}.Compare(option.Some{{.Name}}({{.TestingValue}})))
+	assert.Zero(t, empty{{.Name}}.Compare(option.None{{.Name}}()))
+	assert.Equal(t, -other{{.Name}}.Compare(some{{.Name}}), some{{.Name}}.Compare(other{{.Name}}))
+	assert.NotZero(t, some{{.Name}}.Compare(other{{.Name}}))
+	assert.Equal(t, 1, some{{.Name}}.Compare(empty{{.Name}}))
+	assert.Equal(t, -1, empty{{.Name}}.Compare(some{{.Name}}))
+}
+
+func Test{{.Name}}_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		assert.True(t, some{{.Name}}.Filter(func({{.TestType}}) bool { return true }).IsSome())
+		assert.False(t, some{{.Name}}.Filter(func({{.TestType}}) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		assert.False(t, empty{{.Name}}.Filter(func({{.TestType}}) bool { return true }).IsSome())
+	})
+}
+
+func Test{{.Name}}_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		other := option.Some{{.Name}}({{.UnexpectedTestingValue}})
+		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		other := option.Some{{.Name}}({{.UnexpectedTestingValue}})
+		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.Or(other).Unwrap())
+	})
+}
+
+func Test{{.Name}}_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.OrElse(func() option.{{.Name}} {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.OrElse(func() option.{{.Name}} {
+			return option.Some{{.Name}}({{.UnexpectedTestingValue}})
+		}).Unwrap())
+	})
+}
+
 func Test{{.Name}}_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -714,33 +1483,210 @@ func Test{{.Name}}_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
-func ExampleSome{{.Name}}() {
-	opt := option.Some{{.Name}}({{.TestingValue}})
-	if opt.IsSome() {
-		fmt.Println(opt.Unwrap())
-	}
-	// Output: {{.ExampleValueOutput}}
-}
+func Test{{.Name}}_AppendMsgpack(t *testing.T) {
+	t.Parallel()
 
-func ExampleNone{{.Name}}() {
-	opt := option.None{{.Name}}()
-	if opt.IsZero() {
-		fmt.Println("value is absent")
-	}
-	// Output: value is absent
-}
+	prefix := []byte{0x93}
 
-func Example{{.Name}}_IsSome() {
-	some := option.Some{{.Name}}({{.TestingValue}})
-	none := option.None{{.Name}}()
-	fmt.Println(some.IsSome())
-	fmt.Println(none.IsSome())
-	// Output:
-	// true
-	// false
-}
+	{{ range $i, $value := .TestingValues }}
 
-func Example{{.Name}}_IsZero() {
+	{{ if (eq $i 0) }}
+	t.Run("some", func(t *testing.T) {
+	{{ else }}
+	t.Run("some_{{ $i }}", func(t *testing.T) {
+	{{ end -}}
+
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		some{{$.Name}} := option.Some{{$.Name}}({{ $value }})
+		require.NoError(t, some{{$.Name}}.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := some{{$.Name}}.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+	{{ end }}
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.None{{.Name}}().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func Test{{.Name}}_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		val, err := some{{.Name}}.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.{{.Name}}
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, {{.TestingValue}}, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		val, err := empty{{.Name}}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.Some{{.Name}}({{.TestingValue}})
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func Test{{.Name}}_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		data, err := json.Marshal(some{{.Name}})
+		require.NoError(t, err)
+
+		var unmarshaled option.{{.Name}}
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, {{.TestingValue}}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		data, err := json.Marshal(empty{{.Name}})
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.Some{{.Name}}({{.TestingValue}})
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.{{.Name}}
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "{{.Name}}", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func Test{{.Name}}_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		data, err := some{{.Name}}.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.{{.Name}}
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, {{.TestingValue}}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		empty{{.Name}} := option.None{{.Name}}()
+		data, err := empty{{.Name}}.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.Some{{.Name}}({{.TestingValue}})
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func Test{{.Name}}_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.Some{{.Name}}({{.TestingValue}}).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.{{.Name}}
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, {{.TestingValue}}, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.{{.Name}}
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSome{{.Name}}() {
+	opt := option.Some{{.Name}}({{.TestingValue}})
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: {{.ExampleValueOutput}}
+}
+
+func ExampleNone{{.Name}}() {
+	opt := option.None{{.Name}}()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func Example{{.Name}}_IsSome() {
+	some := option.Some{{.Name}}({{.TestingValue}})
+	none := option.None{{.Name}}()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func Example{{.Name}}_IsZero() {
 	some := option.Some{{.Name}}({{.TestingValue}})
 	none := option.None{{.Name}}()
 	fmt.Println(some.IsZero())
@@ -814,10 +1760,10 @@ func Example{{.Name}}_UnwrapOr() {
 func Example{{.Name}}_UnwrapOrElse() {
 	some := option.Some{{.Name}}({{.TestingValue}})
 	none := option.None{{.Name}}()
-	fmt.Println(some.UnwrapOrElse(func() {{.Type}} {
+	fmt.Println(some.UnwrapOrElse(func() {{.TestType}} {
 		return {{.UnexpectedTestingValue}}
 	}))
-	fmt.Println(none.UnwrapOrElse(func() {{.Type}} {
+	fmt.Println(none.UnwrapOrElse(func() {{.TestType}} {
 		return {{.UnexpectedTestingValue}}
 	}))
 	// Output:
@@ -826,6 +1772,570 @@ func Example{{.Name}}_UnwrapOrElse() {
 }
 `
 
+var tplNullableText = `
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package {{ .packageName }}
+
+import (
+	{{ range $i, $import := .imports }}
+	"{{ $import }}"
+	{{ end }}
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Nullable{{.Name}} represents a nullable value of type {{.Type}} with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack ` + "`omitempty`" + ` tag and by the encoding/json ` + "`omitzero`" + ` tag,
+// while Null values are encoded as nil (null).
+type Nullable{{.Name}} struct {
+	value {{.Type}}
+	state nullableState
+}
+
+func init() {
+	registerNullable[Nullable{{.Name}}]()
+}
+
+// SomeNullable{{.Name}} creates a Nullable{{.Name}} with the given {{.Type}} value.
+func SomeNullable{{.Name}}(value {{.Type}}) Nullable{{.Name}} {
+	return Nullable{{.Name}}{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullable{{.Name}} creates a Nullable{{.Name}}, that is explicitly set to null.
+func NullNullable{{.Name}}() Nullable{{.Name}} {
+	return Nullable{{.Name}}{
+		value: zero[{{.Type}}](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullable{{.Name}} creates a Nullable{{.Name}}, that is not set.
+// It is equal to the zero value of Nullable{{.Name}}.
+func UnsetNullable{{.Name}}() Nullable{{.Name}} {
+	return Nullable{{.Name}}{
+		value: zero[{{.Type}}](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the Nullable{{.Name}} contains a value.
+func (o Nullable{{.Name}}) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the Nullable{{.Name}} is explicitly set to null.
+func (o Nullable{{.Name}}) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the Nullable{{.Name}} is either null or contains a value.
+func (o Nullable{{.Name}}) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the Nullable{{.Name}} is not set.
+//
+// This method is used by msgpack ` + "`omitempty`" + ` and encoding/json ` + "`omitzero`" + ` tags
+// to skip Unset values.
+func (o Nullable{{.Name}}) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of {{.Type}}, false).
+func (o Nullable{{.Name}}) Get() ({{.Type}}, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o Nullable{{.Name}}) MustGet() {{.Type}} {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for {{.Type}}.
+func (o Nullable{{.Name}}) Unwrap() {{.Type}} {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Nullable{{.Name}}) UnwrapOr(defaultValue {{.Type}}) {{.Type}} {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o Nullable{{.Name}}) UnwrapOrElse(defaultValue func() {{.Type}}) {{.Type}} {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the Nullable{{.Name}} to {{.Name}}. Both Null and Unset are converted to None{{.Name}}().
+func (o Nullable{{.Name}}) Option() {{.Name}} {
+	if o.state == nullableSome {
+		return Some{{.Name}}(o.value)
+	}
+
+	return None{{.Name}}()
+}
+
+// Equal returns true if both Nullable{{.Name}}s have the same state and, if present, equal values.
+func (o Nullable{{.Name}}) Equal(other Nullable{{.Name}}) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || {{ .EqualFunc }}(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o Nullable{{.Name}}) Compare(other Nullable{{.Name}}) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return {{ .CompareFunc }}(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the Nullable{{.Name}} value using MessagePack format.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the ` + "`omitempty`" + ` tag to skip Unset values.
+func (o Nullable{{.Name}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("Nullable{{.Name}}", {{ .EncoderFunc }}(encoder, o.value))
+	}
+
+	return newEncodeError("Nullable{{.Name}}", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Nullable{{.Name}} value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Nullable{{.Name}}) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := {{ .AppendFunc }}(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Nullable{{.Name}}", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Nullable{{.Name}} value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullable{{.Name}})
+//   - {{.Type}}: interpreted as a present value (SomeNullable{{.Name}})
+{{- if .LenientDecodeFunc }}
+//
+// In DecodeModeLenient alternative encodings of {{.Type}} are accepted as well.
+{{- end }}
+{{- if .StrictDecodeFunc }}
+//
+// In DecodeModeStrict values, that can't be represented as {{.Type}} exactly, are rejected.
+{{- end }}
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *Nullable{{.Name}}) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Nullable{{.Name}}", "{{.Type}}", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[{{.Type}}]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("Nullable{{.Name}}", "{{.Type}}", offset, decoder.Skip())
+	case {{ .CheckerFunc }}(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val {{.Type}}
+		{{- if .StrictDecodeFunc }}
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = {{ .StrictDecodeFunc }}(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Nullable{{.Name}}", "{{.Type}}", offset, code, err)
+			}
+		} else {
+			val, err = {{ .DecodeFunc }}(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Nullable{{.Name}}", "{{.Type}}", offset, err)
+			}
+		}
+		{{- else }}
+
+		val, err = {{ .DecodeFunc }}(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Nullable{{.Name}}", "{{.Type}}", offset, err)
+		}
+		{{- end }}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	{{- if .LenientDecodeFunc }}
+	case {{ .LenientCheckerFunc }}(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := {{ .LenientDecodeFunc }}(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Nullable{{.Name}}", "{{.Type}}", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	{{- end }}
+	default:
+		return newDecodeWithCodeError("Nullable{{.Name}}", "{{.Type}}", offset, code)
+	}
+}
+
+// MarshalJSON encodes the Nullable{{.Name}} value using JSON format.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the ` + "`omitzero`" + ` tag to skip Unset values.
+func (o Nullable{{.Name}}) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Nullable{{.Name}}", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Nullable{{.Name}} value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullable{{.Name}})
+//   - {{.Type}}: interpreted as a present value (SomeNullable{{.Name}})
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *Nullable{{.Name}}) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[{{.Type}}]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Nullable{{.Name}}", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of {{.Type}}.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o Nullable{{.Name}}) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := {{ .TextMarshalFunc }}(o.value)
+	if err != nil {
+		return nil, newEncodeError("Nullable{{.Name}}", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullable{{.Name}})
+//   - text representation of {{.Type}}: interpreted as a present value (SomeNullable{{.Name}})
+func (o *Nullable{{.Name}}) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[{{.Type}}]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := {{ .TextUnmarshalFunc }}(text)
+	if err != nil {
+		return newDecodeError("Nullable{{.Name}}", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}`
+
+var tplNullableTestText = `
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package {{ .packageName }}_test
+
+import (
+	{{ range $i, $import := .imports }}
+	"{{ $import }}"
+	{{ end }}
+
+	"cmp"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestNullable{{.Name}}_State(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullable{{.Name}}({{.TestingValue}})
+		assert.True(t, some.IsSome())
+		assert.False(t, some.IsNull())
+		assert.True(t, some.IsSet())
+		assert.False(t, some.IsZero())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullable{{.Name}}()
+		assert.False(t, null.IsSome())
+		assert.True(t, null.IsNull())
+		assert.True(t, null.IsSet())
+		assert.False(t, null.IsZero())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		unset := option.UnsetNullable{{.Name}}()
+		assert.False(t, unset.IsSome())
+		assert.False(t, unset.IsNull())
+		assert.False(t, unset.IsSet())
+		assert.True(t, unset.IsZero())
+		assert.Equal(t, option.Nullable{{.Name}}{}, unset)
+	})
+}
+
+func TestNullable{{.Name}}_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullable{{.Name}}({{.TestingValue}})
+		val, ok := some.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, {{.TestingValue}}, val)
+		assert.EqualValues(t, {{.TestingValue}}, some.MustGet())
+		assert.EqualValues(t, {{.TestingValue}}, some.UnwrapOr({{.UnexpectedTestingValue}}))
+		assert.True(t, some.Option().IsSome())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullable{{.Name}}()
+		_, ok := null.Get()
+		require.False(t, ok)
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			null.MustGet()
+		})
+		assert.EqualValues(t, {{.UnexpectedTestingValue}}, null.UnwrapOr({{.UnexpectedTestingValue}}))
+		assert.False(t, null.Option().IsSome())
+	})
+}
+
+func TestNullable{{.Name}}_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	ordered := []option.Nullable{{.Name}}{
+		option.UnsetNullable{{.Name}}(),
+		option.NullNullable{{.Name}}(),
+		option.SomeNullable{{.Name}}({{.TestingValue}}),
+	}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			assert.Equal(t, i == j, a.Equal(b))
+			assert.Equal(t, cmp.Compare(i, j), a.Compare(b))
+		}
+	}
+
+	assert.False(t, ordered[2].Equal(option.SomeNullable{{.Name}}({{.UnexpectedTestingValue}})))
+}
+
+func TestNullable{{.Name}}_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	for name, value := range map[string]option.Nullable{{.Name}}{
+		"some":  option.SomeNullable{{.Name}}({{.TestingValue}}),
+		"null":  option.NullNullable{{.Name}}(),
+		"unset": option.UnsetNullable{{.Name}}(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, err := msgpack.Marshal(value)
+			require.NoError(t, err)
+
+			data, err := value.AppendMsgpack(nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestNullable{{.Name}}_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.Nullable{{.Name}} ` + "`msgpack:\"field,omitempty\"`" + `
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.SomeNullable{{.Name}}({{.TestingValue}})})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, {{.TestingValueOutput}}, unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.NullNullable{{.Name}}()})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.UnsetNullable{{.Name}}()})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x80}, data)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+}
+
+func TestNullable{{.Name}}_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.Nullable{{.Name}} ` + "`json:\"field,omitzero\"`" + `
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.SomeNullable{{.Name}}({{.TestingValue}})})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, {{.TestingValue}}, unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.NullNullable{{.Name}}()})
+		require.NoError(t, err)
+		assert.JSONEq(t, ` + "`{\"field\":null}`" + `, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.UnsetNullable{{.Name}}()})
+		require.NoError(t, err)
+		assert.JSONEq(t, ` + "`{}`" + `, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Nullable{{.Name}}
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Nullable{{.Name}}", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSet())
+	})
+}
+`
+
 func printFile(prefix string, data []byte) {
 	for lineNo, line := range bytes.Split(data, []byte("\n")) {
 		fmt.Printf("%03d%s%s\n", lineNo, prefix, string(line))
@@ -843,7 +2353,17 @@ func generateAndWrite() error {
 		return fmt.Errorf("failed to parse testing template: %w", err)
 	}
 
-	outputData := make(map[string][]byte, 2*len(defaultTypes)) //nolint:mnd
+	tmplNullable, err := template.New("nullable").Parse(tplNullableText)
+	if err != nil {
+		return fmt.Errorf("failed to parse nullable template: %w", err)
+	}
+
+	tmplNullableTest, err := template.New("nullable_test").Parse(tplNullableTestText)
+	if err != nil {
+		return fmt.Errorf("failed to parse nullable testing template: %w", err)
+	}
+
+	outputData := make(map[string][]byte, 4*len(defaultTypes)) //nolint:mnd
 
 	var data bytes.Buffer
 
@@ -852,7 +2372,7 @@ func generateAndWrite() error {
 		tmplData := structToMap(generatedType)
 
 		tmplData["packageName"] = "option" // Package name is option, since generator is used for option only right now.
-		tmplData["imports"] = []string{}   // No additional imports are needed right now.
+		tmplData["imports"] = generatedType.Imports
 
 		// Generate code of an Optional type.
 		{
@@ -875,6 +2395,28 @@ func generateAndWrite() error {
 			outputData[generatedType.Name+"_gen_test.go"] = slices.Clone(data.Bytes())
 			data.Reset()
 		}
+
+		// Generate code of a Nullable type.
+		{
+			err := tmplNullable.Execute(&data, tmplData)
+			if err != nil {
+				return fmt.Errorf("failed to execute nullable template: %w", err)
+			}
+
+			outputData[generatedType.Name+"_nullable_gen.go"] = slices.Clone(data.Bytes())
+			data.Reset()
+		}
+
+		// Generate code for tests of a Nullable type.
+		{
+			err := tmplNullableTest.Execute(&data, tmplData)
+			if err != nil {
+				return fmt.Errorf("failed to execute nullable test template: %w", err)
+			}
+
+			outputData[generatedType.Name+"_nullable_gen_test.go"] = slices.Clone(data.Bytes())
+			data.Reset()
+		}
 	}
 
 	// 2. Just in case format code using gofmt.
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	errExtCodeNotSet    = errors.New("extension code is not set")
+	errInvalidExtCode   = errors.New("extension code must be in range [-128, 127]")
+	errDuplicateExtCode = errors.New("duplicate extension code")
+	errDuplicateType    = errors.New("duplicate type name")
+	errExtCodeInPlain   = errors.New("extension code is not used in plain mode")
+	errUnknownTestType  = errors.New("test constructor is set for unknown type")
+	errTestTypeNotSet   = errors.New("test constructor must be set as Type=Func for several types")
+)
+
+// typeArg is a type to generate optional to, passed as a positional argument.
+type typeArg struct {
+	Name    string
+	ExtCode int
+}
+
+// parseTypeArgs parses positional arguments of form "Name" or "Name=code".
+// Types without an explicit code get sequential codes starting from firstExtCode.
+func parseTypeArgs(args []string, firstExtCode int) ([]typeArg, error) {
+	out := make([]typeArg, 0, len(args))
+	nextExtCode := firstExtCode
+
+	typeNames := make(map[string]struct{}, len(args))
+	extCodes := make(map[int]string, len(args))
+
+	for _, arg := range args {
+		name, codeStr, hasCode := strings.Cut(arg, "=")
+
+		var code int
+
+		switch {
+		case hasCode:
+			parsedCode, err := strconv.Atoi(codeStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid extension code for type %s: %w", name, err)
+			}
+
+			code = parsedCode
+		case nextExtCode == undefinedExtCode:
+			return nil, fmt.Errorf("%w for type %s", errExtCodeNotSet, name)
+		default:
+			code = nextExtCode
+			nextExtCode++
+		}
+
+		if !checkMsgpackExtCode(code) {
+			return nil, fmt.Errorf("%w: %d for type %s", errInvalidExtCode, code, name)
+		}
+
+		if _, ok := typeNames[name]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateType, name)
+		}
+
+		if other, ok := extCodes[code]; ok {
+			return nil, fmt.Errorf("%w %d for types %s and %s", errDuplicateExtCode, code, other, name)
+		}
+
+		typeNames[name] = struct{}{}
+		extCodes[code] = name
+
+		out = append(out, typeArg{Name: name, ExtCode: code})
+	}
+
+	return out, nil
+}
+
+// parsePlainTypeArgs parses positional arguments for the plain mode, where types
+// have no extension codes.
+func parsePlainTypeArgs(args []string) ([]typeArg, error) {
+	out := make([]typeArg, 0, len(args))
+	typeNames := make(map[string]struct{}, len(args))
+
+	for _, arg := range args {
+		if strings.Contains(arg, "=") {
+			return nil, fmt.Errorf("%w: %s", errExtCodeInPlain, arg)
+		}
+
+		if _, ok := typeNames[arg]; ok {
+			return nil, fmt.Errorf("%w: %s", errDuplicateType, arg)
+		}
+
+		typeNames[arg] = struct{}{}
+
+		out = append(out, typeArg{Name: arg, ExtCode: 0})
+	}
+
+	return out, nil
+}
+
+// parseTestConstructors parses values of form "Type=Func" to the map from type name to constructor.
+// The type could be omitted, if only one type is generated.
+func parseTestConstructors(values []string, typeArgs []typeArg) (map[string]string, error) {
+	out := make(map[string]string, len(values))
+
+	for _, value := range values {
+		typeName, constructor, hasType := strings.Cut(value, "=")
+
+		switch {
+		case !hasType && len(typeArgs) != 1:
+			return nil, fmt.Errorf("%w: %s", errTestTypeNotSet, value)
+		case !hasType:
+			typeName, constructor = typeArgs[0].Name, value
+		case !slices.ContainsFunc(typeArgs, func(arg typeArg) bool { return arg.Name == typeName }):
+			return nil, fmt.Errorf("%w: %s", errUnknownTestType, typeName)
+		}
+
+		out[typeName] = constructor
+	}
+
+	return out, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTypeArgs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("range", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := parseTypeArgs([]string{"Foo", "Bar", "Baz=-5", "Qux"}, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []typeArg{
+			{Name: "Foo", ExtCode: 10},
+			{Name: "Bar", ExtCode: 11},
+			{Name: "Baz", ExtCode: -5},
+			{Name: "Qux", ExtCode: 12},
+		}, args)
+	})
+
+	t.Run("explicit codes", func(t *testing.T) {
+		t.Parallel()
+
+		args, err := parseTypeArgs([]string{"Foo=10", "Bar=11"}, undefinedExtCode)
+		require.NoError(t, err)
+		assert.Equal(t, []typeArg{{Name: "Foo", ExtCode: 10}, {Name: "Bar", ExtCode: 11}}, args)
+	})
+
+	t.Run("code not set", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseTypeArgs([]string{"Foo=10", "Bar"}, undefinedExtCode)
+		require.ErrorIs(t, err, errExtCodeNotSet)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseTypeArgs([]string{"Foo=abc"}, undefinedExtCode)
+		require.Error(t, err)
+
+		_, err = parseTypeArgs([]string{"Foo=128"}, undefinedExtCode)
+		require.ErrorIs(t, err, errInvalidExtCode)
+
+		_, err = parseTypeArgs([]string{"Foo", "Bar"}, 127)
+		require.ErrorIs(t, err, errInvalidExtCode)
+	})
+
+	t.Run("duplicates", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseTypeArgs([]string{"Foo", "Bar=10"}, 10)
+		require.ErrorIs(t, err, errDuplicateExtCode)
+
+		_, err = parseTypeArgs([]string{"Foo=1", "Foo=2"}, undefinedExtCode)
+		require.ErrorIs(t, err, errDuplicateType)
+	})
+}
+
+func TestParsePlainTypeArgs(t *testing.T) {
+	t.Parallel()
+
+	args, err := parsePlainTypeArgs([]string{"Foo", "Bar"})
+	require.NoError(t, err)
+	assert.Equal(t, []typeArg{{Name: "Foo", ExtCode: 0}, {Name: "Bar", ExtCode: 0}}, args)
+
+	_, err = parsePlainTypeArgs([]string{"Foo=10"})
+	require.ErrorIs(t, err, errExtCodeInPlain)
+
+	_, err = parsePlainTypeArgs([]string{"Foo", "Foo"})
+	require.ErrorIs(t, err, errDuplicateType)
+}
+
+func TestParseTestConstructors(t *testing.T) {
+	t.Parallel()
+
+	single := []typeArg{{Name: "Foo", ExtCode: 1}}
+	several := []typeArg{{Name: "Foo", ExtCode: 1}, {Name: "Bar", ExtCode: 2}}
+
+	constructors, err := parseTestConstructors([]string{"newFoo"}, single)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Foo": "newFoo"}, constructors)
+
+	constructors, err = parseTestConstructors([]string{"Foo=newFoo", "Bar=newBar"}, several)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"Foo": "newFoo", "Bar": "newBar"}, constructors)
+
+	_, err = parseTestConstructors([]string{"newFoo"}, several)
+	require.ErrorIs(t, err, errTestTypeNotSet)
+
+	_, err = parseTestConstructors([]string{"Baz=newBaz"}, several)
+	require.ErrorIs(t, err, errUnknownTestType)
+}
@@ -1,19 +1,62 @@
-// Package extractor is a package, that extracts type specs and methods from given ast tree.
+// Package extractor is a package, that extracts type specs and their method sets from a type-checked package.
 package extractor
 
 import (
 	"go/ast"
+	"go/types"
+	"strings"
 )
 
-// TypeSpecEntry is an entry, that defines ast's TypeSpec and contains type name and methods.
+// TypeSpecEntry is an entry, that defines a type of the package and contains type name and methods.
 type TypeSpecEntry struct {
-	Name    string
+	Name string
+	// Methods are names of methods of the pointer to the type: methods with value and pointer
+	// receivers and methods, promoted from embedded fields. Methods of the target type are used
+	// for aliases.
 	Methods []string
 
-	methodMap map[string]struct{}
+	methodMap map[string]*types.Func
 
-	rawType    *ast.TypeSpec
-	rawMethods []*ast.FuncDecl
+	// pkg is the analyzed package, that could differ from the package of the type.
+	pkg     *types.Package
+	typ     types.Type
+	rawType *ast.TypeSpec
+}
+
+func newTypeSpecEntry(pkg *types.Package, name string, typ types.Type, rawType *ast.TypeSpec) *TypeSpecEntry {
+	// Values of optional types are addressable, so methods with pointer receivers could be called.
+	var methodSet *types.MethodSet
+	if types.IsInterface(typ) {
+		methodSet = types.NewMethodSet(typ)
+	} else {
+		methodSet = types.NewMethodSet(types.NewPointer(typ))
+	}
+
+	entry := &TypeSpecEntry{
+		Name:      name,
+		Methods:   make([]string, 0, methodSet.Len()),
+		methodMap: make(map[string]*types.Func, methodSet.Len()),
+		pkg:       pkg,
+		typ:       typ,
+		rawType:   rawType,
+	}
+
+	for i := range methodSet.Len() {
+		method, ok := methodSet.At(i).Obj().(*types.Func)
+		if !ok {
+			continue
+		}
+
+		entry.Methods = append(entry.Methods, method.Name())
+		entry.methodMap[method.Name()] = method
+	}
+
+	return entry
+}
+
+// Type returns the type of the entry. Aliases are resolved to their target types.
+func (e TypeSpecEntry) Type() types.Type {
+	return e.typ
 }
 
 // HasMethod returns true if type spec has method with given name.
@@ -22,52 +65,87 @@ func (e TypeSpecEntry) HasMethod(name string) bool {
 	return ok
 }
 
-// Analyzer is an analyzer, that extracts type specs and methods from package and groups
+// IsGeneric returns true if type spec declares a generic type.
+func (e TypeSpecEntry) IsGeneric() bool {
+	return e.rawType != nil && e.rawType.TypeParams != nil && len(e.rawType.TypeParams.List) > 0
+}
+
+// TypeParams returns type parameter list of a generic type as it is declared in source,
+// e.g. "[K comparable, V any]". Returns an empty string for non-generic types.
+func (e TypeSpecEntry) TypeParams() string {
+	if !e.IsGeneric() {
+		return ""
+	}
+
+	params := make([]string, 0, len(e.rawType.TypeParams.List))
+	for _, field := range e.rawType.TypeParams.List {
+		params = append(params, strings.Join(fieldNames(field), ", ")+" "+types.ExprString(field.Type))
+	}
+
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// TypeArgs returns type parameter names of a generic type, e.g. "[K, V]", that could be
+// used to instantiate the type with its own type parameters.
+// Returns an empty string for non-generic types.
+func (e TypeSpecEntry) TypeArgs() string {
+	if !e.IsGeneric() {
+		return ""
+	}
+
+	var names []string
+	for _, field := range e.rawType.TypeParams.List {
+		names = append(names, fieldNames(field)...)
+	}
+
+	return "[" + strings.Join(names, ", ") + "]"
+}
+
+func fieldNames(field *ast.Field) []string {
+	names := make([]string, 0, len(field.Names))
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+
+	return names
+}
+
+// Analyzer is an analyzer, that extracts type specs and method sets from package and groups
 // them for quick access.
 type Analyzer struct {
 	pkgPath string
 	pkgName string
+	pkg     *types.Package
 	entries map[string]*TypeSpecEntry
 }
 
-// NewAnalyzerFromPackage parses ast tree for TypeSpecs and associated methods.
+// NewAnalyzerFromPackage resolves TypeSpecs of the package with its type information and
+// collects method sets of the types.
 func NewAnalyzerFromPackage(pkg Package) (*Analyzer, error) {
 	typeSpecs := ExtractTypeSpecsFromPackage(pkg)
-	methodsDefs := ExtractMethodsFromPackage(pkg)
+	info := pkg.TypesInfo()
 
 	analyzer := &Analyzer{
 		entries: make(map[string]*TypeSpecEntry, len(typeSpecs)),
 		pkgPath: pkg.PkgPath(),
 		pkgName: pkg.Name(),
+		pkg:     pkg.Types(),
 	}
 
 	for _, typeSpec := range typeSpecs {
-		tsName := typeSpec.Name.String()
-		if _, ok := analyzer.entries[tsName]; ok {
-			// Duplicate type spec, skipping.
+		obj, ok := info.Defs[typeSpec.Name].(*types.TypeName)
+		if !ok || obj.Parent() != analyzer.pkg.Scope() {
+			// Types, declared in functions, are skipped.
 			continue
 		}
 
-		entry := &TypeSpecEntry{
-			Name:       tsName,
-			Methods:    nil,
-			methodMap:  make(map[string]struct{}),
-			rawType:    typeSpec,
-			rawMethods: nil,
-		}
-
-		for _, methodDef := range methodsDefs {
-			typeName := ExtractRecvTypeName(methodDef)
-			if typeName != tsName {
-				continue
-			}
-
-			entry.Methods = append(entry.Methods, methodDef.Name.String())
-			entry.rawMethods = append(entry.rawMethods, methodDef)
-			entry.methodMap[methodDef.Name.String()] = struct{}{}
+		tsName := obj.Name()
+		if _, ok := analyzer.entries[tsName]; ok {
+			// Duplicate type spec, skipping.
+			continue
 		}
 
-		analyzer.entries[tsName] = entry
+		analyzer.entries[tsName] = newTypeSpecEntry(analyzer.pkg, tsName, types.Unalias(obj.Type()), typeSpec)
 	}
 
 	return analyzer, nil
@@ -83,8 +161,39 @@ func (a Analyzer) PackageName() string {
 	return a.pkgName
 }
 
-// TypeSpecEntryByName returns TypeSpecEntry entry by name.
+// TypeSpecEntryByName returns TypeSpecEntry entry by name. Qualified names, e.g. "uuid.UUID",
+// are resolved in packages, imported by the analyzed package.
 func (a Analyzer) TypeSpecEntryByName(name string) (*TypeSpecEntry, bool) {
-	structEntry, ok := a.entries[name]
-	return structEntry, ok
+	if !strings.Contains(name, ".") {
+		structEntry, ok := a.entries[name]
+		return structEntry, ok
+	}
+
+	obj, ok := a.lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, false
+	}
+
+	return newTypeSpecEntry(a.pkg, name, types.Unalias(obj.Type()), nil), true
+}
+
+// lookup returns an object of the package scope by name, or an exported object of an imported
+// package by a qualified name.
+func (a Analyzer) lookup(name string) types.Object {
+	qualifier, objName, qualified := strings.Cut(name, ".")
+	if !qualified {
+		return a.pkg.Scope().Lookup(name)
+	}
+
+	for _, imported := range a.pkg.Imports() {
+		if imported.Name() != qualifier {
+			continue
+		}
+
+		if obj := imported.Scope().Lookup(objName); obj != nil && obj.Exported() {
+			return obj
+		}
+	}
+
+	return nil
 }
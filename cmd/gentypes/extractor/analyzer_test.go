@@ -2,6 +2,7 @@ package extractor_test
 
 import (
 	"go/ast"
+	"go/types"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,9 +12,11 @@ import (
 )
 
 type MockPackage struct {
-	NameValue    string
-	PkgPathValue string
-	SyntaxValue  []*ast.File
+	NameValue      string
+	PkgPathValue   string
+	SyntaxValue    []*ast.File
+	TypesValue     *types.Package
+	TypesInfoValue *types.Info
 }
 
 func (p *MockPackage) Name() string {
@@ -28,16 +31,18 @@ func (p *MockPackage) Syntax() []*ast.File {
 	return p.SyntaxValue
 }
 
+func (p *MockPackage) Types() *types.Package {
+	return p.TypesValue
+}
+
+func (p *MockPackage) TypesInfo() *types.Info {
+	return p.TypesInfoValue
+}
+
 func TestNewAnalyzerFromPackage_Success(t *testing.T) {
 	t.Parallel()
 
-	pkg := &MockPackage{
-		SyntaxValue: []*ast.File{
-			astFromString(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}")),
-		},
-		NameValue:    "pkg",
-		PkgPathValue: "some-pkg-path",
-	}
+	pkg := typedPackage(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}"))
 
 	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
 	require.NoError(t, err)
@@ -47,13 +52,7 @@ func TestNewAnalyzerFromPackage_Success(t *testing.T) {
 func TestNewAnalyzerFromPackage_PkgInfo(t *testing.T) {
 	t.Parallel()
 
-	pkg := &MockPackage{
-		SyntaxValue: []*ast.File{
-			astFromString(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}")),
-		},
-		NameValue:    "pkg",
-		PkgPathValue: "some-pkg-path",
-	}
+	pkg := typedPackage(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}"))
 
 	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
 	require.NoError(t, err)
@@ -65,13 +64,7 @@ func TestNewAnalyzerFromPackage_PkgInfo(t *testing.T) {
 func TestNewAnalyzerFromPackage_TypeInfo(t *testing.T) {
 	t.Parallel()
 
-	pkg := &MockPackage{
-		SyntaxValue: []*ast.File{
-			astFromString(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}")),
-		},
-		NameValue:    "pkg",
-		PkgPathValue: "some-pkg-path",
-	}
+	pkg := typedPackage(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}"))
 
 	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
 	require.NoError(t, err)
@@ -87,6 +80,40 @@ func TestNewAnalyzerFromPackage_TypeInfo(t *testing.T) {
 	assert.False(t, found)
 }
 
+func TestNewAnalyzerFromPackage_GenericTypeInfo(t *testing.T) {
+	t.Parallel()
+
+	pkg := typedPackage(t, s(
+		"package pkg",
+		`import "cmp"`,
+		"type T struct{}",
+		"type Pair[K comparable, V any] struct{ Key K; Value V }",
+		"func (p *Pair[K, V]) Method() {}",
+		"type List[A, B cmp.Ordered, C ~[]A] struct{}",
+	))
+
+	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
+	require.NoError(t, err)
+
+	entry, found := analyzer.TypeSpecEntryByName("Pair")
+	require.True(t, found)
+	assert.True(t, entry.IsGeneric())
+	assert.Equal(t, "[K comparable, V any]", entry.TypeParams())
+	assert.Equal(t, "[K, V]", entry.TypeArgs())
+	assert.True(t, entry.HasMethod("Method"))
+
+	entry, found = analyzer.TypeSpecEntryByName("List")
+	require.True(t, found)
+	assert.Equal(t, "[A, B cmp.Ordered, C ~[]A]", entry.TypeParams())
+	assert.Equal(t, "[A, B, C]", entry.TypeArgs())
+
+	entry, found = analyzer.TypeSpecEntryByName("T")
+	require.True(t, found)
+	assert.False(t, entry.IsGeneric())
+	assert.Empty(t, entry.TypeParams())
+	assert.Empty(t, entry.TypeArgs())
+}
+
 func TestNewAnalyzerFromPackage_NilPackage(t *testing.T) {
 	t.Parallel()
 
@@ -94,3 +121,137 @@ func TestNewAnalyzerFromPackage_NilPackage(t *testing.T) {
 		_, _ = extractor.NewAnalyzerFromPackage(nil)
 	})
 }
+
+func TestNewAnalyzerFromPackage_MethodSet(t *testing.T) {
+	t.Parallel()
+
+	pkg := typedPackage(t,
+		s(
+			"package pkg",
+			`import "bytes"`,
+			"type Base struct{}",
+			"func (b Base) Value() {}",
+			"func (b *Base) Pointer() {}",
+			"type T struct{ Base }",
+			"type Alias = bytes.Buffer",
+			"func f() { type T struct{} }",
+		),
+		s("package pkg", "func (t T) Own() {}"),
+	)
+
+	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
+	require.NoError(t, err)
+
+	// Methods with value and pointer receivers, declared in other files and promoted from embedded fields.
+	entry, found := analyzer.TypeSpecEntryByName("T")
+	require.True(t, found)
+	assert.Equal(t, []string{"Own", "Pointer", "Value"}, entry.Methods)
+
+	// Methods of alias targets from other packages.
+	entry, found = analyzer.TypeSpecEntryByName("Alias")
+	require.True(t, found)
+	assert.True(t, entry.HasMethod("WriteString"))
+	assert.Equal(t, "bytes.Buffer", entry.Type().String())
+
+	// Types of imported packages are resolved by qualified names.
+	entry, found = analyzer.TypeSpecEntryByName("bytes.Buffer")
+	require.True(t, found)
+	assert.Equal(t, "bytes.Buffer", entry.Name)
+	assert.True(t, entry.HasMethod("WriteString"))
+	assert.False(t, entry.IsGeneric())
+
+	_, found = analyzer.TypeSpecEntryByName("bytes.buffer")
+	assert.False(t, found)
+	_, found = analyzer.TypeSpecEntryByName("strings.Builder")
+	assert.False(t, found)
+}
+
+func TestTypeSpecEntry_CheckMethods(t *testing.T) {
+	t.Parallel()
+
+	pkg := typedPackage(t, s(
+		"package pkg",
+		"type Valid struct{}",
+		"func (v Valid) MarshalMsgpack() ([]byte, error) { return nil, nil }",
+		"func (v *Valid) UnmarshalMsgpack([]byte) error { return nil }",
+		"func (v *Valid) AppendMsgpack(dst []byte) ([]byte, error) { return dst, nil }",
+		"func (v *Valid) AppendExtPayload(dst []byte) ([]byte, error) { return dst, nil }",
+		"type Invalid struct{}",
+		"func (v Invalid) MarshalMsgpack() []byte { return nil }",
+		"func (v *Invalid) AppendMsgpack(dst []byte) []byte { return dst }",
+		"type Embedded struct{ *Valid }",
+	))
+
+	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
+	require.NoError(t, err)
+
+	for _, name := range []string{"Valid", "Embedded"} {
+		entry, found := analyzer.TypeSpecEntryByName(name)
+		require.True(t, found)
+		require.NoError(t, entry.CheckMarshalMethod())
+		require.NoError(t, entry.CheckUnmarshalMethod())
+		assert.True(t, entry.IsAppendMarshaler())
+		assert.True(t, entry.IsExtPayloadAppender())
+	}
+
+	entry, found := analyzer.TypeSpecEntryByName("Invalid")
+	require.True(t, found)
+
+	err = entry.CheckMarshalMethod()
+	require.ErrorIs(t, err, extractor.ErrInvalidSignature)
+	assert.Equal(t, "invalid signature: MarshalMsgpack of Invalid is func() []byte, expected func() ([]byte, error)",
+		err.Error())
+
+	err = entry.CheckUnmarshalMethod()
+	require.ErrorIs(t, err, extractor.ErrMethodNotFound)
+	assert.Equal(t, "method is not found: UnmarshalMsgpack of Invalid", err.Error())
+
+	assert.False(t, entry.IsAppendMarshaler())
+	assert.False(t, entry.IsExtPayloadAppender())
+}
+
+func TestAnalyzer_CheckFuncs(t *testing.T) {
+	t.Parallel()
+
+	pkg := typedPackage(t, s(
+		"package pkg",
+		`import "bytes"`,
+		"type T struct{}",
+		"type Pair[K comparable, V any] struct{}",
+		"func encode(T) ([]byte, error) { return nil, nil }",
+		"func decode(*T, []byte) error { return nil }",
+		"func encodeBuffer(bytes.Buffer) ([]byte, error) { return nil, nil }",
+		"func encodePair[K comparable, V any](Pair[K, V]) ([]byte, error) { return nil, nil }",
+		"func decodePair[K comparable, V any](*Pair[K, V], []byte) error { return nil }",
+	))
+
+	analyzer, err := extractor.NewAnalyzerFromPackage(pkg)
+	require.NoError(t, err)
+
+	entry, found := analyzer.TypeSpecEntryByName("T")
+	require.True(t, found)
+	require.NoError(t, analyzer.CheckMarshalFunc("encode", entry))
+	require.NoError(t, analyzer.CheckUnmarshalFunc("decode", entry))
+
+	err = analyzer.CheckMarshalFunc("decode", entry)
+	require.ErrorIs(t, err, extractor.ErrInvalidSignature)
+	assert.Equal(t, "invalid signature: decode is func(*T, []byte) error, expected func(T) ([]byte, error)",
+		err.Error())
+
+	err = analyzer.CheckUnmarshalFunc("decodeT", entry)
+	require.ErrorIs(t, err, extractor.ErrFuncNotFound)
+
+	// Functions, that accept another type, are rejected.
+	err = analyzer.CheckMarshalFunc("encodeBuffer", entry)
+	require.ErrorIs(t, err, extractor.ErrInvalidSignature)
+
+	buffer, found := analyzer.TypeSpecEntryByName("bytes.Buffer")
+	require.True(t, found)
+	require.NoError(t, analyzer.CheckMarshalFunc("encodeBuffer", buffer))
+
+	// Functions for generic types are generic too.
+	pair, found := analyzer.TypeSpecEntryByName("Pair")
+	require.True(t, found)
+	require.NoError(t, analyzer.CheckMarshalFunc("encodePair", pair))
+	require.NoError(t, analyzer.CheckUnmarshalFunc("decodePair", pair))
+}
@@ -20,6 +20,10 @@ func (t *methodVisitor) Visit(node ast.Node) ast.Visitor {
 }
 
 // ExtractMethodsFromPackage is a function to extract methods from package.
+//
+// Deprecated: methods are declared in source only, so promoted methods and methods of aliased
+// and imported types are missed. Use NewAnalyzerFromPackage and TypeSpecEntry.Methods, that
+// are resolved with go/types.
 func ExtractMethodsFromPackage(pkg Package) []*ast.FuncDecl {
 	visitor := &methodVisitor{
 		Methods: nil,
@@ -32,6 +36,8 @@ func ExtractMethodsFromPackage(pkg Package) []*ast.FuncDecl {
 }
 
 // ExtractRecvTypeName is a helper function to extract receiver type name (string) from method.
+//
+// Deprecated: use NewAnalyzerFromPackage and TypeSpecEntryByName to get methods of a type.
 func ExtractRecvTypeName(method *ast.FuncDecl) string {
 	if method.Recv == nil {
 		return ""
@@ -16,7 +16,7 @@ func TestExtractMethodsFromPackageSimple(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		t.Parallel()
 
-		pkg := &MockPackage{NameValue: "", PkgPathValue: "", SyntaxValue: nil}
+		pkg := &MockPackage{NameValue: "", PkgPathValue: "", SyntaxValue: nil, TypesValue: nil, TypesInfoValue: nil}
 		funcDecls := extractor.ExtractMethodsFromPackage(pkg)
 		require.Empty(t, funcDecls)
 	})
@@ -25,8 +25,10 @@ func TestExtractMethodsFromPackageSimple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "type T struct{}")),
 			},
@@ -40,8 +42,10 @@ func TestExtractMethodsFromPackageSimple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "type T struct{}", "func (t *T) Method() {}")),
 			},
@@ -60,8 +64,10 @@ func TestExtractMethodsFromPackageMultiple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "type T struct{}", "func (t *T) Method1() {}")),
 				astFromString(t, s("package pkg", "func (t *T) Method2() {}")),
@@ -78,8 +84,10 @@ func TestExtractMethodsFromPackageMultiple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "func Method() {}")),
 			},
@@ -97,8 +105,10 @@ func TestExtractRecvTypeNameSimple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T struct{}", "func (t T) Method() {}"),
 			)},
@@ -113,8 +123,10 @@ func TestExtractRecvTypeNameSimple(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T struct{}", "func (t *T) Method() {}"),
 			)},
@@ -133,8 +145,10 @@ func TestExtractRecvTypeNameGenericSingle(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T[K any] struct{}", "func (t T[K]) Method() {}"),
 			)},
@@ -149,8 +163,10 @@ func TestExtractRecvTypeNameGenericSingle(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T[K any] struct{}", "func (t *T[K]) Method() {}"),
 			)},
@@ -169,8 +185,10 @@ func TestExtractRecvTypeNameGenericMulti(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T[K any, V any] struct{}", "func (t T[K, V]) Method() {}"),
 			)},
@@ -185,8 +203,10 @@ func TestExtractRecvTypeNameGenericMulti(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{astFromString(t,
 				s("package pkg", "type T[K any, V any] struct{}", "func (t *T[K, V]) Method() {}"),
 			)},
@@ -1,6 +1,9 @@
 package extractor
 
-import "go/ast"
+import (
+	"go/ast"
+	"go/types"
+)
 
 // Package is an interface that provides access to package data.
 // It's used to abstract away the `packages.Package` type.
@@ -8,4 +11,8 @@ type Package interface {
 	Name() string
 	PkgPath() string
 	Syntax() []*ast.File
+	// Types returns the type-checked package.
+	Types() *types.Package
+	// TypesInfo returns the type information of the syntax trees.
+	TypesInfo() *types.Info
 }
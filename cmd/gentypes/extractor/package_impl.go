@@ -2,6 +2,7 @@ package extractor
 
 import (
 	"go/ast"
+	"go/types"
 
 	"golang.org/x/tools/go/packages"
 )
@@ -26,3 +27,11 @@ func (p *packageImpl) PkgPath() string {
 func (p *packageImpl) Syntax() []*ast.File {
 	return p.pkg.Syntax
 }
+
+func (p *packageImpl) Types() *types.Package {
+	return p.pkg.Types
+}
+
+func (p *packageImpl) TypesInfo() *types.Info {
+	return p.pkg.TypesInfo
+}
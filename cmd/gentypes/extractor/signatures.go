@@ -0,0 +1,143 @@
+package extractor
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+)
+
+var (
+	// ErrMethodNotFound is returned, if the type has no method with the given name.
+	ErrMethodNotFound = errors.New("method is not found")
+	// ErrFuncNotFound is returned, if the package has no function with the given name.
+	ErrFuncNotFound = errors.New("function is not found")
+	// ErrInvalidSignature is returned, if the method or the function has unexpected signature.
+	ErrInvalidSignature = errors.New("invalid signature")
+)
+
+func byteSliceType() types.Type {
+	return types.NewSlice(types.Typ[types.Byte])
+}
+
+func errorType() types.Type {
+	return types.Universe.Lookup("error").Type()
+}
+
+// newQualifier prints types of other packages as they are referred in the source, e.g. uuid.UUID,
+// and types of the current package without qualifiers.
+func newQualifier(current *types.Package) types.Qualifier {
+	return func(pkg *types.Package) string {
+		if pkg == current {
+			return ""
+		}
+
+		return pkg.Name()
+	}
+}
+
+// checkSignature reports whether the signature has the given parameter and result types.
+// nil types are not checked.
+func checkSignature(sig *types.Signature, params, results []types.Type) bool {
+	if sig.Variadic() || sig.Params().Len() != len(params) || sig.Results().Len() != len(results) {
+		return false
+	}
+
+	for i, param := range params {
+		if param != nil && !types.Identical(sig.Params().At(i).Type(), param) {
+			return false
+		}
+	}
+
+	for i, result := range results {
+		if result != nil && !types.Identical(sig.Results().At(i).Type(), result) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e TypeSpecEntry) checkMethod(name string, params, results []types.Type, expected string) error {
+	method, ok := e.methodMap[name]
+	if !ok {
+		return fmt.Errorf("%w: %s of %s", ErrMethodNotFound, name, e.Name)
+	}
+
+	sig, ok := method.Type().(*types.Signature)
+	if !ok || !checkSignature(sig, params, results) {
+		return fmt.Errorf("%w: %s of %s is %s, expected %s",
+			ErrInvalidSignature, name, e.Name, types.TypeString(method.Type(), newQualifier(e.pkg)), expected)
+	}
+
+	return nil
+}
+
+// CheckMarshalMethod checks, that the type has the MarshalMsgpack() ([]byte, error) method.
+func (e TypeSpecEntry) CheckMarshalMethod() error {
+	return e.checkMethod("MarshalMsgpack", nil, []types.Type{byteSliceType(), errorType()},
+		"func() ([]byte, error)")
+}
+
+// CheckUnmarshalMethod checks, that the type has the UnmarshalMsgpack([]byte) error method.
+func (e TypeSpecEntry) CheckUnmarshalMethod() error {
+	return e.checkMethod("UnmarshalMsgpack", []types.Type{byteSliceType()}, []types.Type{errorType()},
+		"func([]byte) error")
+}
+
+// IsAppendMarshaler reports whether the type has the AppendMsgpack([]byte) ([]byte, error) method
+// of the option.AppendMarshaler interface.
+func (e TypeSpecEntry) IsAppendMarshaler() bool {
+	return e.checkMethod("AppendMsgpack", []types.Type{byteSliceType()},
+		[]types.Type{byteSliceType(), errorType()}, "") == nil
+}
+
+// IsExtPayloadAppender reports whether the type has the AppendExtPayload([]byte) ([]byte, error)
+// method of the option.ExtPayloadAppender interface.
+func (e TypeSpecEntry) IsExtPayloadAppender() bool {
+	return e.checkMethod("AppendExtPayload", []types.Type{byteSliceType()},
+		[]types.Type{byteSliceType(), errorType()}, "") == nil
+}
+
+// valueType returns the type of the entry to check parameters of functions, or nil for generic
+// types: functions for them are generic too, so their parameters are not identical to the type.
+func (e TypeSpecEntry) valueType() types.Type {
+	if e.IsGeneric() {
+		return nil
+	}
+
+	return e.typ
+}
+
+func (a Analyzer) checkFunc(name string, params, results []types.Type, expected string) error {
+	fn, ok := a.lookup(name).(*types.Func)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrFuncNotFound, name)
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() != nil || !checkSignature(sig, params, results) {
+		return fmt.Errorf("%w: %s is %s, expected %s",
+			ErrInvalidSignature, name, types.TypeString(fn.Type(), newQualifier(a.pkg)), expected)
+	}
+
+	return nil
+}
+
+// CheckMarshalFunc checks, that the custom marshal function, passed with -marshal-func, is
+// declared as func(T) ([]byte, error) for the type of the entry.
+func (a Analyzer) CheckMarshalFunc(name string, entry *TypeSpecEntry) error {
+	return a.checkFunc(name, []types.Type{entry.valueType()}, []types.Type{byteSliceType(), errorType()},
+		fmt.Sprintf("func(%s) ([]byte, error)", entry.Name))
+}
+
+// CheckUnmarshalFunc checks, that the custom unmarshal function, passed with -unmarshal-func, is
+// declared as func(*T, []byte) error for the type of the entry.
+func (a Analyzer) CheckUnmarshalFunc(name string, entry *TypeSpecEntry) error {
+	var param types.Type
+	if typ := entry.valueType(); typ != nil {
+		param = types.NewPointer(typ)
+	}
+
+	return a.checkFunc(name, []types.Type{param, byteSliceType()}, []types.Type{errorType()},
+		fmt.Sprintf("func(*%s, []byte) error", entry.Name))
+}
@@ -2,8 +2,10 @@ package extractor_test
 
 import (
 	"go/ast"
+	"go/importer"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"strings"
 	"testing"
 
@@ -24,3 +26,33 @@ func astFromString(t *testing.T, s string) *ast.File {
 
 	return f
 }
+
+// typedPackage parses and type-checks the sources as files of a single package "pkg".
+// Imports are resolved from sources.
+func typedPackage(t *testing.T, sources ...string) *MockPackage {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(sources))
+
+	for _, source := range sources {
+		f, err := parser.ParseFile(fset, "test.go", source, parser.AllErrors)
+		require.NoError(t, err)
+
+		files = append(files, f)
+	}
+
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}                    //nolint:exhaustruct
+	config := types.Config{Importer: importer.ForCompiler(fset, "source", nil)} //nolint:exhaustruct
+
+	pkg, err := config.Check("some-pkg-path", fset, files, info)
+	require.NoError(t, err)
+
+	return &MockPackage{
+		NameValue:      pkg.Name(),
+		PkgPathValue:   pkg.Path(),
+		SyntaxValue:    files,
+		TypesValue:     pkg,
+		TypesInfoValue: info,
+	}
+}
@@ -14,7 +14,7 @@ func TestExtractTypeSpecsFromPackage(t *testing.T) {
 	t.Run("empty", func(t *testing.T) {
 		t.Parallel()
 
-		pkg := &MockPackage{NameValue: "", PkgPathValue: "", SyntaxValue: nil}
+		pkg := &MockPackage{NameValue: "", PkgPathValue: "", SyntaxValue: nil, TypesValue: nil, TypesInfoValue: nil}
 		typeSpecs := extractor.ExtractTypeSpecsFromPackage(pkg)
 		require.Empty(t, typeSpecs)
 	})
@@ -23,8 +23,10 @@ func TestExtractTypeSpecsFromPackage(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "type T struct{}")),
 			},
@@ -39,8 +41,10 @@ func TestExtractTypeSpecsFromPackage(t *testing.T) {
 		t.Parallel()
 
 		pkg := &MockPackage{
-			NameValue:    "",
-			PkgPathValue: "",
+			NameValue:      "",
+			PkgPathValue:   "",
+			TypesValue:     nil,
+			TypesInfoValue: nil,
 			SyntaxValue: []*ast.File{
 				astFromString(t, s("package pkg", "type T struct{}")),
 				astFromString(t, s("package pkg", "type U[K any, V any] struct{}")),
@@ -1,5 +1,8 @@
-//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 1 -package internal/test FullMsgpackExtType
-//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 2 -force -package internal/test HiddenTypeAlias
-//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 3 -imports github.com/google/uuid -package internal/test -marshal-func encodeUUID -unmarshal-func decodeUUID uuid.UUID
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 40 -package internal/test FullMsgpackExtType
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 50 -package internal/test HiddenTypeAlias
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 100 -imports github.com/google/uuid -package internal/test -marshal-func encodeUUID -unmarshal-func decodeUUID -tests uuid.UUID
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 10 -register -package internal/test -output multitype_gen.go -tests -test-constructor Point=newSamplePoint -test-constructor Color=newSampleColor Point Color=20
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -mode plain -package internal/test -output plain_gen.go -tests -test-constructor PlainStruct=newSamplePlainStruct PlainStruct PlainCustom
+//go:generate go run github.com/tarantool/go-option/cmd/gentypes -ext-code 30 -package internal/test -tests -test-constructor newSamplePair Pair
 
 package main
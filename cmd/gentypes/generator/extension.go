@@ -18,7 +18,7 @@ var typeGenTestTemplate string
 
 var (
 	cTypeGenTemplate     *template.Template
-	cTypeGenTestTemplate *template.Template //nolint:unused
+	cTypeGenTestTemplate *template.Template
 )
 
 // InitializeTemplates initializes the templates, should be called at the start of the main program loop.
@@ -45,12 +45,27 @@ func constructTypeName(typeName string) string {
 	return "Optional" + typeName
 }
 
+// Mode defines how the value of an optional type is encoded to MessagePack.
+type Mode string
+
+const (
+	// ModeExt encodes the value as a MessagePack extension with the given code,
+	// using MarshalMsgpack/UnmarshalMsgpack methods or custom functions.
+	ModeExt Mode = "ext"
+	// ModePlain encodes the value without an extension header, using msgpack.Encoder.Encode
+	// and msgpack.Decoder.Decode. So msgpack.CustomEncoder and msgpack.CustomDecoder
+	// implementations of the type are used, if any.
+	ModePlain Mode = "plain"
+)
+
 // GenerateOptions is the options for the code generation.
 type GenerateOptions struct {
 	// TypeName is the name of the type to generate optional to.
 	TypeName string
-	// ExtCode is the extension code.
+	// ExtCode is the extension code, it is not used in ModePlain.
 	ExtCode int
+	// Mode is the encoding mode, ModeExt is used by default.
+	Mode Mode
 	// PackageName is the name of the package to generate to.
 	PackageName string
 	// Imports is the list of imports to add to the generated code.
@@ -59,11 +74,85 @@ type GenerateOptions struct {
 	CustomMarshalFunc string
 	// CustomUnmarshalFunc is the name of the custom unmarshal function.
 	CustomUnmarshalFunc string
+	// Register is true, if the type should be registered with msgpack.RegisterExtEncoder and
+	// msgpack.RegisterExtDecoder in init(). It is not used in ModePlain.
+	Register bool
+	// TypeParams is the type parameter list of a generic type, e.g. "[K comparable, V any]".
+	TypeParams string
+	// TypeArgs is the list of type parameter names of a generic type, e.g. "[K, V]".
+	TypeArgs string
 }
 
-// GenerateByType generates the code for the optional type.
-func GenerateByType(opts GenerateOptions) ([]byte, error) {
-	var buf bytes.Buffer
+// TypeOptions is the options for the code generation of a single optional type in a file.
+type TypeOptions struct {
+	// TypeName is the name of the type to generate optional to.
+	TypeName string
+	// ExtCode is the extension code, it is not used in ModePlain.
+	ExtCode int
+	// Mode is the encoding mode, ModeExt is used by default.
+	Mode Mode
+	// CustomMarshalFunc is the name of the custom marshal function.
+	CustomMarshalFunc string
+	// CustomUnmarshalFunc is the name of the custom unmarshal function.
+	CustomUnmarshalFunc string
+	// Register is true, if the type should be registered with msgpack.RegisterExtEncoder and
+	// msgpack.RegisterExtDecoder in init(), so values of the type are decoded into interface{}
+	// as is. It is not used in ModePlain and is not supported for generic types.
+	Register bool
+	// AppendMarshaler is true if the type implements option.AppendMarshaler. Its AppendMsgpack
+	// method is used to append the value straight into the destination buffer in ModePlain.
+	AppendMarshaler bool
+	// ExtPayloadAppender is true if the type implements option.ExtPayloadAppender. Its
+	// AppendExtPayload method is used to append the extension payload straight into the
+	// destination buffer in ModeExt. It is ignored, if CustomMarshalFunc is set.
+	ExtPayloadAppender bool
+	// TestConstructor is the name of a function, that returns a sample value for tests.
+	// The zero value is used in tests if it is empty. It is required for generic types.
+	TestConstructor string
+	// TypeParams is the type parameter list of a generic type, e.g. "[K comparable, V any]".
+	TypeParams string
+	// TypeArgs is the list of type parameter names of a generic type, e.g. "[K, V]".
+	TypeArgs string
+}
+
+// FileOptions is the options for the code generation of a file with one or more optional types.
+type FileOptions struct {
+	// PackageName is the name of the package to generate to.
+	PackageName string
+	// Imports is the list of imports to add to the generated code.
+	Imports []string
+	// Types is the list of types to generate optionals to.
+	Types []TypeOptions
+}
+
+type typeTemplateData struct {
+	Name                string
+	Self                string
+	Type                string
+	TypeParams          string
+	TypeArgs            string
+	ExtCode             string
+	Plain               bool
+	CustomMarshalFunc   string
+	CustomUnmarshalFunc string
+	Register            bool
+	AppendMarshaler     bool
+	ExtPayloadAppender  bool
+	TestConstructor     string
+}
+
+func newTypeTemplateData(opts TypeOptions) typeTemplateData {
+	if opts.Mode == ModePlain {
+		opts.ExtPayloadAppender = false
+	} else {
+		// The value is encoded as an extension, so its complete MessagePack representation is not relevant.
+		opts.AppendMarshaler = false
+	}
+
+	if opts.CustomMarshalFunc != "" {
+		// The custom function defines the payload, so AppendExtPayload of the type is not relevant.
+		opts.ExtPayloadAppender = false
+	}
 
 	if opts.CustomMarshalFunc == "" {
 		opts.CustomMarshalFunc = "o.value.MarshalMsgpack()"
@@ -72,31 +161,100 @@ func GenerateByType(opts GenerateOptions) ([]byte, error) {
 	}
 
 	if opts.CustomUnmarshalFunc == "" {
-		opts.CustomUnmarshalFunc = "o.value.UnmarshalMsgpack(a)"
+		opts.CustomUnmarshalFunc = "value.UnmarshalMsgpack(a)"
 	} else {
-		opts.CustomUnmarshalFunc += "(&o.value, a)"
+		opts.CustomUnmarshalFunc += "(&value, a)"
 	}
 
-	err := cTypeGenTemplate.Execute(&buf, struct {
-		Name                string
-		Type                string
-		ExtCode             string
-		PackageName         string
-		Imports             []string
-		CustomMarshalFunc   string
-		CustomUnmarshalFunc string
-	}{
+	return typeTemplateData{
 		Name:                constructTypeName(opts.TypeName),
-		Type:                opts.TypeName,
+		Self:                constructTypeName(opts.TypeName) + opts.TypeArgs,
+		Type:                opts.TypeName + opts.TypeArgs,
+		TypeParams:          opts.TypeParams,
+		TypeArgs:            opts.TypeArgs,
 		ExtCode:             strconv.Itoa(opts.ExtCode),
-		PackageName:         opts.PackageName,
-		Imports:             opts.Imports,
+		Plain:               opts.Mode == ModePlain,
 		CustomMarshalFunc:   opts.CustomMarshalFunc,
 		CustomUnmarshalFunc: opts.CustomUnmarshalFunc,
+		Register:            opts.Register && opts.Mode != ModePlain,
+		AppendMarshaler:     opts.AppendMarshaler,
+		ExtPayloadAppender:  opts.ExtPayloadAppender,
+		TestConstructor:     opts.TestConstructor,
+	}
+}
+
+func executeFileTemplate(tmpl *template.Template, opts FileOptions) ([]byte, error) {
+	var buf bytes.Buffer
+
+	types := make([]typeTemplateData, 0, len(opts.Types))
+	hasExt, hasRegister := false, false
+
+	for _, typeOpts := range opts.Types {
+		typeData := newTypeTemplateData(typeOpts)
+
+		types = append(types, typeData)
+		hasExt = hasExt || !typeData.Plain
+		hasRegister = hasRegister || typeData.Register
+	}
+
+	err := tmpl.Execute(&buf, struct {
+		PackageName string
+		Imports     []string
+		Types       []typeTemplateData
+		// HasExt is set, if any of types is encoded as an extension type.
+		HasExt bool
+		// HasRegister is set, if any of types is registered with msgpack in init().
+		HasRegister bool
+	}{
+		PackageName: opts.PackageName,
+		Imports:     opts.Imports,
+		Types:       types,
+		HasExt:      hasExt,
+		HasRegister: hasRegister,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to generateByType: %w", err)
+		return nil, err //nolint:wrapcheck
 	}
 
 	return buf.Bytes(), nil
 }
+
+// GenerateFile generates the code of a single file with optional types for all requested types.
+func GenerateFile(opts FileOptions) ([]byte, error) {
+	out, err := executeFileTemplate(cTypeGenTemplate, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generateFile: %w", err)
+	}
+
+	return out, nil
+}
+
+// GenerateTestFile generates the code of tests for optional types, generated by GenerateFile
+// with the same options. Tests are placed in the same package, so the test constructors
+// could be unexported.
+func GenerateTestFile(opts FileOptions) ([]byte, error) {
+	out, err := executeFileTemplate(cTypeGenTestTemplate, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generateTestFile: %w", err)
+	}
+
+	return out, nil
+}
+
+// GenerateByType generates the code for the optional type.
+func GenerateByType(opts GenerateOptions) ([]byte, error) {
+	return GenerateFile(FileOptions{
+		PackageName: opts.PackageName,
+		Imports:     opts.Imports,
+		Types: []TypeOptions{{
+			TypeName:            opts.TypeName,
+			ExtCode:             opts.ExtCode,
+			Mode:                opts.Mode,
+			CustomMarshalFunc:   opts.CustomMarshalFunc,
+			CustomUnmarshalFunc: opts.CustomUnmarshalFunc,
+			Register:            opts.Register,
+			TypeParams:          opts.TypeParams,
+			TypeArgs:            opts.TypeArgs,
+		}},
+	})
+}
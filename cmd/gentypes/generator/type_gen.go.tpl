@@ -7,7 +7,15 @@ import (
 	"{{ $import }}"
 	{{ end }}
 
+	"bytes"
+	"encoding/json"
+	{{- if .HasExt }}
 	"fmt"
+	{{- end }}
+	"iter"
+	{{- if .HasRegister }}
+	"reflect"
+	{{- end }}
 
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
@@ -15,17 +23,22 @@ import (
 	"github.com/tarantool/go-option"
 )
 
+{{- range .Types }}
+{{ template "optional" . }}
+{{- end }}
+
+{{- define "optional" }}
 // {{.Name}} represents an optional value of type {{.Type}}.
 // It can either hold a valid {{.Type}} (IsSome == true) or be empty (IsZero == true).
-type {{.Name}} struct {
+type {{.Name}}{{.TypeParams}} struct {
 	value  {{.Type}}
 	exists bool
 }
 
 // Some{{.Name}} creates an optional {{.Name}} with the given {{.Type}} value.
 // The returned {{.Name}} will have IsSome() == true and IsZero() == false.
-func Some{{.Name}}(value {{.Type}}) {{.Name}} {
-	return {{.Name}}{
+func Some{{.Name}}{{.TypeParams}}(value {{.Type}}) {{.Self}} {
+	return {{.Self}}{
 		value: value,
 		exists: true,
 	}
@@ -36,52 +49,104 @@ func Some{{.Name}}(value {{.Type}}) {{.Name}} {
 //
 // Example:
 //
-//	o := None{{.Name}}()
+//	o := None{{.Name}}{{.TypeArgs}}()
 //	if o.IsZero() {
 //	    fmt.Println("value is absent")
 //	}
-func None{{.Name}}() {{.Name}} {
-	return {{.Name}}{}
+func None{{.Name}}{{.TypeParams}}() {{.Self}} {
+	return {{.Self}}{}
+}
+
+{{- if .Register }}
+
+func init() {
+	var zero {{.Type}}
+
+	// Decoding of the extension into interface{} yields {{.Type}} values, not raw bytes.
+	option.RegisterExtType({{ .ExtCode }}, reflect.TypeOf(zero))
+	msgpack.RegisterExtEncoder({{ .ExtCode }}, zero, func(_ *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+		o := Some{{.Name}}(v.Interface().({{.Type}}))
+		return {{ .CustomMarshalFunc }}
+	})
+	msgpack.RegisterExtDecoder({{ .ExtCode }}, zero, func(decoder *msgpack.Decoder, v reflect.Value, extLen int) error {
+		a := make([]byte, extLen)
+		if err := decoder.ReadFull(a); err != nil {
+			return err
+		}
+
+		var value {{.Type}}
+
+		if err := {{ .CustomUnmarshalFunc }}; err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(value))
+
+		return nil
+	})
 }
+{{- end }}
 
-func (o {{.Name}}) newEncodeError(err error) error {
+func (o {{.Self}}) newEncodeError(err error) error {
 	if err == nil {
 		return nil
 	}
+
 	return &option.EncodeError{
 		Type: "{{.Name}}",
 		Parent: err,
 	}
 }
 
-func (o {{.Name}}) newDecodeError(err error) error {
+func (o {{.Self}}) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type: "{{.Name}}",
+		Expected: "{{.Type}}",
+		Parent: err,
+	}
+}
+
+func (o {{.Self}}) newDecodeMsgpackError(offset option.Int64, err error) error {
 	if err == nil {
 		return nil
 	}
 
 	return &option.DecodeError{
 		Type: "{{.Name}}",
+		Expected: "{{.Type}}",
+		Offset: offset,
 		Parent: err,
 	}
 }
 
+{{ if not .Plain -}}
+// ExtCode returns the MessagePack extension code, that {{.Type}} values are encoded with.
+func (o {{.Self}}) ExtCode() int8 {
+	return {{ .ExtCode }}
+}
+
+{{ end -}}
 // IsSome returns true if the {{.Name}} contains a value.
 // This indicates the value is explicitly set (not None).
-func (o {{.Name}}) IsSome() bool {
+func (o {{.Self}}) IsSome() bool {
 	return o.exists
 }
 
 // IsZero returns true if the {{.Name}} does not contain a value.
 // Equivalent to !IsSome(). Useful for consistency with types where
 // zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
-func (o {{.Name}}) IsZero() bool {
+func (o {{.Self}}) IsZero() bool {
 	return !o.exists
 }
 
 // IsNil is an alias for IsZero.
 //
 // This method is provided for compatibility with the msgpack Encoder interface.
-func (o {{.Name}}) IsNil() bool {
+func (o {{.Self}}) IsNil() bool {
 	return o.IsZero()
 }
 
@@ -94,7 +159,7 @@ func (o {{.Name}}) IsNil() bool {
 //	if value, ok := o.Get(); ok {
 //      // use value
 //	}
-func (o {{.Name}}) Get() ({{.Type}}, bool) {
+func (o {{.Self}}) Get() ({{.Type}}, bool) {
 	return o.value, o.exists
 }
 
@@ -103,10 +168,10 @@ func (o {{.Name}}) Get() ({{.Type}}, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
-func (o {{.Name}}) MustGet() {{.Type}} {
+// Panics with option.ErrNone if no value is set.
+func (o {{.Self}}) MustGet() {{.Type}} {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(option.ErrNone)
 	}
 
 	return o.value
@@ -117,7 +182,7 @@ func (o {{.Name}}) MustGet() {{.Type}} {
 //
 // Warning: Does not check presence. Use IsSome() before calling if you need
 // to distinguish between absent value and explicit zero value.
-func (o {{.Name}}) Unwrap() {{.Type}} {
+func (o {{.Self}}) Unwrap() {{.Type}} {
 	return o.value
 }
 
@@ -126,9 +191,9 @@ func (o {{.Name}}) Unwrap() {{.Type}} {
 //
 // Example:
 //
-//	o := None{{.Name}}()
+//	o := None{{.Name}}{{.TypeArgs}}()
 //	v := o.UnwrapOr(someDefault{{.Name}})
-func (o {{.Name}}) UnwrapOr(defaultValue {{.Type}}) {{.Type}} {
+func (o {{.Self}}) UnwrapOr(defaultValue {{.Type}}) {{.Type}} {
 	if o.exists {
 		return o.value
 	}
@@ -142,9 +207,9 @@ func (o {{.Name}}) UnwrapOr(defaultValue {{.Type}}) {{.Type}} {
 //
 // Example:
 //
-//	o := None{{.Name}}()
+//	o := None{{.Name}}{{.TypeArgs}}()
 //	v := o.UnwrapOrElse(func() {{.Type}} { return computeDefault() })
-func (o {{.Name}}) UnwrapOrElse(defaultValue func() {{.Type}}) {{.Type}} {
+func (o {{.Self}}) UnwrapOrElse(defaultValue func() {{.Type}}) {{.Type}} {
 	if o.exists {
 		return o.value
 	}
@@ -152,7 +217,26 @@ func (o {{.Name}}) UnwrapOrElse(defaultValue func() {{.Type}}) {{.Type}} {
 	return defaultValue()
 }
 
-func (o {{.Name}}) encodeValue(encoder *msgpack.Encoder) error {
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o {{.Self}}) All() iter.Seq[{{.Type}}] {
+	return func(yield func({{.Type}}) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o {{.Self}}) encodeValue(encoder *msgpack.Encoder) error {
+{{- if .Plain }}
+	return encoder.Encode(&o.value)
+{{- else }}
 	value, err := {{ .CustomMarshalFunc }}
 	if err != nil {
 		return err
@@ -169,6 +253,7 @@ func (o {{.Name}}) encodeValue(encoder *msgpack.Encoder) error {
 	}
 
 	return nil
+{{- end }}
 }
 
 
@@ -177,7 +262,7 @@ func (o {{.Name}}) encodeValue(encoder *msgpack.Encoder) error {
 // - If the value is absent (None), it is encoded as nil.
 //
 // Returns an error if encoding fails.
-func (o {{.Name}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
+func (o {{.Self}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	if o.exists {
 		return o.newEncodeError(o.encodeValue(encoder))
 	}
@@ -185,30 +270,92 @@ func (o {{.Name}}) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return o.newEncodeError(encoder.EncodeNil())
 }
 
-func (o *{{.Name}}) decodeValue(decoder *msgpack.Decoder) error {
+// AppendMsgpack appends the {{.Name}} value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o {{.Self}}) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+{{- if .AppendMarshaler }}
+
+	out, err := o.value.AppendMsgpack(dst)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return out, nil
+{{- else if .Plain }}
+
+	out, err := option.AppendValue(dst, &o.value)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return out, nil
+{{- else if .ExtPayloadAppender }}
+
+	// The payload is appended straight into dst, the header is inserted before it afterwards.
+	out, err := o.value.AppendExtPayload(dst)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return option.InsertExtHeader(out, len(dst), {{ .ExtCode }}), nil
+{{- else }}
+
+	value, err := {{ .CustomMarshalFunc }}
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, {{ .ExtCode }}, len(value)), value...), nil
+{{- end }}
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *{{.Self}}) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value {{.Type}}
+{{- if .Plain }}
+
+	if err := decoder.Decode(&value); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+{{- else }}
+
 	tp, length, err := decoder.DecodeExtHeader()
 	switch {
 	case err != nil:
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	case tp != {{ .ExtCode }}:
-		return o.newDecodeError(fmt.Errorf("invalid extension code: %d", tp))
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
 	}
 
 	a := make([]byte, length)
 	if err := decoder.ReadFull(a); err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
 	if err := {{ .CustomUnmarshalFunc }}; err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
+{{- end }}
 
+	o.value = value
 	o.exists = true
+
 	return nil
 }
 
-func (o *{{.Name}}) checkCode(code byte) bool {
+func (o *{{.Self}}) checkCode(code byte) bool {
+{{- if .Plain }}
+	return code != msgpcode.Nil
+{{- else }}
 	return msgpcode.IsExt(code)
+{{- end }}
 }
 
 // DecodeMsgpack decodes a {{.Name}} value from MessagePack format.
@@ -221,26 +368,71 @@ func (o *{{.Name}}) checkCode(code byte) bool {
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on {{.Type}}: exists = true, value = decoded value
-func (o *{{.Name}}) DecodeMsgpack(decoder *msgpack.Decoder) error {
+func (o *{{.Self}}) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return o.newDecodeError(decoder.Skip())
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
 	case o.checkCode(code):
-		err := o.decodeValue(decoder)
-		if err != nil {
-			return o.newDecodeError(err)
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type: "{{.Name}}",
+			Expected: "{{.Type}}",
+			Code: option.SomeByte(code),
+			Offset: offset,
 		}
-		o.exists = true
+	}
+}
 
-		return err
-	default:
-		return o.newDecodeError(fmt.Errorf("unexpected code: %d", code))
+// MarshalJSON encodes the {{.Name}} value using JSON format.
+// - If the value is present, it is encoded as {{.Type}}.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o {{.Self}}) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
 	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a {{.Name}} value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (None{{.Name}})
+//   - {{.Type}}: interpreted as a present value (Some{{.Name}})
+//
+// Returns an error if the input can't be decoded as {{.Type}}.
+func (o *{{.Self}}) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None{{.Name}}{{.TypeArgs}}()
+
+		return nil
+	}
+
+	var value {{.Type}}
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
 }
+{{- end }}
@@ -1,72 +1,60 @@
 // Code generated by github.com/tarantool/go-option; DO NOT EDIT.
 
-package {{ .packageName }}_test
+package {{ .PackageName }}
 
 import (
-	{{ range $i, $import := .imports }}
+	{{ range $i, $import := .Imports }}
 	"{{ $import }}"
 	{{ end }}
 
 	"bytes"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
-
-	"github.com/tarantool/go-option"
 )
 
-func Test{{.Name}}_IsSome(t *testing.T) {
-	t.Parallel()
+{{- range .Types }}
+{{ template "optional_test" . }}
+{{- end }}
 
-	t.Run("some", func(t *testing.T) {
-		t.Parallel()
+{{- define "optional_test" }}
+// sample{{.Name}}Value returns a value, that is used to test {{.Name}}.
+{{- if .TestConstructor }}
+var sample{{.Name}}Value = {{ .TestConstructor }}
+{{- else }}
+func sample{{.Name}}Value() {{.Type}} {
+	var value {{.Type}}
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.True(t, some{{.Name}}.IsSome())
-	})
-
-	t.Run("none", func(t *testing.T) {
-		t.Parallel()
-
-		empty{{.Name}} := option.None{{.Name}}()
-		assert.False(t, empty{{.Name}}.IsSome())
-	})
+	return value
 }
+{{- end }}
 
-func Test{{.Name}}_IsZero(t *testing.T) {
-	t.Parallel()
-
-	t.Run("some", func(t *testing.T) {
-		t.Parallel()
-
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.False(t, some{{.Name}}.IsZero())
-	})
-
-	t.Run("none", func(t *testing.T) {
-		t.Parallel()
-
-		empty{{.Name}} := option.None{{.Name}}()
-		assert.True(t, empty{{.Name}}.IsZero())
-	})
+// none{{.Name}}For returns an empty {{.Name}} for the type of values, returned by the sample function.
+func none{{.Name}}For{{.TypeParams}}(func() {{.Type}}) {{.Self}} {
+	return None{{.Name}}{{.TypeArgs}}()
 }
 
-func Test{{.Name}}_IsNil(t *testing.T) {
+func Test{{.Name}}_IsSome(t *testing.T) {
 	t.Parallel()
 
 	t.Run("some", func(t *testing.T) {
 		t.Parallel()
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
+		assert.True(t, some{{.Name}}.IsSome())
+		assert.False(t, some{{.Name}}.IsZero())
 		assert.False(t, some{{.Name}}.IsNil())
 	})
 
 	t.Run("none", func(t *testing.T) {
 		t.Parallel()
 
-		empty{{.Name}} := option.None{{.Name}}()
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
+		assert.False(t, empty{{.Name}}.IsSome())
+		assert.True(t, empty{{.Name}}.IsZero())
 		assert.True(t, empty{{.Name}}.IsNil())
 	})
 }
@@ -77,16 +65,16 @@ func Test{{.Name}}_Get(t *testing.T) {
 	t.Run("some", func(t *testing.T) {
 		t.Parallel()
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
 		val, ok := some{{.Name}}.Get()
 		require.True(t, ok)
-		assert.EqualValues(t, {{.TestingValue}}, val)
+		assert.Equal(t, sample{{.Name}}Value(), val)
 	})
 
 	t.Run("none", func(t *testing.T) {
 		t.Parallel()
 
-		empty{{.Name}} := option.None{{.Name}}()
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
 		_, ok := empty{{.Name}}.Get()
 		require.False(t, ok)
 	})
@@ -98,14 +86,14 @@ func Test{{.Name}}_MustGet(t *testing.T) {
 	t.Run("some", func(t *testing.T) {
 		t.Parallel()
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.MustGet())
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
+		assert.Equal(t, sample{{.Name}}Value(), some{{.Name}}.MustGet())
 	})
 
 	t.Run("none", func(t *testing.T) {
 		t.Parallel()
 
-		empty{{.Name}} := option.None{{.Name}}()
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
 		assert.Panics(t, func() {
 			empty{{.Name}}.MustGet()
 		})
@@ -118,57 +106,39 @@ func Test{{.Name}}_Unwrap(t *testing.T) {
 	t.Run("some", func(t *testing.T) {
 		t.Parallel()
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.Unwrap())
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
+		assert.Equal(t, sample{{.Name}}Value(), some{{.Name}}.Unwrap())
+		assert.Equal(t, sample{{.Name}}Value(), some{{.Name}}.UnwrapOr(sample{{.Name}}Value()))
+		assert.Equal(t, sample{{.Name}}Value(), some{{.Name}}.UnwrapOrElse(sample{{.Name}}Value))
 	})
 
 	t.Run("none", func(t *testing.T) {
 		t.Parallel()
 
-		empty{{.Name}} := option.None{{.Name}}()
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
 		assert.NotPanics(t, func() {
 			empty{{.Name}}.Unwrap()
 		})
+		assert.Equal(t, sample{{.Name}}Value(), empty{{.Name}}.UnwrapOr(sample{{.Name}}Value()))
+		assert.Equal(t, sample{{.Name}}Value(), empty{{.Name}}.UnwrapOrElse(sample{{.Name}}Value))
 	})
 }
 
-func Test{{.Name}}_UnwrapOr(t *testing.T) {
-	t.Parallel()
-
-	t.Run("some", func(t *testing.T) {
-		t.Parallel()
-
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.UnwrapOr({{.UnexpectedTestingValue}}))
-	})
-
-	t.Run("none", func(t *testing.T) {
-		t.Parallel()
-
-		empty{{.Name}} := option.None{{.Name}}()
-		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.UnwrapOr({{.UnexpectedTestingValue}}))
-	})
-}
-
-func Test{{.Name}}_UnwrapOrElse(t *testing.T) {
+func Test{{.Name}}_All(t *testing.T) {
 	t.Parallel()
 
 	t.Run("some", func(t *testing.T) {
 		t.Parallel()
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
-		assert.EqualValues(t, {{.TestingValue}}, some{{.Name}}.UnwrapOrElse(func() {{.Type}} {
-			return {{.UnexpectedTestingValue}}
-		}))
+		values := slices.Collect(Some{{.Name}}(sample{{.Name}}Value()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sample{{.Name}}Value(), values[0])
 	})
 
 	t.Run("none", func(t *testing.T) {
 		t.Parallel()
 
-		empty{{.Name}} := option.None{{.Name}}()
-		assert.EqualValues(t, {{.UnexpectedTestingValue}}, empty{{.Name}}.UnwrapOrElse(func() {{.Type}} {
-			return {{.UnexpectedTestingValue}}
-		}))
+		assert.Empty(t, slices.Collect(none{{.Name}}For(sample{{.Name}}Value).All()))
 	})
 }
 
@@ -183,15 +153,15 @@ func Test{{.Name}}_EncodeDecodeMsgpack(t *testing.T) {
 		enc := msgpack.NewEncoder(&buf)
 		dec := msgpack.NewDecoder(&buf)
 
-		some{{.Name}} := option.Some{{.Name}}({{.TestingValue}})
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
 		err := some{{.Name}}.EncodeMsgpack(enc)
 		require.NoError(t, err)
 
-		var unmarshaled option.{{.Name}}
+		unmarshaled := none{{.Name}}For(sample{{.Name}}Value)
 		err = unmarshaled.DecodeMsgpack(dec)
 		require.NoError(t, err)
 		assert.True(t, unmarshaled.IsSome())
-		assert.EqualValues(t, {{.TestingValue}}, unmarshaled.Unwrap())
+		assert.Equal(t, sample{{.Name}}Value(), unmarshaled.Unwrap())
 	})
 
 	t.Run("none", func(t *testing.T) {
@@ -202,14 +172,46 @@ func Test{{.Name}}_EncodeDecodeMsgpack(t *testing.T) {
 		enc := msgpack.NewEncoder(&buf)
 		dec := msgpack.NewDecoder(&buf)
 
-		empty{{.Name}} := option.None{{.Name}}()
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
 		err := empty{{.Name}}.EncodeMsgpack(enc)
 		require.NoError(t, err)
 
-		var unmarshaled option.{{.Name}}
+		unmarshaled := Some{{.Name}}(sample{{.Name}}Value())
 		err = unmarshaled.DecodeMsgpack(dec)
-
 		require.NoError(t, err)
 		assert.False(t, unmarshaled.IsSome())
 	})
-}
\ No newline at end of file
+}
+
+func Test{{.Name}}_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		some{{.Name}} := Some{{.Name}}(sample{{.Name}}Value())
+		require.NoError(t, some{{.Name}}.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := some{{.Name}}.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		empty{{.Name}} := none{{.Name}}For(sample{{.Name}}Value)
+		require.NoError(t, empty{{.Name}}.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := empty{{.Name}}.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
+{{- end }}
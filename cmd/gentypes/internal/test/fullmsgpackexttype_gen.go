@@ -3,7 +3,10 @@
 package test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
@@ -44,6 +47,7 @@ func (o OptionalFullMsgpackExtType) newEncodeError(err error) error {
 	if err == nil {
 		return nil
 	}
+
 	return &option.EncodeError{
 		Type:   "OptionalFullMsgpackExtType",
 		Parent: err,
@@ -56,11 +60,30 @@ func (o OptionalFullMsgpackExtType) newDecodeError(err error) error {
 	}
 
 	return &option.DecodeError{
-		Type:   "OptionalFullMsgpackExtType",
-		Parent: err,
+		Type:     "OptionalFullMsgpackExtType",
+		Expected: "FullMsgpackExtType",
+		Parent:   err,
+	}
+}
+
+func (o OptionalFullMsgpackExtType) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalFullMsgpackExtType",
+		Expected: "FullMsgpackExtType",
+		Offset:   offset,
+		Parent:   err,
 	}
 }
 
+// ExtCode returns the MessagePack extension code, that FullMsgpackExtType values are encoded with.
+func (o OptionalFullMsgpackExtType) ExtCode() int8 {
+	return 40
+}
+
 // IsSome returns true if the OptionalFullMsgpackExtType contains a value.
 // This indicates the value is explicitly set (not None).
 func (o OptionalFullMsgpackExtType) IsSome() bool {
@@ -99,10 +122,10 @@ func (o OptionalFullMsgpackExtType) Get() (FullMsgpackExtType, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with option.ErrNone if no value is set.
 func (o OptionalFullMsgpackExtType) MustGet() FullMsgpackExtType {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(option.ErrNone)
 	}
 
 	return o.value
@@ -148,13 +171,29 @@ func (o OptionalFullMsgpackExtType) UnwrapOrElse(defaultValue func() FullMsgpack
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalFullMsgpackExtType) All() iter.Seq[FullMsgpackExtType] {
+	return func(yield func(FullMsgpackExtType) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
 func (o OptionalFullMsgpackExtType) encodeValue(encoder *msgpack.Encoder) error {
 	value, err := o.value.MarshalMsgpack()
 	if err != nil {
 		return err
 	}
 
-	err = encoder.EncodeExtHeader(1, len(value))
+	err = encoder.EncodeExtHeader(40, len(value))
 	if err != nil {
 		return err
 	}
@@ -180,25 +219,49 @@ func (o OptionalFullMsgpackExtType) EncodeMsgpack(encoder *msgpack.Encoder) erro
 	return o.newEncodeError(encoder.EncodeNil())
 }
 
-func (o *OptionalFullMsgpackExtType) decodeValue(decoder *msgpack.Decoder) error {
+// AppendMsgpack appends the OptionalFullMsgpackExtType value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as FullMsgpackExtType.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalFullMsgpackExtType) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, 40, len(value)), value...), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalFullMsgpackExtType) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value FullMsgpackExtType
+
 	tp, length, err := decoder.DecodeExtHeader()
 	switch {
 	case err != nil:
-		return o.newDecodeError(err)
-	case tp != 1:
-		return o.newDecodeError(fmt.Errorf("invalid extension code: %d", tp))
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 40:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
 	}
 
 	a := make([]byte, length)
 	if err := decoder.ReadFull(a); err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
-	if err := o.value.UnmarshalMsgpack(a); err != nil {
-		return o.newDecodeError(err)
+	if err := value.UnmarshalMsgpack(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
+	o.value = value
 	o.exists = true
+
 	return nil
 }
 
@@ -217,25 +280,69 @@ func (o *OptionalFullMsgpackExtType) checkCode(code byte) bool {
 //   - on nil: exists = false, value = default zero value
 //   - on FullMsgpackExtType: exists = true, value = decoded value
 func (o *OptionalFullMsgpackExtType) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return o.newDecodeError(decoder.Skip())
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
 	case o.checkCode(code):
-		err := o.decodeValue(decoder)
-		if err != nil {
-			return o.newDecodeError(err)
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalFullMsgpackExtType",
+			Expected: "FullMsgpackExtType",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
 		}
-		o.exists = true
+	}
+}
 
-		return err
-	default:
-		return o.newDecodeError(fmt.Errorf("unexpected code: %d", code))
+// MarshalJSON encodes the OptionalFullMsgpackExtType value using JSON format.
+// - If the value is present, it is encoded as FullMsgpackExtType.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalFullMsgpackExtType) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
 	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalFullMsgpackExtType value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalFullMsgpackExtType)
+//   - FullMsgpackExtType: interpreted as a present value (SomeOptionalFullMsgpackExtType)
+//
+// Returns an error if the input can't be decoded as FullMsgpackExtType.
+func (o *OptionalFullMsgpackExtType) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalFullMsgpackExtType()
+
+		return nil
+	}
+
+	var value FullMsgpackExtType
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
 }
@@ -2,12 +2,14 @@ package test_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
 
+	"github.com/tarantool/go-option"
 	"github.com/tarantool/go-option/cmd/gentypes/internal/test"
 )
 
@@ -161,7 +163,7 @@ func TestOptionalFullMsgpackExtType_MustGet(t *testing.T) {
 
 		opt := test.NoneOptionalFullMsgpackExtType()
 
-		require.Panics(t, func() { opt.MustGet() })
+		require.PanicsWithValue(t, option.ErrNone, func() { opt.MustGet() })
 	})
 }
 
@@ -249,3 +251,48 @@ func TestOptionalFullMsgpackExtType_UnwrapOrElse(t *testing.T) {
 		}))
 	})
 }
+
+func TestOptionalFullMsgpackExtType_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		input := test.FullMsgpackExtType{
+			A: 412,
+			B: "bababa",
+		}
+
+		data, err := json.Marshal(test.SomeOptionalFullMsgpackExtType(input))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"A":412,"B":"bababa"}`, string(data))
+
+		opt := test.NoneOptionalFullMsgpackExtType()
+		require.NoError(t, json.Unmarshal(data, &opt))
+		assert.True(t, opt.IsSome())
+		assert.Equal(t, input, opt.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(test.NoneOptionalFullMsgpackExtType())
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		opt := test.SomeOptionalFullMsgpackExtType(test.FullMsgpackExtType{A: 1, B: "b"})
+		require.NoError(t, json.Unmarshal(data, &opt))
+		assert.False(t, opt.IsSome())
+		assert.Equal(t, test.NewEmptyFullMsgpackExtType(), opt.Unwrap())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		opt := test.NoneOptionalFullMsgpackExtType()
+
+		var decodeErr *option.DecodeError
+		require.ErrorAs(t, opt.UnmarshalJSON([]byte(`"string"`)), &decodeErr)
+		assert.Equal(t, "OptionalFullMsgpackExtType", decodeErr.Type)
+	})
+}
@@ -3,7 +3,10 @@
 package test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
@@ -44,6 +47,7 @@ func (o OptionalHiddenTypeAlias) newEncodeError(err error) error {
 	if err == nil {
 		return nil
 	}
+
 	return &option.EncodeError{
 		Type:   "OptionalHiddenTypeAlias",
 		Parent: err,
@@ -56,11 +60,30 @@ func (o OptionalHiddenTypeAlias) newDecodeError(err error) error {
 	}
 
 	return &option.DecodeError{
-		Type:   "OptionalHiddenTypeAlias",
-		Parent: err,
+		Type:     "OptionalHiddenTypeAlias",
+		Expected: "HiddenTypeAlias",
+		Parent:   err,
+	}
+}
+
+func (o OptionalHiddenTypeAlias) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalHiddenTypeAlias",
+		Expected: "HiddenTypeAlias",
+		Offset:   offset,
+		Parent:   err,
 	}
 }
 
+// ExtCode returns the MessagePack extension code, that HiddenTypeAlias values are encoded with.
+func (o OptionalHiddenTypeAlias) ExtCode() int8 {
+	return 50
+}
+
 // IsSome returns true if the OptionalHiddenTypeAlias contains a value.
 // This indicates the value is explicitly set (not None).
 func (o OptionalHiddenTypeAlias) IsSome() bool {
@@ -99,10 +122,10 @@ func (o OptionalHiddenTypeAlias) Get() (HiddenTypeAlias, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with option.ErrNone if no value is set.
 func (o OptionalHiddenTypeAlias) MustGet() HiddenTypeAlias {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(option.ErrNone)
 	}
 
 	return o.value
@@ -148,13 +171,29 @@ func (o OptionalHiddenTypeAlias) UnwrapOrElse(defaultValue func() HiddenTypeAlia
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalHiddenTypeAlias) All() iter.Seq[HiddenTypeAlias] {
+	return func(yield func(HiddenTypeAlias) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
 func (o OptionalHiddenTypeAlias) encodeValue(encoder *msgpack.Encoder) error {
 	value, err := o.value.MarshalMsgpack()
 	if err != nil {
 		return err
 	}
 
-	err = encoder.EncodeExtHeader(2, len(value))
+	err = encoder.EncodeExtHeader(50, len(value))
 	if err != nil {
 		return err
 	}
@@ -180,25 +219,49 @@ func (o OptionalHiddenTypeAlias) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return o.newEncodeError(encoder.EncodeNil())
 }
 
-func (o *OptionalHiddenTypeAlias) decodeValue(decoder *msgpack.Decoder) error {
+// AppendMsgpack appends the OptionalHiddenTypeAlias value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as HiddenTypeAlias.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalHiddenTypeAlias) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, 50, len(value)), value...), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalHiddenTypeAlias) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value HiddenTypeAlias
+
 	tp, length, err := decoder.DecodeExtHeader()
 	switch {
 	case err != nil:
-		return o.newDecodeError(err)
-	case tp != 2:
-		return o.newDecodeError(fmt.Errorf("invalid extension code: %d", tp))
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 50:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
 	}
 
 	a := make([]byte, length)
 	if err := decoder.ReadFull(a); err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
-	if err := o.value.UnmarshalMsgpack(a); err != nil {
-		return o.newDecodeError(err)
+	if err := value.UnmarshalMsgpack(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
+	o.value = value
 	o.exists = true
+
 	return nil
 }
 
@@ -217,25 +280,69 @@ func (o *OptionalHiddenTypeAlias) checkCode(code byte) bool {
 //   - on nil: exists = false, value = default zero value
 //   - on HiddenTypeAlias: exists = true, value = decoded value
 func (o *OptionalHiddenTypeAlias) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return o.newDecodeError(decoder.Skip())
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
 	case o.checkCode(code):
-		err := o.decodeValue(decoder)
-		if err != nil {
-			return o.newDecodeError(err)
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalHiddenTypeAlias",
+			Expected: "HiddenTypeAlias",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
 		}
-		o.exists = true
+	}
+}
 
-		return err
-	default:
-		return o.newDecodeError(fmt.Errorf("unexpected code: %d", code))
+// MarshalJSON encodes the OptionalHiddenTypeAlias value using JSON format.
+// - If the value is present, it is encoded as HiddenTypeAlias.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalHiddenTypeAlias) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
 	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalHiddenTypeAlias value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalHiddenTypeAlias)
+//   - HiddenTypeAlias: interpreted as a present value (SomeOptionalHiddenTypeAlias)
+//
+// Returns an error if the input can't be decoded as HiddenTypeAlias.
+func (o *OptionalHiddenTypeAlias) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalHiddenTypeAlias()
+
+		return nil
+	}
+
+	var value HiddenTypeAlias
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
 }
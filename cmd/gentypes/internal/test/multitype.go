@@ -0,0 +1,61 @@
+package test
+
+import (
+	"encoding/binary"
+)
+
+const (
+	pointSize = 16
+	colorSize = 3
+)
+
+// Point is a test type, that is generated together with Color into a single file.
+type Point struct {
+	X, Y int64
+}
+
+// MarshalMsgpack implements the MsgpackMarshaler interface.
+func (p *Point) MarshalMsgpack() ([]byte, error) {
+	return p.AppendExtPayload(make([]byte, 0, pointSize))
+}
+
+// AppendExtPayload implements the option.ExtPayloadAppender interface.
+func (p *Point) AppendExtPayload(dst []byte) ([]byte, error) {
+	dst = binary.BigEndian.AppendUint64(dst, uint64(p.X)) //nolint:gosec
+	dst = binary.BigEndian.AppendUint64(dst, uint64(p.Y)) //nolint:gosec
+
+	return dst, nil
+}
+
+// UnmarshalMsgpack implements the MsgpackUnmarshaler interface.
+func (p *Point) UnmarshalMsgpack(data []byte) error {
+	if len(data) != pointSize {
+		return ErrInvalidLength
+	}
+
+	p.X = int64(binary.BigEndian.Uint64(data))               //nolint:gosec
+	p.Y = int64(binary.BigEndian.Uint64(data[pointSize/2:])) //nolint:gosec
+
+	return nil
+}
+
+// Color is a test type, that is generated together with Point into a single file.
+type Color struct {
+	R, G, B uint8
+}
+
+// MarshalMsgpack implements the MsgpackMarshaler interface.
+func (c *Color) MarshalMsgpack() ([]byte, error) {
+	return []byte{c.R, c.G, c.B}, nil
+}
+
+// UnmarshalMsgpack implements the MsgpackUnmarshaler interface.
+func (c *Color) UnmarshalMsgpack(data []byte) error {
+	if len(data) != colorSize {
+		return ErrInvalidLength
+	}
+
+	c.R, c.G, c.B = data[0], data[1], data[2]
+
+	return nil
+}
@@ -0,0 +1,737 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+// OptionalPoint represents an optional value of type Point.
+// It can either hold a valid Point (IsSome == true) or be empty (IsZero == true).
+type OptionalPoint struct {
+	value  Point
+	exists bool
+}
+
+// SomeOptionalPoint creates an optional OptionalPoint with the given Point value.
+// The returned OptionalPoint will have IsSome() == true and IsZero() == false.
+func SomeOptionalPoint(value Point) OptionalPoint {
+	return OptionalPoint{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneOptionalPoint creates an empty optional OptionalPoint value.
+// The returned OptionalPoint will have IsSome() == false and IsZero() == true.
+//
+// Example:
+//
+//	o := NoneOptionalPoint()
+//	if o.IsZero() {
+//	    fmt.Println("value is absent")
+//	}
+func NoneOptionalPoint() OptionalPoint {
+	return OptionalPoint{}
+}
+
+func init() {
+	var zero Point
+
+	// Decoding of the extension into interface{} yields Point values, not raw bytes.
+	option.RegisterExtType(10, reflect.TypeOf(zero))
+	msgpack.RegisterExtEncoder(10, zero, func(_ *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+		o := SomeOptionalPoint(v.Interface().(Point))
+		return o.value.MarshalMsgpack()
+	})
+	msgpack.RegisterExtDecoder(10, zero, func(decoder *msgpack.Decoder, v reflect.Value, extLen int) error {
+		a := make([]byte, extLen)
+		if err := decoder.ReadFull(a); err != nil {
+			return err
+		}
+
+		var value Point
+
+		if err := value.UnmarshalMsgpack(a); err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(value))
+
+		return nil
+	})
+}
+
+func (o OptionalPoint) newEncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.EncodeError{
+		Type:   "OptionalPoint",
+		Parent: err,
+	}
+}
+
+func (o OptionalPoint) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPoint",
+		Expected: "Point",
+		Parent:   err,
+	}
+}
+
+func (o OptionalPoint) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPoint",
+		Expected: "Point",
+		Offset:   offset,
+		Parent:   err,
+	}
+}
+
+// ExtCode returns the MessagePack extension code, that Point values are encoded with.
+func (o OptionalPoint) ExtCode() int8 {
+	return 10
+}
+
+// IsSome returns true if the OptionalPoint contains a value.
+// This indicates the value is explicitly set (not None).
+func (o OptionalPoint) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the OptionalPoint does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o OptionalPoint) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o OptionalPoint) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of Point, false).
+//
+// Recommended usage:
+//
+//		if value, ok := o.Get(); ok {
+//	     // use value
+//		}
+func (o OptionalPoint) Get() (Point, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with option.ErrNone if no value is set.
+func (o OptionalPoint) MustGet() Point {
+	if !o.exists {
+		panic(option.ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for Point.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o OptionalPoint) Unwrap() Point {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+//
+// Example:
+//
+//	o := NoneOptionalPoint()
+//	v := o.UnwrapOr(someDefaultOptionalPoint)
+func (o OptionalPoint) UnwrapOr(defaultValue Point) Point {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+//
+// Example:
+//
+//	o := NoneOptionalPoint()
+//	v := o.UnwrapOrElse(func() Point { return computeDefault() })
+func (o OptionalPoint) UnwrapOrElse(defaultValue func() Point) Point {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalPoint) All() iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o OptionalPoint) encodeValue(encoder *msgpack.Encoder) error {
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return err
+	}
+
+	err = encoder.EncodeExtHeader(10, len(value))
+	if err != nil {
+		return err
+	}
+
+	_, err = encoder.Writer().Write(value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncodeMsgpack encodes the OptionalPoint value using MessagePack format.
+// - If the value is present, it is encoded as Point.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o OptionalPoint) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return o.newEncodeError(o.encodeValue(encoder))
+	}
+
+	return o.newEncodeError(encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the OptionalPoint value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as Point.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalPoint) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	// The payload is appended straight into dst, the header is inserted before it afterwards.
+	out, err := o.value.AppendExtPayload(dst)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return option.InsertExtHeader(out, len(dst), 10), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalPoint) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value Point
+
+	tp, length, err := decoder.DecodeExtHeader()
+	switch {
+	case err != nil:
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 10:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
+	}
+
+	a := make([]byte, length)
+	if err := decoder.ReadFull(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	if err := value.UnmarshalMsgpack(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+func (o *OptionalPoint) checkCode(code byte) bool {
+	return msgpcode.IsExt(code)
+}
+
+// DecodeMsgpack decodes a OptionalPoint value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneOptionalPoint)
+//   - Point: interpreted as a present value (SomeOptionalPoint)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on Point: exists = true, value = decoded value
+func (o *OptionalPoint) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
+	case o.checkCode(code):
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalPoint",
+			Expected: "Point",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
+		}
+	}
+}
+
+// MarshalJSON encodes the OptionalPoint value using JSON format.
+// - If the value is present, it is encoded as Point.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalPoint) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalPoint value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalPoint)
+//   - Point: interpreted as a present value (SomeOptionalPoint)
+//
+// Returns an error if the input can't be decoded as Point.
+func (o *OptionalPoint) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalPoint()
+
+		return nil
+	}
+
+	var value Point
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+// OptionalColor represents an optional value of type Color.
+// It can either hold a valid Color (IsSome == true) or be empty (IsZero == true).
+type OptionalColor struct {
+	value  Color
+	exists bool
+}
+
+// SomeOptionalColor creates an optional OptionalColor with the given Color value.
+// The returned OptionalColor will have IsSome() == true and IsZero() == false.
+func SomeOptionalColor(value Color) OptionalColor {
+	return OptionalColor{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneOptionalColor creates an empty optional OptionalColor value.
+// The returned OptionalColor will have IsSome() == false and IsZero() == true.
+//
+// Example:
+//
+//	o := NoneOptionalColor()
+//	if o.IsZero() {
+//	    fmt.Println("value is absent")
+//	}
+func NoneOptionalColor() OptionalColor {
+	return OptionalColor{}
+}
+
+func init() {
+	var zero Color
+
+	// Decoding of the extension into interface{} yields Color values, not raw bytes.
+	option.RegisterExtType(20, reflect.TypeOf(zero))
+	msgpack.RegisterExtEncoder(20, zero, func(_ *msgpack.Encoder, v reflect.Value) ([]byte, error) {
+		o := SomeOptionalColor(v.Interface().(Color))
+		return o.value.MarshalMsgpack()
+	})
+	msgpack.RegisterExtDecoder(20, zero, func(decoder *msgpack.Decoder, v reflect.Value, extLen int) error {
+		a := make([]byte, extLen)
+		if err := decoder.ReadFull(a); err != nil {
+			return err
+		}
+
+		var value Color
+
+		if err := value.UnmarshalMsgpack(a); err != nil {
+			return err
+		}
+
+		v.Set(reflect.ValueOf(value))
+
+		return nil
+	})
+}
+
+func (o OptionalColor) newEncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.EncodeError{
+		Type:   "OptionalColor",
+		Parent: err,
+	}
+}
+
+func (o OptionalColor) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalColor",
+		Expected: "Color",
+		Parent:   err,
+	}
+}
+
+func (o OptionalColor) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalColor",
+		Expected: "Color",
+		Offset:   offset,
+		Parent:   err,
+	}
+}
+
+// ExtCode returns the MessagePack extension code, that Color values are encoded with.
+func (o OptionalColor) ExtCode() int8 {
+	return 20
+}
+
+// IsSome returns true if the OptionalColor contains a value.
+// This indicates the value is explicitly set (not None).
+func (o OptionalColor) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the OptionalColor does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o OptionalColor) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o OptionalColor) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of Color, false).
+//
+// Recommended usage:
+//
+//		if value, ok := o.Get(); ok {
+//	     // use value
+//		}
+func (o OptionalColor) Get() (Color, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with option.ErrNone if no value is set.
+func (o OptionalColor) MustGet() Color {
+	if !o.exists {
+		panic(option.ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for Color.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o OptionalColor) Unwrap() Color {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+//
+// Example:
+//
+//	o := NoneOptionalColor()
+//	v := o.UnwrapOr(someDefaultOptionalColor)
+func (o OptionalColor) UnwrapOr(defaultValue Color) Color {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+//
+// Example:
+//
+//	o := NoneOptionalColor()
+//	v := o.UnwrapOrElse(func() Color { return computeDefault() })
+func (o OptionalColor) UnwrapOrElse(defaultValue func() Color) Color {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalColor) All() iter.Seq[Color] {
+	return func(yield func(Color) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o OptionalColor) encodeValue(encoder *msgpack.Encoder) error {
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return err
+	}
+
+	err = encoder.EncodeExtHeader(20, len(value))
+	if err != nil {
+		return err
+	}
+
+	_, err = encoder.Writer().Write(value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncodeMsgpack encodes the OptionalColor value using MessagePack format.
+// - If the value is present, it is encoded as Color.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o OptionalColor) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return o.newEncodeError(o.encodeValue(encoder))
+	}
+
+	return o.newEncodeError(encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the OptionalColor value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as Color.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalColor) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, 20, len(value)), value...), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalColor) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value Color
+
+	tp, length, err := decoder.DecodeExtHeader()
+	switch {
+	case err != nil:
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 20:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
+	}
+
+	a := make([]byte, length)
+	if err := decoder.ReadFull(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	if err := value.UnmarshalMsgpack(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+func (o *OptionalColor) checkCode(code byte) bool {
+	return msgpcode.IsExt(code)
+}
+
+// DecodeMsgpack decodes a OptionalColor value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneOptionalColor)
+//   - Color: interpreted as a present value (SomeOptionalColor)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on Color: exists = true, value = decoded value
+func (o *OptionalColor) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
+	case o.checkCode(code):
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalColor",
+			Expected: "Color",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
+		}
+	}
+}
+
+// MarshalJSON encodes the OptionalColor value using JSON format.
+// - If the value is present, it is encoded as Color.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalColor) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalColor value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalColor)
+//   - Color: interpreted as a present value (SomeOptionalColor)
+//
+// Returns an error if the input can't be decoded as Color.
+func (o *OptionalColor) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalColor()
+
+		return nil
+	}
+
+	var value Color
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
@@ -0,0 +1,385 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// sampleOptionalPointValue returns a value, that is used to test OptionalPoint.
+var sampleOptionalPointValue = newSamplePoint
+
+// noneOptionalPointFor returns an empty OptionalPoint for the type of values, returned by the sample function.
+func noneOptionalPointFor(func() Point) OptionalPoint {
+	return NoneOptionalPoint()
+}
+
+func TestOptionalPoint_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		assert.True(t, someOptionalPoint.IsSome())
+		assert.False(t, someOptionalPoint.IsZero())
+		assert.False(t, someOptionalPoint.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		assert.False(t, emptyOptionalPoint.IsSome())
+		assert.True(t, emptyOptionalPoint.IsZero())
+		assert.True(t, emptyOptionalPoint.IsNil())
+	})
+}
+
+func TestOptionalPoint_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		val, ok := someOptionalPoint.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalPointValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		_, ok := emptyOptionalPoint.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalPoint_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		assert.Equal(t, sampleOptionalPointValue(), someOptionalPoint.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		assert.Panics(t, func() {
+			emptyOptionalPoint.MustGet()
+		})
+	})
+}
+
+func TestOptionalPoint_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		assert.Equal(t, sampleOptionalPointValue(), someOptionalPoint.Unwrap())
+		assert.Equal(t, sampleOptionalPointValue(), someOptionalPoint.UnwrapOr(sampleOptionalPointValue()))
+		assert.Equal(t, sampleOptionalPointValue(), someOptionalPoint.UnwrapOrElse(sampleOptionalPointValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalPoint.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalPointValue(), emptyOptionalPoint.UnwrapOr(sampleOptionalPointValue()))
+		assert.Equal(t, sampleOptionalPointValue(), emptyOptionalPoint.UnwrapOrElse(sampleOptionalPointValue))
+	})
+}
+
+func TestOptionalPoint_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalPoint(sampleOptionalPointValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalPointValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalPointFor(sampleOptionalPointValue).All()))
+	})
+}
+
+func TestOptionalPoint_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		err := someOptionalPoint.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalPointFor(sampleOptionalPointValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalPointValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		err := emptyOptionalPoint.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalPoint(sampleOptionalPointValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalPoint_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalPoint := SomeOptionalPoint(sampleOptionalPointValue())
+		require.NoError(t, someOptionalPoint.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalPoint.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalPoint := noneOptionalPointFor(sampleOptionalPointValue)
+		require.NoError(t, emptyOptionalPoint.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalPoint.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
+
+// sampleOptionalColorValue returns a value, that is used to test OptionalColor.
+var sampleOptionalColorValue = newSampleColor
+
+// noneOptionalColorFor returns an empty OptionalColor for the type of values, returned by the sample function.
+func noneOptionalColorFor(func() Color) OptionalColor {
+	return NoneOptionalColor()
+}
+
+func TestOptionalColor_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		assert.True(t, someOptionalColor.IsSome())
+		assert.False(t, someOptionalColor.IsZero())
+		assert.False(t, someOptionalColor.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		assert.False(t, emptyOptionalColor.IsSome())
+		assert.True(t, emptyOptionalColor.IsZero())
+		assert.True(t, emptyOptionalColor.IsNil())
+	})
+}
+
+func TestOptionalColor_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		val, ok := someOptionalColor.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalColorValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		_, ok := emptyOptionalColor.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalColor_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		assert.Equal(t, sampleOptionalColorValue(), someOptionalColor.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		assert.Panics(t, func() {
+			emptyOptionalColor.MustGet()
+		})
+	})
+}
+
+func TestOptionalColor_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		assert.Equal(t, sampleOptionalColorValue(), someOptionalColor.Unwrap())
+		assert.Equal(t, sampleOptionalColorValue(), someOptionalColor.UnwrapOr(sampleOptionalColorValue()))
+		assert.Equal(t, sampleOptionalColorValue(), someOptionalColor.UnwrapOrElse(sampleOptionalColorValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalColor.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalColorValue(), emptyOptionalColor.UnwrapOr(sampleOptionalColorValue()))
+		assert.Equal(t, sampleOptionalColorValue(), emptyOptionalColor.UnwrapOrElse(sampleOptionalColorValue))
+	})
+}
+
+func TestOptionalColor_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalColor(sampleOptionalColorValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalColorValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalColorFor(sampleOptionalColorValue).All()))
+	})
+}
+
+func TestOptionalColor_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		err := someOptionalColor.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalColorFor(sampleOptionalColorValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalColorValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		err := emptyOptionalColor.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalColor(sampleOptionalColorValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalColor_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalColor := SomeOptionalColor(sampleOptionalColorValue())
+		require.NoError(t, someOptionalColor.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalColor.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalColor := noneOptionalColorFor(sampleOptionalColorValue)
+		require.NoError(t, emptyOptionalColor.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalColor.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
@@ -0,0 +1,143 @@
+package test_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+	"github.com/tarantool/go-option/cmd/gentypes/internal/test"
+)
+
+func TestOptionalPoint_ExtCode(t *testing.T) {
+	t.Parallel()
+
+	input := test.Point{X: 1, Y: -2}
+
+	data, err := msgpack.Marshal(test.SomeOptionalPoint(input))
+	require.NoError(t, err)
+
+	extCode, _, err := msgpack.NewDecoder(bytes.NewReader(data)).DecodeExtHeader()
+	require.NoError(t, err)
+	assert.Equal(t, int8(10), extCode)
+
+	var opt test.OptionalPoint
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, input, opt.Unwrap())
+}
+
+func TestOptionalPoint_AppendExtPayload(t *testing.T) {
+	t.Parallel()
+
+	input := test.Point{X: 1, Y: -2}
+
+	// Point appends the payload, OptionalPoint appends the complete value.
+	assert.Implements(t, (*option.ExtPayloadAppender)(nil), &input)
+	assert.NotImplements(t, (*option.ExtPayloadAppender)(nil), test.SomeOptionalPoint(input))
+
+	payload, err := input.MarshalMsgpack()
+	require.NoError(t, err)
+
+	data, err := test.SomeOptionalPoint(input).AppendMsgpack(nil)
+	require.NoError(t, err)
+	assert.Equal(t, option.AppendExtHeader(nil, 10, len(payload)), data[:len(data)-len(payload)])
+	assert.Equal(t, payload, data[len(data)-len(payload):])
+}
+
+func TestOptionalColor_ExtCode(t *testing.T) {
+	t.Parallel()
+
+	input := test.Color{R: 255, G: 128, B: 0}
+
+	data, err := msgpack.Marshal(test.SomeOptionalColor(input))
+	require.NoError(t, err)
+
+	extCode, _, err := msgpack.NewDecoder(bytes.NewReader(data)).DecodeExtHeader()
+	require.NoError(t, err)
+	assert.Equal(t, int8(20), extCode)
+
+	var opt test.OptionalColor
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, input, opt.Unwrap())
+
+	// Ext codes of types are checked on decoding.
+	var point test.OptionalPoint
+	require.ErrorIs(t, msgpack.Unmarshal(data, &point), option.ErrInvalidExtCode)
+}
+
+func TestOptionalPoint_UnexpectedCode(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal("point")
+	require.NoError(t, err)
+
+	var opt test.OptionalPoint
+
+	err = msgpack.Unmarshal(data, &opt)
+	require.ErrorIs(t, err, option.ErrUnexpectedCode)
+
+	var decodeErr *option.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "Point", decodeErr.Expected)
+	assert.Equal(t, "fixstr", decodeErr.CodeName())
+	assert.Equal(t, option.SomeInt64(0), decodeErr.Offset)
+	assert.Equal(t, "failed to decode OptionalPoint (offset 0): unexpected code fixstr (0xa5), expected Point",
+		err.Error())
+}
+
+func TestOptionalPoint_ErrorOffset(t *testing.T) {
+	t.Parallel()
+
+	// The value starts at offset 2 of [1, color].
+	data, err := msgpack.Marshal([]any{1, test.SomeOptionalColor(test.Color{R: 1, G: 2, B: 3})})
+	require.NoError(t, err)
+
+	var row struct {
+		_     struct{} `msgpack:",as_array"`
+		ID    int
+		Point test.OptionalPoint
+	}
+
+	err = msgpack.Unmarshal(data, &row)
+	require.ErrorIs(t, err, option.ErrInvalidExtCode)
+
+	var decodeErr *option.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, option.SomeInt64(2), decodeErr.Offset)
+}
+
+func TestOptionalPoint_Register(t *testing.T) {
+	t.Parallel()
+
+	point, color := test.Point{X: 1, Y: -2}, test.Color{R: 255, G: 128, B: 0}
+	assert.Equal(t, int8(10), test.SomeOptionalPoint(point).ExtCode())
+	assert.Equal(t, int8(20), test.NoneOptionalColor().ExtCode())
+
+	data, err := msgpack.Marshal([]any{test.SomeOptionalPoint(point), test.SomeOptionalColor(color)})
+	require.NoError(t, err)
+
+	// Registered extensions are decoded into interface{} as values of the underlying types.
+	var tuple []any
+	require.NoError(t, msgpack.Unmarshal(data, &tuple))
+	assert.Equal(t, []any{point, color}, tuple)
+
+	// Values of the underlying types are encoded as the same extensions.
+	plain, err := msgpack.Marshal([]any{point, &color})
+	require.NoError(t, err)
+	assert.Equal(t, data, plain)
+}
+
+func TestOptionalPoint_RegisterDuplicate(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithError(t,
+		"extension code is already registered: 10 is registered for test.Point, can't register it for test.Color",
+		func() { option.RegisterExtType(10, reflect.TypeFor[test.Color]()) })
+
+	// The registration of the same type is not a conflict.
+	assert.NotPanics(t, func() { option.RegisterExtType(10, reflect.TypeFor[test.Point]()) })
+}
@@ -0,0 +1,21 @@
+package test
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Pair is a generic test type with MarshalMsgpack and UnmarshalMsgpack methods.
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// MarshalMsgpack implements the MsgpackMarshaler interface.
+func (p *Pair[K, V]) MarshalMsgpack() ([]byte, error) {
+	return msgpack.Marshal([]any{p.Key, p.Value}) //nolint:wrapcheck
+}
+
+// UnmarshalMsgpack implements the MsgpackUnmarshaler interface.
+func (p *Pair[K, V]) UnmarshalMsgpack(data []byte) error {
+	return msgpack.Unmarshal(data, &[]any{&p.Key, &p.Value}) //nolint:wrapcheck
+}
@@ -0,0 +1,348 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+// OptionalPair represents an optional value of type Pair[K, V].
+// It can either hold a valid Pair[K, V] (IsSome == true) or be empty (IsZero == true).
+type OptionalPair[K comparable, V any] struct {
+	value  Pair[K, V]
+	exists bool
+}
+
+// SomeOptionalPair creates an optional OptionalPair with the given Pair[K, V] value.
+// The returned OptionalPair will have IsSome() == true and IsZero() == false.
+func SomeOptionalPair[K comparable, V any](value Pair[K, V]) OptionalPair[K, V] {
+	return OptionalPair[K, V]{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneOptionalPair creates an empty optional OptionalPair value.
+// The returned OptionalPair will have IsSome() == false and IsZero() == true.
+//
+// Example:
+//
+//	o := NoneOptionalPair[K, V]()
+//	if o.IsZero() {
+//	    fmt.Println("value is absent")
+//	}
+func NoneOptionalPair[K comparable, V any]() OptionalPair[K, V] {
+	return OptionalPair[K, V]{}
+}
+
+func (o OptionalPair[K, V]) newEncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.EncodeError{
+		Type:   "OptionalPair",
+		Parent: err,
+	}
+}
+
+func (o OptionalPair[K, V]) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPair",
+		Expected: "Pair[K, V]",
+		Parent:   err,
+	}
+}
+
+func (o OptionalPair[K, V]) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPair",
+		Expected: "Pair[K, V]",
+		Offset:   offset,
+		Parent:   err,
+	}
+}
+
+// ExtCode returns the MessagePack extension code, that Pair[K, V] values are encoded with.
+func (o OptionalPair[K, V]) ExtCode() int8 {
+	return 30
+}
+
+// IsSome returns true if the OptionalPair contains a value.
+// This indicates the value is explicitly set (not None).
+func (o OptionalPair[K, V]) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the OptionalPair does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o OptionalPair[K, V]) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o OptionalPair[K, V]) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of Pair[K, V], false).
+//
+// Recommended usage:
+//
+//		if value, ok := o.Get(); ok {
+//	     // use value
+//		}
+func (o OptionalPair[K, V]) Get() (Pair[K, V], bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with option.ErrNone if no value is set.
+func (o OptionalPair[K, V]) MustGet() Pair[K, V] {
+	if !o.exists {
+		panic(option.ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for Pair[K, V].
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o OptionalPair[K, V]) Unwrap() Pair[K, V] {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+//
+// Example:
+//
+//	o := NoneOptionalPair[K, V]()
+//	v := o.UnwrapOr(someDefaultOptionalPair)
+func (o OptionalPair[K, V]) UnwrapOr(defaultValue Pair[K, V]) Pair[K, V] {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+//
+// Example:
+//
+//	o := NoneOptionalPair[K, V]()
+//	v := o.UnwrapOrElse(func() Pair[K, V] { return computeDefault() })
+func (o OptionalPair[K, V]) UnwrapOrElse(defaultValue func() Pair[K, V]) Pair[K, V] {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalPair[K, V]) All() iter.Seq[Pair[K, V]] {
+	return func(yield func(Pair[K, V]) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o OptionalPair[K, V]) encodeValue(encoder *msgpack.Encoder) error {
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return err
+	}
+
+	err = encoder.EncodeExtHeader(30, len(value))
+	if err != nil {
+		return err
+	}
+
+	_, err = encoder.Writer().Write(value)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EncodeMsgpack encodes the OptionalPair value using MessagePack format.
+// - If the value is present, it is encoded as Pair[K, V].
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o OptionalPair[K, V]) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return o.newEncodeError(o.encodeValue(encoder))
+	}
+
+	return o.newEncodeError(encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the OptionalPair value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as Pair[K, V].
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalPair[K, V]) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	value, err := o.value.MarshalMsgpack()
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, 30, len(value)), value...), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalPair[K, V]) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value Pair[K, V]
+
+	tp, length, err := decoder.DecodeExtHeader()
+	switch {
+	case err != nil:
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 30:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
+	}
+
+	a := make([]byte, length)
+	if err := decoder.ReadFull(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	if err := value.UnmarshalMsgpack(a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+func (o *OptionalPair[K, V]) checkCode(code byte) bool {
+	return msgpcode.IsExt(code)
+}
+
+// DecodeMsgpack decodes a OptionalPair value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneOptionalPair)
+//   - Pair[K, V]: interpreted as a present value (SomeOptionalPair)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on Pair[K, V]: exists = true, value = decoded value
+func (o *OptionalPair[K, V]) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
+	case o.checkCode(code):
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalPair",
+			Expected: "Pair[K, V]",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
+		}
+	}
+}
+
+// MarshalJSON encodes the OptionalPair value using JSON format.
+// - If the value is present, it is encoded as Pair[K, V].
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalPair[K, V]) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalPair value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalPair)
+//   - Pair[K, V]: interpreted as a present value (SomeOptionalPair)
+//
+// Returns an error if the input can't be decoded as Pair[K, V].
+func (o *OptionalPair[K, V]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalPair[K, V]()
+
+		return nil
+	}
+
+	var value Pair[K, V]
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
@@ -0,0 +1,199 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// sampleOptionalPairValue returns a value, that is used to test OptionalPair.
+var sampleOptionalPairValue = newSamplePair
+
+// noneOptionalPairFor returns an empty OptionalPair for the type of values, returned by the sample function.
+func noneOptionalPairFor[K comparable, V any](func() Pair[K, V]) OptionalPair[K, V] {
+	return NoneOptionalPair[K, V]()
+}
+
+func TestOptionalPair_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		assert.True(t, someOptionalPair.IsSome())
+		assert.False(t, someOptionalPair.IsZero())
+		assert.False(t, someOptionalPair.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		assert.False(t, emptyOptionalPair.IsSome())
+		assert.True(t, emptyOptionalPair.IsZero())
+		assert.True(t, emptyOptionalPair.IsNil())
+	})
+}
+
+func TestOptionalPair_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		val, ok := someOptionalPair.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalPairValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		_, ok := emptyOptionalPair.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalPair_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		assert.Equal(t, sampleOptionalPairValue(), someOptionalPair.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		assert.Panics(t, func() {
+			emptyOptionalPair.MustGet()
+		})
+	})
+}
+
+func TestOptionalPair_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		assert.Equal(t, sampleOptionalPairValue(), someOptionalPair.Unwrap())
+		assert.Equal(t, sampleOptionalPairValue(), someOptionalPair.UnwrapOr(sampleOptionalPairValue()))
+		assert.Equal(t, sampleOptionalPairValue(), someOptionalPair.UnwrapOrElse(sampleOptionalPairValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalPair.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalPairValue(), emptyOptionalPair.UnwrapOr(sampleOptionalPairValue()))
+		assert.Equal(t, sampleOptionalPairValue(), emptyOptionalPair.UnwrapOrElse(sampleOptionalPairValue))
+	})
+}
+
+func TestOptionalPair_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalPair(sampleOptionalPairValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalPairValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalPairFor(sampleOptionalPairValue).All()))
+	})
+}
+
+func TestOptionalPair_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		err := someOptionalPair.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalPairFor(sampleOptionalPairValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalPairValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		err := emptyOptionalPair.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalPair(sampleOptionalPairValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalPair_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalPair := SomeOptionalPair(sampleOptionalPairValue())
+		require.NoError(t, someOptionalPair.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalPair.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalPair := noneOptionalPairFor(sampleOptionalPairValue)
+		require.NoError(t, emptyOptionalPair.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalPair.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
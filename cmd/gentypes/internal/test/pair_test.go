@@ -0,0 +1,26 @@
+package test_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+	"github.com/tarantool/go-option/cmd/gentypes/internal/test"
+)
+
+func TestOptionalPair_DecodeErrorKeepsValue(t *testing.T) {
+	t.Parallel()
+
+	// Key is decoded before the error on Value.
+	payload, err := msgpack.Marshal([]any{"key", "not a number"})
+	require.NoError(t, err)
+
+	data := append(option.AppendExtHeader(nil, 30, len(payload)), payload...)
+
+	opt := test.SomeOptionalPair(test.Pair[string, int]{Key: "answer", Value: 42})
+	require.Error(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, test.SomeOptionalPair(test.Pair[string, int]{Key: "answer", Value: 42}), opt)
+}
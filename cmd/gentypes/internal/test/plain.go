@@ -0,0 +1,37 @@
+package test
+
+import (
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// PlainStruct is a test type, that is encoded as a plain MessagePack array without an extension header.
+type PlainStruct struct {
+	_msgpack struct{} `msgpack:",as_array"` //nolint:unused
+
+	ID   uint64
+	Name string
+}
+
+// PlainCustom is a test type with msgpack.CustomEncoder and msgpack.CustomDecoder implementations.
+type PlainCustom struct {
+	Value string
+}
+
+// EncodeMsgpack implements the msgpack.CustomEncoder interface.
+func (c *PlainCustom) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	return encoder.EncodeString("custom:" + c.Value) //nolint:wrapcheck
+}
+
+// DecodeMsgpack implements the msgpack.CustomDecoder interface.
+func (c *PlainCustom) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	str, err := decoder.DecodeString()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	c.Value = strings.TrimPrefix(str, "custom:")
+
+	return nil
+}
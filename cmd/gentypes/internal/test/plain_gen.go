@@ -0,0 +1,614 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+// OptionalPlainStruct represents an optional value of type PlainStruct.
+// It can either hold a valid PlainStruct (IsSome == true) or be empty (IsZero == true).
+type OptionalPlainStruct struct {
+	value  PlainStruct
+	exists bool
+}
+
+// SomeOptionalPlainStruct creates an optional OptionalPlainStruct with the given PlainStruct value.
+// The returned OptionalPlainStruct will have IsSome() == true and IsZero() == false.
+func SomeOptionalPlainStruct(value PlainStruct) OptionalPlainStruct {
+	return OptionalPlainStruct{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneOptionalPlainStruct creates an empty optional OptionalPlainStruct value.
+// The returned OptionalPlainStruct will have IsSome() == false and IsZero() == true.
+//
+// Example:
+//
+//	o := NoneOptionalPlainStruct()
+//	if o.IsZero() {
+//	    fmt.Println("value is absent")
+//	}
+func NoneOptionalPlainStruct() OptionalPlainStruct {
+	return OptionalPlainStruct{}
+}
+
+func (o OptionalPlainStruct) newEncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.EncodeError{
+		Type:   "OptionalPlainStruct",
+		Parent: err,
+	}
+}
+
+func (o OptionalPlainStruct) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPlainStruct",
+		Expected: "PlainStruct",
+		Parent:   err,
+	}
+}
+
+func (o OptionalPlainStruct) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPlainStruct",
+		Expected: "PlainStruct",
+		Offset:   offset,
+		Parent:   err,
+	}
+}
+
+// IsSome returns true if the OptionalPlainStruct contains a value.
+// This indicates the value is explicitly set (not None).
+func (o OptionalPlainStruct) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the OptionalPlainStruct does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o OptionalPlainStruct) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o OptionalPlainStruct) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of PlainStruct, false).
+//
+// Recommended usage:
+//
+//		if value, ok := o.Get(); ok {
+//	     // use value
+//		}
+func (o OptionalPlainStruct) Get() (PlainStruct, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with option.ErrNone if no value is set.
+func (o OptionalPlainStruct) MustGet() PlainStruct {
+	if !o.exists {
+		panic(option.ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for PlainStruct.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o OptionalPlainStruct) Unwrap() PlainStruct {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+//
+// Example:
+//
+//	o := NoneOptionalPlainStruct()
+//	v := o.UnwrapOr(someDefaultOptionalPlainStruct)
+func (o OptionalPlainStruct) UnwrapOr(defaultValue PlainStruct) PlainStruct {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+//
+// Example:
+//
+//	o := NoneOptionalPlainStruct()
+//	v := o.UnwrapOrElse(func() PlainStruct { return computeDefault() })
+func (o OptionalPlainStruct) UnwrapOrElse(defaultValue func() PlainStruct) PlainStruct {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalPlainStruct) All() iter.Seq[PlainStruct] {
+	return func(yield func(PlainStruct) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o OptionalPlainStruct) encodeValue(encoder *msgpack.Encoder) error {
+	return encoder.Encode(&o.value)
+}
+
+// EncodeMsgpack encodes the OptionalPlainStruct value using MessagePack format.
+// - If the value is present, it is encoded as PlainStruct.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o OptionalPlainStruct) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return o.newEncodeError(o.encodeValue(encoder))
+	}
+
+	return o.newEncodeError(encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the OptionalPlainStruct value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as PlainStruct.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalPlainStruct) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	out, err := option.AppendValue(dst, &o.value)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return out, nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalPlainStruct) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value PlainStruct
+
+	if err := decoder.Decode(&value); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+func (o *OptionalPlainStruct) checkCode(code byte) bool {
+	return code != msgpcode.Nil
+}
+
+// DecodeMsgpack decodes a OptionalPlainStruct value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneOptionalPlainStruct)
+//   - PlainStruct: interpreted as a present value (SomeOptionalPlainStruct)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on PlainStruct: exists = true, value = decoded value
+func (o *OptionalPlainStruct) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
+	case o.checkCode(code):
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalPlainStruct",
+			Expected: "PlainStruct",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
+		}
+	}
+}
+
+// MarshalJSON encodes the OptionalPlainStruct value using JSON format.
+// - If the value is present, it is encoded as PlainStruct.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalPlainStruct) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalPlainStruct value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalPlainStruct)
+//   - PlainStruct: interpreted as a present value (SomeOptionalPlainStruct)
+//
+// Returns an error if the input can't be decoded as PlainStruct.
+func (o *OptionalPlainStruct) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalPlainStruct()
+
+		return nil
+	}
+
+	var value PlainStruct
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+// OptionalPlainCustom represents an optional value of type PlainCustom.
+// It can either hold a valid PlainCustom (IsSome == true) or be empty (IsZero == true).
+type OptionalPlainCustom struct {
+	value  PlainCustom
+	exists bool
+}
+
+// SomeOptionalPlainCustom creates an optional OptionalPlainCustom with the given PlainCustom value.
+// The returned OptionalPlainCustom will have IsSome() == true and IsZero() == false.
+func SomeOptionalPlainCustom(value PlainCustom) OptionalPlainCustom {
+	return OptionalPlainCustom{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneOptionalPlainCustom creates an empty optional OptionalPlainCustom value.
+// The returned OptionalPlainCustom will have IsSome() == false and IsZero() == true.
+//
+// Example:
+//
+//	o := NoneOptionalPlainCustom()
+//	if o.IsZero() {
+//	    fmt.Println("value is absent")
+//	}
+func NoneOptionalPlainCustom() OptionalPlainCustom {
+	return OptionalPlainCustom{}
+}
+
+func (o OptionalPlainCustom) newEncodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.EncodeError{
+		Type:   "OptionalPlainCustom",
+		Parent: err,
+	}
+}
+
+func (o OptionalPlainCustom) newDecodeError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPlainCustom",
+		Expected: "PlainCustom",
+		Parent:   err,
+	}
+}
+
+func (o OptionalPlainCustom) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalPlainCustom",
+		Expected: "PlainCustom",
+		Offset:   offset,
+		Parent:   err,
+	}
+}
+
+// IsSome returns true if the OptionalPlainCustom contains a value.
+// This indicates the value is explicitly set (not None).
+func (o OptionalPlainCustom) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the OptionalPlainCustom does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o OptionalPlainCustom) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o OptionalPlainCustom) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of PlainCustom, false).
+//
+// Recommended usage:
+//
+//		if value, ok := o.Get(); ok {
+//	     // use value
+//		}
+func (o OptionalPlainCustom) Get() (PlainCustom, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with option.ErrNone if no value is set.
+func (o OptionalPlainCustom) MustGet() PlainCustom {
+	if !o.exists {
+		panic(option.ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for PlainCustom.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o OptionalPlainCustom) Unwrap() PlainCustom {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+//
+// Example:
+//
+//	o := NoneOptionalPlainCustom()
+//	v := o.UnwrapOr(someDefaultOptionalPlainCustom)
+func (o OptionalPlainCustom) UnwrapOr(defaultValue PlainCustom) PlainCustom {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+//
+// Example:
+//
+//	o := NoneOptionalPlainCustom()
+//	v := o.UnwrapOrElse(func() PlainCustom { return computeDefault() })
+func (o OptionalPlainCustom) UnwrapOrElse(defaultValue func() PlainCustom) PlainCustom {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalPlainCustom) All() iter.Seq[PlainCustom] {
+	return func(yield func(PlainCustom) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+func (o OptionalPlainCustom) encodeValue(encoder *msgpack.Encoder) error {
+	return encoder.Encode(&o.value)
+}
+
+// EncodeMsgpack encodes the OptionalPlainCustom value using MessagePack format.
+// - If the value is present, it is encoded as PlainCustom.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o OptionalPlainCustom) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return o.newEncodeError(o.encodeValue(encoder))
+	}
+
+	return o.newEncodeError(encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the OptionalPlainCustom value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as PlainCustom.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalPlainCustom) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	out, err := option.AppendValue(dst, &o.value)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return out, nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalPlainCustom) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value PlainCustom
+
+	if err := decoder.Decode(&value); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+func (o *OptionalPlainCustom) checkCode(code byte) bool {
+	return code != msgpcode.Nil
+}
+
+// DecodeMsgpack decodes a OptionalPlainCustom value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneOptionalPlainCustom)
+//   - PlainCustom: interpreted as a present value (SomeOptionalPlainCustom)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on PlainCustom: exists = true, value = decoded value
+func (o *OptionalPlainCustom) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return o.newDecodeMsgpackError(offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
+	case o.checkCode(code):
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalPlainCustom",
+			Expected: "PlainCustom",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
+		}
+	}
+}
+
+// MarshalJSON encodes the OptionalPlainCustom value using JSON format.
+// - If the value is present, it is encoded as PlainCustom.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalPlainCustom) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalPlainCustom value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalPlainCustom)
+//   - PlainCustom: interpreted as a present value (SomeOptionalPlainCustom)
+//
+// Returns an error if the input can't be decoded as PlainCustom.
+func (o *OptionalPlainCustom) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalPlainCustom()
+
+		return nil
+	}
+
+	var value PlainCustom
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
@@ -0,0 +1,389 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// sampleOptionalPlainStructValue returns a value, that is used to test OptionalPlainStruct.
+var sampleOptionalPlainStructValue = newSamplePlainStruct
+
+// noneOptionalPlainStructFor returns an empty OptionalPlainStruct for the type of values, returned by the sample function.
+func noneOptionalPlainStructFor(func() PlainStruct) OptionalPlainStruct {
+	return NoneOptionalPlainStruct()
+}
+
+func TestOptionalPlainStruct_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		assert.True(t, someOptionalPlainStruct.IsSome())
+		assert.False(t, someOptionalPlainStruct.IsZero())
+		assert.False(t, someOptionalPlainStruct.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		assert.False(t, emptyOptionalPlainStruct.IsSome())
+		assert.True(t, emptyOptionalPlainStruct.IsZero())
+		assert.True(t, emptyOptionalPlainStruct.IsNil())
+	})
+}
+
+func TestOptionalPlainStruct_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		val, ok := someOptionalPlainStruct.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalPlainStructValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		_, ok := emptyOptionalPlainStruct.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalPlainStruct_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		assert.Equal(t, sampleOptionalPlainStructValue(), someOptionalPlainStruct.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		assert.Panics(t, func() {
+			emptyOptionalPlainStruct.MustGet()
+		})
+	})
+}
+
+func TestOptionalPlainStruct_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		assert.Equal(t, sampleOptionalPlainStructValue(), someOptionalPlainStruct.Unwrap())
+		assert.Equal(t, sampleOptionalPlainStructValue(), someOptionalPlainStruct.UnwrapOr(sampleOptionalPlainStructValue()))
+		assert.Equal(t, sampleOptionalPlainStructValue(), someOptionalPlainStruct.UnwrapOrElse(sampleOptionalPlainStructValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalPlainStruct.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalPlainStructValue(), emptyOptionalPlainStruct.UnwrapOr(sampleOptionalPlainStructValue()))
+		assert.Equal(t, sampleOptionalPlainStructValue(), emptyOptionalPlainStruct.UnwrapOrElse(sampleOptionalPlainStructValue))
+	})
+}
+
+func TestOptionalPlainStruct_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalPlainStruct(sampleOptionalPlainStructValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalPlainStructValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalPlainStructFor(sampleOptionalPlainStructValue).All()))
+	})
+}
+
+func TestOptionalPlainStruct_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		err := someOptionalPlainStruct.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalPlainStructValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		err := emptyOptionalPlainStruct.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalPlainStruct_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalPlainStruct := SomeOptionalPlainStruct(sampleOptionalPlainStructValue())
+		require.NoError(t, someOptionalPlainStruct.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalPlainStruct.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalPlainStruct := noneOptionalPlainStructFor(sampleOptionalPlainStructValue)
+		require.NoError(t, emptyOptionalPlainStruct.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalPlainStruct.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
+
+// sampleOptionalPlainCustomValue returns a value, that is used to test OptionalPlainCustom.
+func sampleOptionalPlainCustomValue() PlainCustom {
+	var value PlainCustom
+
+	return value
+}
+
+// noneOptionalPlainCustomFor returns an empty OptionalPlainCustom for the type of values, returned by the sample function.
+func noneOptionalPlainCustomFor(func() PlainCustom) OptionalPlainCustom {
+	return NoneOptionalPlainCustom()
+}
+
+func TestOptionalPlainCustom_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		assert.True(t, someOptionalPlainCustom.IsSome())
+		assert.False(t, someOptionalPlainCustom.IsZero())
+		assert.False(t, someOptionalPlainCustom.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		assert.False(t, emptyOptionalPlainCustom.IsSome())
+		assert.True(t, emptyOptionalPlainCustom.IsZero())
+		assert.True(t, emptyOptionalPlainCustom.IsNil())
+	})
+}
+
+func TestOptionalPlainCustom_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		val, ok := someOptionalPlainCustom.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalPlainCustomValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		_, ok := emptyOptionalPlainCustom.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalPlainCustom_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		assert.Equal(t, sampleOptionalPlainCustomValue(), someOptionalPlainCustom.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		assert.Panics(t, func() {
+			emptyOptionalPlainCustom.MustGet()
+		})
+	})
+}
+
+func TestOptionalPlainCustom_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		assert.Equal(t, sampleOptionalPlainCustomValue(), someOptionalPlainCustom.Unwrap())
+		assert.Equal(t, sampleOptionalPlainCustomValue(), someOptionalPlainCustom.UnwrapOr(sampleOptionalPlainCustomValue()))
+		assert.Equal(t, sampleOptionalPlainCustomValue(), someOptionalPlainCustom.UnwrapOrElse(sampleOptionalPlainCustomValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalPlainCustom.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalPlainCustomValue(), emptyOptionalPlainCustom.UnwrapOr(sampleOptionalPlainCustomValue()))
+		assert.Equal(t, sampleOptionalPlainCustomValue(), emptyOptionalPlainCustom.UnwrapOrElse(sampleOptionalPlainCustomValue))
+	})
+}
+
+func TestOptionalPlainCustom_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalPlainCustom(sampleOptionalPlainCustomValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalPlainCustomValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue).All()))
+	})
+}
+
+func TestOptionalPlainCustom_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		err := someOptionalPlainCustom.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalPlainCustomValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		err := emptyOptionalPlainCustom.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalPlainCustom_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalPlainCustom := SomeOptionalPlainCustom(sampleOptionalPlainCustomValue())
+		require.NoError(t, someOptionalPlainCustom.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalPlainCustom.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalPlainCustom := noneOptionalPlainCustomFor(sampleOptionalPlainCustomValue)
+		require.NoError(t, emptyOptionalPlainCustom.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalPlainCustom.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
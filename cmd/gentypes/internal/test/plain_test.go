@@ -0,0 +1,84 @@
+package test_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option/cmd/gentypes/internal/test"
+)
+
+func TestOptionalPlainStruct_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		input := test.PlainStruct{ID: 42, Name: "answer"}
+
+		data, err := msgpack.Marshal(test.SomeOptionalPlainStruct(input))
+		require.NoError(t, err)
+
+		// The value is encoded as is, without an extension header.
+		expected, err := msgpack.Marshal(&input)
+		require.NoError(t, err)
+		assert.Equal(t, expected, data)
+
+		var opt test.OptionalPlainStruct
+		require.NoError(t, msgpack.Unmarshal(data, &opt))
+		assert.True(t, opt.IsSome())
+		assert.Equal(t, input, opt.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(test.NoneOptionalPlainStruct())
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0xc0}, data)
+
+		opt := test.SomeOptionalPlainStruct(test.PlainStruct{ID: 1, Name: "a"})
+		require.NoError(t, msgpack.Unmarshal(data, &opt))
+		assert.False(t, opt.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal("not an array")
+		require.NoError(t, err)
+
+		var opt test.OptionalPlainStruct
+		require.Error(t, msgpack.Unmarshal(data, &opt))
+		assert.False(t, opt.IsSome())
+	})
+
+	t.Run("keeps value on error", func(t *testing.T) {
+		t.Parallel()
+
+		// ID is decoded before the error on Name.
+		data, err := msgpack.Marshal([]any{2, 3})
+		require.NoError(t, err)
+
+		opt := test.SomeOptionalPlainStruct(test.PlainStruct{ID: 1, Name: "a"})
+		require.Error(t, msgpack.Unmarshal(data, &opt))
+		assert.Equal(t, test.SomeOptionalPlainStruct(test.PlainStruct{ID: 1, Name: "a"}), opt)
+	})
+}
+
+func TestOptionalPlainCustom_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(test.SomeOptionalPlainCustom(test.PlainCustom{Value: "hello"}))
+	require.NoError(t, err)
+
+	var str string
+	require.NoError(t, msgpack.Unmarshal(data, &str))
+	assert.Equal(t, "custom:hello", str)
+
+	var opt test.OptionalPlainCustom
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, test.PlainCustom{Value: "hello"}, opt.Unwrap())
+}
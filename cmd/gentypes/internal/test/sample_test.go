@@ -0,0 +1,19 @@
+package test
+
+// Sample values for generated tests, see -test-constructor flag of gentypes.
+
+func newSamplePoint() Point {
+	return Point{X: 3, Y: -4}
+}
+
+func newSampleColor() Color {
+	return Color{R: 12, G: 34, B: 56}
+}
+
+func newSamplePlainStruct() PlainStruct {
+	return PlainStruct{ID: 42, Name: "answer"}
+}
+
+func newSamplePair() Pair[string, int] {
+	return Pair[string, int]{Key: "answer", Value: 42}
+}
@@ -5,7 +5,10 @@ package test
 import (
 	"github.com/google/uuid"
 
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"iter"
 
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
@@ -46,6 +49,7 @@ func (o OptionalUUID) newEncodeError(err error) error {
 	if err == nil {
 		return nil
 	}
+
 	return &option.EncodeError{
 		Type:   "OptionalUUID",
 		Parent: err,
@@ -58,11 +62,30 @@ func (o OptionalUUID) newDecodeError(err error) error {
 	}
 
 	return &option.DecodeError{
-		Type:   "OptionalUUID",
-		Parent: err,
+		Type:     "OptionalUUID",
+		Expected: "uuid.UUID",
+		Parent:   err,
+	}
+}
+
+func (o OptionalUUID) newDecodeMsgpackError(offset option.Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &option.DecodeError{
+		Type:     "OptionalUUID",
+		Expected: "uuid.UUID",
+		Offset:   offset,
+		Parent:   err,
 	}
 }
 
+// ExtCode returns the MessagePack extension code, that uuid.UUID values are encoded with.
+func (o OptionalUUID) ExtCode() int8 {
+	return 100
+}
+
 // IsSome returns true if the OptionalUUID contains a value.
 // This indicates the value is explicitly set (not None).
 func (o OptionalUUID) IsSome() bool {
@@ -101,10 +124,10 @@ func (o OptionalUUID) Get() (uuid.UUID, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with option.ErrNone if no value is set.
 func (o OptionalUUID) MustGet() uuid.UUID {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(option.ErrNone)
 	}
 
 	return o.value
@@ -150,13 +173,29 @@ func (o OptionalUUID) UnwrapOrElse(defaultValue func() uuid.UUID) uuid.UUID {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+//
+// Example:
+//
+//	for v := range o.All() {
+//	    use(v)
+//	}
+func (o OptionalUUID) All() iter.Seq[uuid.UUID] {
+	return func(yield func(uuid.UUID) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
 func (o OptionalUUID) encodeValue(encoder *msgpack.Encoder) error {
 	value, err := encodeUUID(o.value)
 	if err != nil {
 		return err
 	}
 
-	err = encoder.EncodeExtHeader(3, len(value))
+	err = encoder.EncodeExtHeader(100, len(value))
 	if err != nil {
 		return err
 	}
@@ -182,25 +221,49 @@ func (o OptionalUUID) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return o.newEncodeError(encoder.EncodeNil())
 }
 
-func (o *OptionalUUID) decodeValue(decoder *msgpack.Decoder) error {
+// AppendMsgpack appends the OptionalUUID value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o OptionalUUID) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return append(dst, msgpcode.Nil), nil
+	}
+
+	value, err := encodeUUID(o.value)
+	if err != nil {
+		return dst, o.newEncodeError(err)
+	}
+
+	return append(option.AppendExtHeader(dst, 100, len(value)), value...), nil
+}
+
+// decodeValue decodes the value into a local variable, so the optional is not changed on errors.
+func (o *OptionalUUID) decodeValue(decoder *msgpack.Decoder, offset option.Int64) error {
+	var value uuid.UUID
+
 	tp, length, err := decoder.DecodeExtHeader()
 	switch {
 	case err != nil:
-		return o.newDecodeError(err)
-	case tp != 3:
-		return o.newDecodeError(fmt.Errorf("invalid extension code: %d", tp))
+		return o.newDecodeMsgpackError(offset, err)
+	case tp != 100:
+		return o.newDecodeMsgpackError(offset, fmt.Errorf("%w: %d", option.ErrInvalidExtCode, tp))
 	}
 
 	a := make([]byte, length)
 	if err := decoder.ReadFull(a); err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
-	if err := decodeUUID(&o.value, a); err != nil {
-		return o.newDecodeError(err)
+	if err := decodeUUID(&value, a); err != nil {
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
+	o.value = value
 	o.exists = true
+
 	return nil
 }
 
@@ -219,25 +282,69 @@ func (o *OptionalUUID) checkCode(code byte) bool {
 //   - on nil: exists = false, value = default zero value
 //   - on uuid.UUID: exists = true, value = decoded value
 func (o *OptionalUUID) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := option.DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return o.newDecodeError(err)
+		return o.newDecodeMsgpackError(offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return o.newDecodeError(decoder.Skip())
+		return o.newDecodeMsgpackError(offset, decoder.Skip())
 	case o.checkCode(code):
-		err := o.decodeValue(decoder)
-		if err != nil {
-			return o.newDecodeError(err)
+		return o.decodeValue(decoder, offset)
+	default:
+		return &option.DecodeError{
+			Type:     "OptionalUUID",
+			Expected: "uuid.UUID",
+			Code:     option.SomeByte(code),
+			Offset:   offset,
 		}
-		o.exists = true
+	}
+}
 
-		return err
-	default:
-		return o.newDecodeError(fmt.Errorf("unexpected code: %d", code))
+// MarshalJSON encodes the OptionalUUID value using JSON format.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o OptionalUUID) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(o.value)
+	if err != nil {
+		return nil, o.newEncodeError(err)
 	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a OptionalUUID value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneOptionalUUID)
+//   - uuid.UUID: interpreted as a present value (SomeOptionalUUID)
+//
+// Returns an error if the input can't be decoded as uuid.UUID.
+func (o *OptionalUUID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = NoneOptionalUUID()
+
+		return nil
+	}
+
+	var value uuid.UUID
+
+	if err := json.Unmarshal(data, &value); err != nil {
+		return o.newDecodeError(err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
 }
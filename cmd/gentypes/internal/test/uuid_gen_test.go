@@ -0,0 +1,205 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+import (
+	"github.com/google/uuid"
+
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// sampleOptionalUUIDValue returns a value, that is used to test OptionalUUID.
+func sampleOptionalUUIDValue() uuid.UUID {
+	var value uuid.UUID
+
+	return value
+}
+
+// noneOptionalUUIDFor returns an empty OptionalUUID for the type of values, returned by the sample function.
+func noneOptionalUUIDFor(func() uuid.UUID) OptionalUUID {
+	return NoneOptionalUUID()
+}
+
+func TestOptionalUUID_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		assert.True(t, someOptionalUUID.IsSome())
+		assert.False(t, someOptionalUUID.IsZero())
+		assert.False(t, someOptionalUUID.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		assert.False(t, emptyOptionalUUID.IsSome())
+		assert.True(t, emptyOptionalUUID.IsZero())
+		assert.True(t, emptyOptionalUUID.IsNil())
+	})
+}
+
+func TestOptionalUUID_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		val, ok := someOptionalUUID.Get()
+		require.True(t, ok)
+		assert.Equal(t, sampleOptionalUUIDValue(), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		_, ok := emptyOptionalUUID.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestOptionalUUID_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		assert.Equal(t, sampleOptionalUUIDValue(), someOptionalUUID.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		assert.Panics(t, func() {
+			emptyOptionalUUID.MustGet()
+		})
+	})
+}
+
+func TestOptionalUUID_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		assert.Equal(t, sampleOptionalUUIDValue(), someOptionalUUID.Unwrap())
+		assert.Equal(t, sampleOptionalUUIDValue(), someOptionalUUID.UnwrapOr(sampleOptionalUUIDValue()))
+		assert.Equal(t, sampleOptionalUUIDValue(), someOptionalUUID.UnwrapOrElse(sampleOptionalUUIDValue))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		assert.NotPanics(t, func() {
+			emptyOptionalUUID.Unwrap()
+		})
+		assert.Equal(t, sampleOptionalUUIDValue(), emptyOptionalUUID.UnwrapOr(sampleOptionalUUIDValue()))
+		assert.Equal(t, sampleOptionalUUIDValue(), emptyOptionalUUID.UnwrapOrElse(sampleOptionalUUIDValue))
+	})
+}
+
+func TestOptionalUUID_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(SomeOptionalUUID(sampleOptionalUUIDValue()).All())
+		require.Len(t, values, 1)
+		assert.Equal(t, sampleOptionalUUIDValue(), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(noneOptionalUUIDFor(sampleOptionalUUIDValue).All()))
+	})
+}
+
+func TestOptionalUUID_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		err := someOptionalUUID.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, sampleOptionalUUIDValue(), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		err := emptyOptionalUUID.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		unmarshaled := SomeOptionalUUID(sampleOptionalUUIDValue())
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestOptionalUUID_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someOptionalUUID := SomeOptionalUUID(sampleOptionalUUIDValue())
+		require.NoError(t, someOptionalUUID.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someOptionalUUID.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		emptyOptionalUUID := noneOptionalUUIDFor(sampleOptionalUUIDValue)
+		require.NoError(t, emptyOptionalUUID.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := emptyOptionalUUID.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+}
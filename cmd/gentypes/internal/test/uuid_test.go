@@ -0,0 +1,31 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestOptionalUUID_RegisterExtCodec(t *testing.T) {
+	t.Parallel()
+
+	option.RegisterExtCodec(100, encodeUUID, decodeUUID)
+
+	id := uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")
+
+	expected, err := msgpack.Marshal(SomeOptionalUUID(id))
+	require.NoError(t, err)
+
+	data, err := msgpack.Marshal(option.Some(id))
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	var opt option.Generic[uuid.UUID]
+	require.NoError(t, msgpack.Unmarshal(expected, &opt))
+	assert.Equal(t, option.Some(id), opt)
+}
@@ -5,6 +5,7 @@ package main
 import (
 	"bytes"
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"go/format"
@@ -14,6 +15,7 @@ import (
 	"strings"
 
 	"golang.org/x/tools/go/packages"
+	goimports "golang.org/x/tools/imports"
 
 	"github.com/tarantool/go-option/cmd/gentypes/extractor"
 	"github.com/tarantool/go-option/cmd/gentypes/generator"
@@ -31,6 +33,13 @@ var (
 	imports             stringListFlag
 	customMarshalFunc   string
 	customUnmarshalFunc string
+	outputFile          string
+	mode                string
+	tests               bool
+	testConstructors    stringListFlag
+	register            bool
+	autoExtCode         bool
+	check               bool
 )
 
 func logfuncf(format string, args ...any) {
@@ -122,27 +131,139 @@ func constructFileName(name string) string {
 	return strings.ToLower(name) + "_gen.go"
 }
 
+func constructTestFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".go") + "_test.go"
+}
+
+func formatGoSource(fileName string, source []byte) ([]byte, error) {
+	if strings.HasSuffix(fileName, "_test.go") {
+		// Imports, that are required by generated types, may be unused in tests.
+		return goimports.Process(filepath.Join(packagePath, fileName), source, nil) //nolint:wrapcheck
+	}
+
+	return format.Source(source) //nolint:wrapcheck
+}
+
+func writeGoSource(fileName string, source []byte) {
+	formattedGoSource, err := formatGoSource(fileName, source)
+	if err != nil {
+		fmt.Println("failed to format generated code: ", err)
+		printFile("> ", source)
+		os.Exit(1)
+	}
+
+	err = os.WriteFile(filepath.Join(packagePath, fileName), formattedGoSource, defaultGoPermissions)
+	if err != nil {
+		fmt.Println("failed to write generated code:")
+		fmt.Println("    ", err)
+		os.Exit(1)
+	}
+}
+
+// checkMarshalers checks signatures of MarshalMsgpack and UnmarshalMsgpack methods of the type,
+// or of the custom functions, if they are set.
+func checkMarshalers(analyzer *extractor.Analyzer, entry *extractor.TypeSpecEntry) error {
+	var marshalErr, unmarshalErr error
+
+	if customMarshalFunc != "" {
+		marshalErr = analyzer.CheckMarshalFunc(customMarshalFunc, entry)
+	} else {
+		marshalErr = entry.CheckMarshalMethod()
+	}
+
+	if customUnmarshalFunc != "" {
+		unmarshalErr = analyzer.CheckUnmarshalFunc(customUnmarshalFunc, entry)
+	} else {
+		unmarshalErr = entry.CheckUnmarshalMethod()
+	}
+
+	return errors.Join(marshalErr, unmarshalErr)
+}
+
+// runCheck reports extension code collisions in the module and exits.
+func runCheck() {
+	errs, err := checkModuleExtCodes(packagePath)
+	if err != nil {
+		fmt.Println("failed to check extension codes:")
+		fmt.Println("    ", err)
+		os.Exit(1)
+	}
+
+	for _, err := range errs {
+		fmt.Println(err)
+	}
+
+	if len(errs) > 0 {
+		os.Exit(1)
+	}
+
+	fmt.Println("no extension code collisions found")
+	os.Exit(0)
+}
+
 func main() { //nolint:funlen
 	generator.InitializeTemplates()
 
 	ctx := context.Background()
 
 	flag.StringVar(&packagePath, "package", "./", "input and output path")
-	flag.IntVar(&extCode, "ext-code", undefinedExtCode, "extension code")
+	extCode = undefinedExtCode
+	flag.Func("ext-code",
+		"extension code, for several types it is the first code of the range, "+
+			"'auto' assigns free codes and persists them in "+extCodeLockFile, parseExtCodeFlag)
 	flag.BoolVar(&verbose, "verbose", false, "print verbose output")
-	flag.BoolVar(&force, "force", false, "generate files even if methods do not exist")
+	flag.BoolVar(&force, "force", false,
+		"generate files without checks of marshal and unmarshal methods or functions")
 	flag.Var(&imports, "imports", "imports to add to generated files")
 	flag.StringVar(&customMarshalFunc, "marshal-func", "", "custom marshal function")
 	flag.StringVar(&customUnmarshalFunc, "unmarshal-func", "", "custom unmarshal function")
+	flag.StringVar(&outputFile, "output", "",
+		"write all optional types to a single file (default: a separate file per type)")
+	flag.StringVar(&mode, "mode", string(generator.ModeExt),
+		"encoding mode: 'ext' for MessagePack extensions or 'plain' for values encoded as is")
+	flag.BoolVar(&tests, "tests", false, "generate tests for optional types into <file>_test.go")
+	flag.Var(&testConstructors, "test-constructor",
+		"function, that returns a sample value for tests, as Type=Func (or Func for a single type)")
+	flag.BoolVar(&register, "register", false,
+		"register types with msgpack.RegisterExtEncoder and msgpack.RegisterExtDecoder in init()")
+	flag.BoolVar(&check, "check", false,
+		"check extension codes of all gentypes directives and generated files in the module for collisions")
 	flag.Parse()
 
+	if check {
+		runCheck()
+	}
+
+	plain := false
+
+	switch generator.Mode(mode) {
+	case generator.ModeExt:
+	case generator.ModePlain:
+		plain = true
+	default:
+		fmt.Println("invalid mode:", mode)
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
 	switch {
-	case extCode == undefinedExtCode:
-		fmt.Println("extension code is not set")
+	case plain && (extCode != undefinedExtCode || autoExtCode):
+		fmt.Println("extension code is not used in plain mode")
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	case plain && (customMarshalFunc != "" || customUnmarshalFunc != ""):
+		fmt.Println("custom marshal and unmarshal functions are not used in plain mode")
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	case plain && register:
+		fmt.Println("types are registered only in ext mode")
 
 		flag.PrintDefaults()
 		os.Exit(1)
-	case !checkMsgpackExtCode(extCode):
+	case extCode != undefinedExtCode && !checkMsgpackExtCode(extCode):
 		fmt.Println("invalid extension code:", extCode)
 		fmt.Println("extension code must be in range [-128, 127]")
 
@@ -150,6 +271,57 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 
+	args := flag.Args() // Args contains names of struct to generate optional types.
+	switch {
+	case len(args) == 0:
+		fmt.Println("no struct name provided")
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	case len(args) > 1 && (customMarshalFunc != "" || customUnmarshalFunc != ""):
+		fmt.Println("custom marshal and unmarshal functions are supported only for a single type")
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	var (
+		typeArgs []typeArg
+		err      error
+	)
+
+	if autoExtCode {
+		args, err = assignAutoExtCodes(packagePath, args)
+		if err != nil {
+			fmt.Println("failed to assign extension codes:")
+			fmt.Println("    ", err)
+			os.Exit(1)
+		}
+	}
+
+	if plain {
+		typeArgs, err = parsePlainTypeArgs(args)
+	} else {
+		typeArgs, err = parseTypeArgs(args, extCode)
+	}
+
+	if err != nil {
+		fmt.Println("failed to parse type names:")
+		fmt.Println("    ", err)
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
+	testConstructorByType, err := parseTestConstructors(testConstructors, typeArgs)
+	if err != nil {
+		fmt.Println("failed to parse test constructors:")
+		fmt.Println("    ", err)
+
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+
 	packageList, err := readGoFiles(ctx, packagePath)
 	switch {
 	case err != nil:
@@ -173,71 +345,110 @@ func main() { //nolint:funlen
 		os.Exit(1)
 	}
 
-	args := flag.Args() // Args contains names of struct to generate optional types.
-	switch {
-	case len(args) == 0:
-		fmt.Println("no struct name provided")
+	typeOptions := make([]generator.TypeOptions, 0, len(typeArgs))
 
-		flag.PrintDefaults()
-		os.Exit(1)
-	case len(args) > 1:
-		fmt.Println("too many arguments")
+	for _, arg := range typeArgs {
+		typeName := arg.Name
 
-		flag.PrintDefaults()
-		os.Exit(1)
-	}
+		// Check for existence of all types that we want to generate.
+		typeSpecDef, ok := analyzer.TypeSpecEntryByName(typeName)
+		switch {
+		case !ok && isExternalDep(typeName):
+			fmt.Println("failed to resolve type, probably its package is not imported:", typeName)
+		case !ok:
+			fmt.Println("failed to find type:", typeName)
+			os.Exit(1)
+		}
 
-	typeName := args[0]
+		fmt.Println("generating optional:", typeName)
 
-	// Check for existence of all types that we want to generate.
-	typeSpecDef, ok := analyzer.TypeSpecEntryByName(typeName)
-	switch {
-	case isExternalDep(typeName):
-		fmt.Println("typename contains dot, probably third party type:", typeName)
-	case !ok:
-		fmt.Println("failed to find struct:", typeName)
-		os.Exit(1)
-	}
+		if !force && !plain && typeSpecDef != nil {
+			err := checkMarshalers(analyzer, typeSpecDef)
+			if err != nil {
+				fmt.Println("failed to check marshal and unmarshal functions for type:", typeName)
 
-	fmt.Println("generating optional:", typeName)
+				for _, line := range strings.Split(err.Error(), "\n") {
+					fmt.Println("    ", line)
+				}
 
-	switch {
-	case force || isExternalDep(typeName):
-		// Skipping check for MarshalMsgpack and UnmarshalMsgpack methods.
-	case !typeSpecDef.HasMethod("MarshalMsgpack") || !typeSpecDef.HasMethod("UnmarshalMsgpack"):
-		fmt.Println("failed to find MarshalMsgpack or UnmarshalMsgpack method for struct:", typeName)
-		os.Exit(1)
-	}
+				os.Exit(1)
+			}
+		}
 
-	generatedGoSources, err := generator.GenerateByType(generator.GenerateOptions{
-		TypeName:            typeName,
-		ExtCode:             extCode,
-		PackageName:         pkg.Name,
-		Imports:             imports,
-		CustomMarshalFunc:   customMarshalFunc,
-		CustomUnmarshalFunc: customUnmarshalFunc,
-	})
-	if err != nil {
-		fmt.Println("failed to generate optional types:")
-		fmt.Println("    ", err)
-		os.Exit(1)
+		var typeParams, typeParamNames string
+		if typeSpecDef != nil {
+			typeParams, typeParamNames = typeSpecDef.TypeParams(), typeSpecDef.TypeArgs()
+		}
+
+		if tests && typeParams != "" && testConstructorByType[typeName] == "" {
+			fmt.Println("test constructor is required to generate tests for generic type:", typeName)
+			os.Exit(1)
+		}
+
+		if register && typeParams != "" {
+			fmt.Println("generic type can't be registered with msgpack:", typeName)
+			os.Exit(1)
+		}
+
+		typeOptions = append(typeOptions, generator.TypeOptions{
+			TypeName:            typeName,
+			ExtCode:             arg.ExtCode,
+			Mode:                generator.Mode(mode),
+			CustomMarshalFunc:   customMarshalFunc,
+			CustomUnmarshalFunc: customUnmarshalFunc,
+			Register:            register,
+			AppendMarshaler:     typeSpecDef != nil && typeSpecDef.IsAppendMarshaler(),
+			ExtPayloadAppender:  typeSpecDef != nil && typeSpecDef.IsExtPayloadAppender(),
+			TestConstructor:     testConstructorByType[typeName],
+			TypeParams:          typeParams,
+			TypeArgs:            typeParamNames,
+		})
 	}
 
-	formattedGoSource, err := format.Source(generatedGoSources)
-	if err != nil {
-		fmt.Println("failed to format generated code: ", err)
-		printFile("> ", generatedGoSources)
-		os.Exit(1)
+	// Group types by output file: either a single file for all types or a file per type.
+	files := make(map[string][]generator.TypeOptions, len(typeOptions))
+	fileNames := make([]string, 0, len(typeOptions))
+
+	for _, typeOpts := range typeOptions {
+		fileName := outputFile
+		if fileName == "" {
+			fileName = constructFileName(typeOpts.TypeName)
+		}
+
+		if _, ok := files[fileName]; !ok {
+			fileNames = append(fileNames, fileName)
+		}
+
+		files[fileName] = append(files[fileName], typeOpts)
 	}
 
-	err = os.WriteFile(
-		filepath.Join(packagePath, constructFileName(typeName)),
-		formattedGoSource,
-		defaultGoPermissions,
-	)
-	if err != nil {
-		fmt.Println("failed to write generated code:")
-		fmt.Println("    ", err)
-		os.Exit(1)
+	for _, fileName := range fileNames {
+		fileOptions := generator.FileOptions{
+			PackageName: pkg.Name,
+			Imports:     imports,
+			Types:       files[fileName],
+		}
+
+		generatedGoSources, err := generator.GenerateFile(fileOptions)
+		if err != nil {
+			fmt.Println("failed to generate optional types:")
+			fmt.Println("    ", err)
+			os.Exit(1)
+		}
+
+		writeGoSource(fileName, generatedGoSources)
+
+		if !tests {
+			continue
+		}
+
+		generatedGoTestSources, err := generator.GenerateTestFile(fileOptions)
+		if err != nil {
+			fmt.Println("failed to generate tests for optional types:")
+			fmt.Println("    ", err)
+			os.Exit(1)
+		}
+
+		writeGoSource(constructTestFileName(fileName), generatedGoTestSources)
 	}
 }
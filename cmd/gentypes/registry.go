@@ -0,0 +1,738 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/tarantool/go-option/cmd/gentypes/generator"
+)
+
+const (
+	// autoExtCodeValue is the value of -ext-code, that assigns free codes to types automatically.
+	autoExtCodeValue = "auto"
+	// firstAutoExtCode is the first code, assigned automatically. Codes below it are reserved by Tarantool.
+	firstAutoExtCode = 9
+	// extCodeLockFile is the name of the file in the module root, that persists codes, assigned automatically.
+	extCodeLockFile   = "gentypes.lock"
+	extCodeLockHeader = "# Extension codes, assigned by gentypes -ext-code=auto. Edit with care."
+)
+
+var (
+	errModuleNotFound   = errors.New("go.mod is not found")
+	errNoFreeExtCode    = errors.New("no free extension code")
+	errExtCodeCollision = errors.New("extension code collision")
+	errReservedExtCode  = errors.New("extension code is reserved")
+	errExtCodeMismatch  = errors.New("extension code mismatch")
+	errInvalidLockLine  = errors.New("invalid line")
+)
+
+// reservedExtCodes are extension codes, that are used by Tarantool.
+var reservedExtCodes = map[int]string{
+	1: "Tarantool decimal",
+	2: "Tarantool uuid",
+	3: "Tarantool error",
+	4: "Tarantool datetime",
+	5: "Tarantool compression",
+	6: "Tarantool interval",
+	7: "Tarantool tuple",
+	8: "Tarantool arrow",
+}
+
+// reservedExtCode returns the user of the extension code, if the code is reserved. Negative codes
+// are reserved by the MessagePack specification for predefined types.
+func reservedExtCode(code int) (string, bool) {
+	switch {
+	case code == -1:
+		return "MessagePack timestamp", true
+	case code < 0:
+		return "MessagePack predefined types", true
+	}
+
+	reserved, ok := reservedExtCodes[code]
+
+	return reserved, ok
+}
+
+// extCodeKey identifies an optional type in a module.
+type extCodeKey struct {
+	// Dir is the package directory, relative to the module root, with forward slashes.
+	Dir string
+	// TypeName is the name of the optional type, e.g. OptionalPoint.
+	TypeName string
+}
+
+func (k extCodeKey) String() string {
+	if k.Dir == "." {
+		return k.TypeName
+	}
+
+	return k.Dir + "." + k.TypeName
+}
+
+// extCodeEntry is an extension code of an optional type, found in a go:generate directive
+// or in a generated file.
+type extCodeEntry struct {
+	Key     extCodeKey
+	ExtCode int
+	// Source is the position, where the code is found.
+	Source string
+}
+
+// extCodeLock maps optional types to extension codes, assigned with -ext-code=auto.
+type extCodeLock map[extCodeKey]int
+
+func constructOptionalName(name string) string {
+	if _, after, ok := strings.Cut(name, "."); ok {
+		name = after
+	}
+
+	return "Optional" + name
+}
+
+// parseExtCodeFlag parses the value of -ext-code: a number or "auto".
+func parseExtCodeFlag(value string) error {
+	if value == autoExtCodeValue {
+		autoExtCode = true
+		return nil
+	}
+
+	code, err := strconv.Atoi(value)
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	extCode = code
+
+	return nil
+}
+
+// findModuleRoot returns the closest directory with go.mod, starting from dir.
+func findModuleRoot(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errModuleNotFound
+		}
+
+		dir = parent
+	}
+}
+
+// moduleDir returns the directory, relative to the module root, with forward slashes.
+func moduleDir(root, dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return "", err //nolint:wrapcheck
+	}
+
+	return filepath.ToSlash(rel), nil
+}
+
+// readExtCodeLock reads the lock file. A missing file is an empty lock.
+func readExtCodeLock(fileName string) (extCodeLock, error) {
+	lock := extCodeLock{}
+
+	file, err := os.Open(fileName) //nolint:gosec
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return lock, nil
+	case err != nil:
+		return nil, err //nolint:wrapcheck
+	}
+	defer func() { _ = file.Close() }()
+
+	scanner := bufio.NewScanner(file)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 { //nolint:mnd
+			return nil, fmt.Errorf("%s:%d: %w: %q", fileName, lineNo, errInvalidLockLine, line)
+		}
+
+		code, err := strconv.Atoi(fields[2])
+		if err != nil || !checkMsgpackExtCode(code) {
+			return nil, fmt.Errorf("%s:%d: %w: %q", fileName, lineNo, errInvalidExtCode, fields[2])
+		}
+
+		lock[extCodeKey{Dir: fields[0], TypeName: fields[1]}] = code
+	}
+
+	return lock, scanner.Err() //nolint:wrapcheck
+}
+
+// writeExtCodeLock writes the lock file, sorted by package and type.
+func writeExtCodeLock(fileName string, lock extCodeLock) error {
+	keys := make([]extCodeKey, 0, len(lock))
+	for key := range lock {
+		keys = append(keys, key)
+	}
+
+	slices.SortFunc(keys, func(a, b extCodeKey) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	var buf strings.Builder
+
+	buf.WriteString(extCodeLockHeader + "\n")
+
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s %s %d\n", key.Dir, key.TypeName, lock[key])
+	}
+
+	return os.WriteFile(fileName, []byte(buf.String()), defaultGoPermissions) //nolint:wrapcheck
+}
+
+// splitDirective splits the go:generate directive into words. Double-quoted strings are single words,
+// as they are for go generate.
+func splitDirective(line string) ([]string, error) {
+	var words []string
+
+	for line = strings.TrimSpace(line); line != ""; line = strings.TrimSpace(line) {
+		if line[0] != '"' {
+			word, rest, _ := strings.Cut(line, " ")
+			words = append(words, word)
+			line = rest
+
+			continue
+		}
+
+		prefix, err := strconv.QuotedPrefix(line)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		word, err := strconv.Unquote(prefix)
+		if err != nil {
+			return nil, err //nolint:wrapcheck
+		}
+
+		words = append(words, word)
+		line = line[len(prefix):]
+	}
+
+	return words, nil
+}
+
+// gentypesArgs returns the arguments of gentypes in the go:generate directive, or false
+// if the directive doesn't run gentypes.
+func gentypesArgs(words []string) ([]string, bool) {
+	for i, word := range words {
+		name, _, _ := strings.Cut(path.Base(word), "@")
+		if name == "gentypes" {
+			return words[i+1:], true
+		}
+	}
+
+	return nil, false
+}
+
+// parseDirective returns extension codes of types, generated by the go:generate directive in dir.
+// Codes of types, generated with -ext-code=auto, are taken from the lock.
+func parseDirective(root, dir string, args []string, lock extCodeLock) ([]extCodeKey, []int, error) {
+	flagSet := flag.NewFlagSet("gentypes", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	var ignored stringListFlag
+
+	directiveExtCode := flagSet.String("ext-code", "", "")
+	directivePackage := flagSet.String("package", "./", "")
+	directiveMode := flagSet.String("mode", "", "")
+
+	for _, name := range []string{"verbose", "force", "tests", "register", "check"} {
+		flagSet.Bool(name, false, "")
+	}
+
+	for _, name := range []string{"imports", "marshal-func", "unmarshal-func", "output", "test-constructor"} {
+		flagSet.Var(&ignored, name, "")
+	}
+
+	if err := flagSet.Parse(args); err != nil {
+		return nil, nil, err //nolint:wrapcheck
+	}
+
+	if generator.Mode(*directiveMode) == generator.ModePlain {
+		return nil, nil, nil
+	}
+
+	pkgDir, err := moduleDir(root, filepath.Join(dir, *directivePackage))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if *directiveExtCode == autoExtCodeValue {
+		var keys []extCodeKey
+
+		var codes []int
+
+		for _, arg := range flagSet.Args() {
+			name, codeStr, hasCode := strings.Cut(arg, "=")
+			key := extCodeKey{Dir: pkgDir, TypeName: constructOptionalName(name)}
+
+			code, ok := lock[key]
+			if hasCode {
+				code, err = strconv.Atoi(codeStr)
+				if err != nil {
+					return nil, nil, fmt.Errorf("invalid extension code for type %s: %w", name, err)
+				}
+			}
+
+			// Types without a code in the lock are not generated yet.
+			if hasCode || ok {
+				keys, codes = append(keys, key), append(codes, code)
+			}
+		}
+
+		return keys, codes, nil
+	}
+
+	firstExtCode := undefinedExtCode
+	if *directiveExtCode != "" {
+		firstExtCode, err = strconv.Atoi(*directiveExtCode)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid extension code: %w", err)
+		}
+	}
+
+	typeArgs, err := parseTypeArgs(flagSet.Args(), firstExtCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keys := make([]extCodeKey, 0, len(typeArgs))
+	codes := make([]int, 0, len(typeArgs))
+
+	for _, arg := range typeArgs {
+		keys = append(keys, extCodeKey{Dir: pkgDir, TypeName: constructOptionalName(arg.Name)})
+		codes = append(codes, arg.ExtCode)
+	}
+
+	return keys, codes, nil
+}
+
+// receiverName returns the name of the receiver type of the method, e.g. OptionalPair for
+// (o *OptionalPair[K, V]).
+func receiverName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	switch typ := expr.(type) {
+	case *ast.IndexExpr:
+		expr = typ.X
+	case *ast.IndexListExpr:
+		expr = typ.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// generatedExtCodes returns extension codes of optional types in the generated file: the first
+// argument of EncodeExtHeader calls in their methods.
+func generatedExtCodes(file *ast.File) map[string]int {
+	codes := map[string]int{}
+
+	for _, decl := range file.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Body == nil {
+			continue
+		}
+
+		typeName := receiverName(funcDecl)
+		if typeName == "" {
+			continue
+		}
+
+		ast.Inspect(funcDecl.Body, func(node ast.Node) bool {
+			call, ok := node.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != "EncodeExtHeader" {
+				return true
+			}
+
+			if code, ok := parseIntLiteral(call.Args[0]); ok {
+				codes[typeName] = code
+			}
+
+			return true
+		})
+	}
+
+	return codes
+}
+
+// parseIntLiteral parses an integer literal, possibly negative.
+func parseIntLiteral(expr ast.Expr) (int, bool) {
+	sign := 1
+
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.SUB {
+		sign, expr = -1, unary.X
+	}
+
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return 0, false
+	}
+
+	val, err := strconv.ParseInt(lit.Value, 0, 0)
+	if err != nil || val > math.MaxInt8+1 {
+		return 0, false
+	}
+
+	return sign * int(val), true
+}
+
+// skipDir reports whether the directory is not a part of the module: a hidden directory,
+// vendor, testdata or a nested module.
+func skipDir(root, dir string) bool {
+	if dir == root {
+		return false
+	}
+
+	name := filepath.Base(dir)
+	if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "vendor" || name == "testdata" {
+		return true
+	}
+
+	_, err := os.Stat(filepath.Join(dir, "go.mod"))
+
+	return err == nil
+}
+
+// scanModule returns extension codes from all gentypes go:generate directives and all generated
+// _gen.go files in the module.
+func scanModule(root string, lock extCodeLock) ([]extCodeEntry, error) {
+	var entries []extCodeEntry
+
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(root, func(fileName string, entry fs.DirEntry, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case entry.IsDir() && skipDir(root, fileName):
+			return filepath.SkipDir
+		case entry.IsDir() || !strings.HasSuffix(fileName, ".go"):
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, fileName, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		dir := filepath.Dir(fileName)
+
+		fileEntries, err := scanFile(root, dir, fset, file, lock)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, fileEntries...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return entries, nil
+}
+
+func scanFile(root, dir string, fset *token.FileSet, file *ast.File, lock extCodeLock) ([]extCodeEntry, error) {
+	var entries []extCodeEntry
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			line, ok := strings.CutPrefix(comment.Text, "//go:generate ")
+			if !ok {
+				continue
+			}
+
+			words, err := splitDirective(line)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fset.Position(comment.Pos()), err)
+			}
+
+			args, ok := gentypesArgs(words)
+			if !ok {
+				continue
+			}
+
+			keys, codes, err := parseDirective(root, dir, args, lock)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", fset.Position(comment.Pos()), err)
+			}
+
+			for i, key := range keys {
+				entries = append(entries, extCodeEntry{
+					Key:     key,
+					ExtCode: codes[i],
+					Source:  fset.Position(comment.Pos()).String(),
+				})
+			}
+		}
+	}
+
+	fileName := fset.Position(file.Pos()).Filename
+	if !strings.HasSuffix(fileName, "_gen.go") || !ast.IsGenerated(file) {
+		return entries, nil
+	}
+
+	pkgDir, err := moduleDir(root, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for typeName, code := range generatedExtCodes(file) {
+		entries = append(entries, extCodeEntry{
+			Key:     extCodeKey{Dir: pkgDir, TypeName: typeName},
+			ExtCode: code,
+			Source:  fileName,
+		})
+	}
+
+	return entries, nil
+}
+
+// checkExtCodes reports types, that share an extension code, use a reserved code, or have
+// different codes in the directive and the generated file.
+func checkExtCodes(entries []extCodeEntry) []error {
+	var errs []error
+
+	keysByCode := map[int][]extCodeKey{}
+	codesByKey := map[extCodeKey][]extCodeEntry{}
+
+	for _, entry := range entries {
+		if !slices.Contains(keysByCode[entry.ExtCode], entry.Key) {
+			keysByCode[entry.ExtCode] = append(keysByCode[entry.ExtCode], entry.Key)
+		}
+
+		codesByKey[entry.Key] = append(codesByKey[entry.Key], entry)
+	}
+
+	for key, keyEntries := range codesByKey {
+		for _, entry := range keyEntries[1:] {
+			if entry.ExtCode != keyEntries[0].ExtCode {
+				errs = append(errs, fmt.Errorf("%w: %s has code %d in %s and code %d in %s",
+					errExtCodeMismatch, key, keyEntries[0].ExtCode, keyEntries[0].Source, entry.ExtCode, entry.Source))
+
+				break
+			}
+		}
+	}
+
+	for code, keys := range keysByCode {
+		if reserved, ok := reservedExtCode(code); ok {
+			for _, key := range keys {
+				errs = append(errs, fmt.Errorf("%w: %d of %s is used by %s", errReservedExtCode, code, key, reserved))
+			}
+		}
+
+		if len(keys) > 1 {
+			names := make([]string, 0, len(keys))
+			for _, key := range keys {
+				names = append(names, key.String())
+			}
+
+			slices.Sort(names)
+
+			errs = append(errs, fmt.Errorf("%w: %d is used by %s",
+				errExtCodeCollision, code, strings.Join(names, ", ")))
+		}
+	}
+
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errs
+}
+
+// checkModuleExtCodes scans the module, that contains dir, and reports extension code collisions.
+func checkModuleExtCodes(dir string) ([]error, error) {
+	root, err := findModuleRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := readExtCodeLock(filepath.Join(root, extCodeLockFile))
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := scanModule(root, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	return checkExtCodes(entries), nil
+}
+
+// allocateExtCode returns the first code, that is not used or reserved.
+func allocateExtCode(used map[int]bool) (int, error) {
+	for code := firstAutoExtCode; code <= math.MaxInt8; code++ {
+		if !used[code] {
+			return code, nil
+		}
+	}
+
+	return 0, errNoFreeExtCode
+}
+
+// assignAutoExtCodes assigns codes to types without an explicit code in args, generated into
+// the package in dir. Assigned codes are persisted in the lock file in the module root, so
+// the types keep their codes on the next run.
+func assignAutoExtCodes(dir string, args []string) ([]string, error) {
+	root, err := findModuleRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	lockFileName := filepath.Join(root, extCodeLockFile)
+
+	lock, err := readExtCodeLock(lockFileName)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := scanModule(root, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	pkgDir, err := moduleDir(root, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	used := map[int]bool{}
+	for code := range reservedExtCodes {
+		used[code] = true
+	}
+
+	// The codes of the types themselves are not considered as used: they are taken from the lock.
+	own := map[extCodeKey]bool{}
+
+	for _, arg := range args {
+		name, codeStr, hasCode := strings.Cut(arg, "=")
+		if !hasCode {
+			own[extCodeKey{Dir: pkgDir, TypeName: constructOptionalName(name)}] = true
+
+			continue
+		}
+
+		code, err := strconv.Atoi(codeStr)
+		if err != nil {
+			// Invalid codes are reported on parsing of the type arguments.
+			continue
+		}
+
+		if reserved, ok := reservedExtCode(code); ok {
+			return nil, fmt.Errorf("%w: %d of %s is used by %s", errReservedExtCode, code, name, reserved)
+		}
+
+		used[code] = true
+	}
+
+	for _, entry := range entries {
+		if !own[entry.Key] {
+			used[entry.ExtCode] = true
+		}
+	}
+
+	for key, code := range lock {
+		if !own[key] {
+			used[code] = true
+		}
+	}
+
+	out := make([]string, 0, len(args))
+	changed := false
+
+	for _, arg := range args {
+		if strings.Contains(arg, "=") {
+			out = append(out, arg)
+			continue
+		}
+
+		key := extCodeKey{Dir: pkgDir, TypeName: constructOptionalName(arg)}
+
+		code, ok := lock[key]
+		reserved, isReserved := reservedExtCode(code)
+
+		switch {
+		case ok && isReserved:
+			return nil, fmt.Errorf("%w: %d of %s from %s is used by %s",
+				errReservedExtCode, code, key, extCodeLockFile, reserved)
+		case ok && used[code]:
+			// The code is already on the wire, so it is not reassigned silently.
+			return nil, fmt.Errorf("%w: %d of %s from %s is used by another type",
+				errExtCodeCollision, code, key, extCodeLockFile)
+		case !ok:
+			code, err = allocateExtCode(used)
+			if err != nil {
+				return nil, fmt.Errorf("%w for type %s", err, arg)
+			}
+
+			lock[key], changed = code, true
+		}
+
+		used[code] = true
+
+		out = append(out, arg+"="+strconv.Itoa(code))
+	}
+
+	if changed {
+		err = writeExtCodeLock(lockFileName, lock)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return out, nil
+}
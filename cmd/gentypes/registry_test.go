@@ -0,0 +1,180 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// goGenerate is the prefix of go:generate directives in test files. It is split, so go generate
+// doesn't run the directives of this file.
+const goGenerate = "//go:" + "generate "
+
+// writeModule creates a module in a temporary directory with the given files. "GENERATE " at
+// the start of lines is replaced with the go:generate prefix.
+func writeModule(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	files["go.mod"] = "module example.com/test\n"
+
+	for name, content := range files {
+		content = strings.ReplaceAll(content, "GENERATE ", goGenerate)
+
+		fileName := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(fileName), 0o755))
+		require.NoError(t, os.WriteFile(fileName, []byte(content), 0o600))
+	}
+
+	return root
+}
+
+func TestSplitDirective(t *testing.T) {
+	t.Parallel()
+
+	words, err := splitDirective(`go run github.com/tarantool/go-option/cmd/gentypes@latest  -imports "a b" Foo`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"go", "run", "github.com/tarantool/go-option/cmd/gentypes@latest", "-imports", "a b", "Foo",
+	}, words)
+
+	args, ok := gentypesArgs(words)
+	require.True(t, ok)
+	assert.Equal(t, []string{"-imports", "a b", "Foo"}, args)
+
+	_, ok = gentypesArgs([]string{"go", "run", "./cmd/generator"})
+	assert.False(t, ok)
+
+	_, err = splitDirective(`gentypes "Foo`)
+	require.Error(t, err)
+}
+
+func TestCheckModuleExtCodes(t *testing.T) {
+	t.Parallel()
+
+	root := writeModule(t, map[string]string{
+		"generate.go": `GENERATE go tool gentypes -ext-code 100 Foo
+GENERATE go run github.com/tarantool/go-option/cmd/gentypes@latest -ext-code 100 -package sub -force Bar
+GENERATE gentypes -ext-code 2 "Baz"
+GENERATE gentypes -ext-code -5 Neg
+GENERATE go tool gentypes -mode plain Plain
+GENERATE go run ./cmd/generator
+
+package test
+`,
+		"foo_gen.go": `// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package test
+
+func (o OptionalFoo) encodeValue(encoder *msgpack.Encoder) error {
+	return encoder.EncodeExtHeader(101, 0)
+}
+`,
+		"sub/pair_gen.go": `// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package sub
+
+func (o *OptionalPair[K, V]) encodeValue(encoder *msgpack.Encoder) error {
+	return encoder.EncodeExtHeader(-1, 0)
+}
+`,
+		"nested/go.mod":   "module example.com/nested\n",
+		"nested/types.go": "GENERATE gentypes -ext-code 100 Nested\n\npackage nested\n",
+	})
+
+	errs, err := checkModuleExtCodes(filepath.Join(root, "sub"))
+	require.NoError(t, err)
+	require.Len(t, errs, 5)
+
+	require.ErrorIs(t, errs[0], errExtCodeCollision)
+	assert.Equal(t, "extension code collision: 100 is used by OptionalFoo, sub.OptionalBar", errs[0].Error())
+	require.ErrorIs(t, errs[1], errReservedExtCode)
+	assert.Equal(t, "extension code is reserved: -1 of sub.OptionalPair is used by MessagePack timestamp",
+		errs[1].Error())
+	require.ErrorIs(t, errs[2], errReservedExtCode)
+	assert.Equal(t, "extension code is reserved: -5 of OptionalNeg is used by MessagePack predefined types",
+		errs[2].Error())
+	require.ErrorIs(t, errs[3], errReservedExtCode)
+	assert.Equal(t, "extension code is reserved: 2 of OptionalBaz is used by Tarantool uuid", errs[3].Error())
+	require.ErrorIs(t, errs[4], errExtCodeMismatch)
+	assert.Contains(t, errs[4].Error(), "OptionalFoo has code")
+	assert.Contains(t, errs[4].Error(), "code 100 in "+filepath.Join(root, "generate.go")+":1:1")
+	assert.Contains(t, errs[4].Error(), "code 101 in "+filepath.Join(root, "foo_gen.go"))
+}
+
+func TestAssignAutoExtCodes(t *testing.T) {
+	t.Parallel()
+
+	root := writeModule(t, map[string]string{
+		"generate.go": `GENERATE go tool gentypes -ext-code 9 Used Other
+GENERATE go tool gentypes -ext-code=auto -package types Foo Bar=12 Baz
+
+package test
+`,
+	})
+	dir := filepath.Join(root, "types")
+
+	// 9 and 10 are used by other types, 12 is set explicitly.
+	args, err := assignAutoExtCodes(dir, []string{"Foo", "Bar=12", "Baz"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Foo=11", "Bar=12", "Baz=13"}, args)
+
+	lock, err := os.ReadFile(filepath.Join(root, extCodeLockFile))
+	require.NoError(t, err)
+	assert.Equal(t, extCodeLockHeader+"\ntypes OptionalBaz 13\ntypes OptionalFoo 11\n", string(lock))
+
+	// Codes from the lock file are kept on the next run.
+	args, err = assignAutoExtCodes(dir, []string{"Baz", "Foo"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Baz=13", "Foo=11"}, args)
+
+	// Directives with -ext-code=auto are checked with the codes from the lock file.
+	errs, err := checkModuleExtCodes(root)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+
+	// The code from the lock file is not reassigned, if it is taken by another type.
+	require.NoError(t, os.WriteFile(filepath.Join(root, extCodeLockFile),
+		[]byte("types OptionalFoo 9\n"), 0o600))
+
+	_, err = assignAutoExtCodes(dir, []string{"Foo"})
+	require.ErrorIs(t, err, errExtCodeCollision)
+
+	// Negative codes are reserved by MessagePack.
+	_, err = assignAutoExtCodes(dir, []string{"Foo", "Bar=-5"})
+	require.ErrorIs(t, err, errReservedExtCode)
+
+	errs, err = checkModuleExtCodes(root)
+	require.NoError(t, err)
+	require.Len(t, errs, 1)
+	assert.Equal(t, "extension code collision: 9 is used by OptionalUsed, types.OptionalFoo", errs[0].Error())
+
+	// Codes in the lock file are checked too.
+	require.NoError(t, os.WriteFile(filepath.Join(root, extCodeLockFile),
+		[]byte("types OptionalFoo -3\n"), 0o600))
+
+	_, err = assignAutoExtCodes(dir, []string{"Foo"})
+	require.ErrorIs(t, err, errReservedExtCode)
+}
+
+func TestReadExtCodeLock_Invalid(t *testing.T) {
+	t.Parallel()
+
+	fileName := filepath.Join(t.TempDir(), extCodeLockFile)
+
+	require.NoError(t, os.WriteFile(fileName, []byte("types OptionalFoo\n"), 0o600))
+	_, err := readExtCodeLock(fileName)
+	require.ErrorIs(t, err, errInvalidLockLine)
+
+	require.NoError(t, os.WriteFile(fileName, []byte("types OptionalFoo 128\n"), 0o600))
+	_, err = readExtCodeLock(fileName)
+	require.ErrorIs(t, err, errInvalidExtCode)
+
+	lock, err := readExtCodeLock(filepath.Join(t.TempDir(), extCodeLockFile))
+	require.NoError(t, err)
+	assert.Empty(t, lock)
+}
@@ -0,0 +1,192 @@
+package option
+
+// This file provides codecs, that Generic[T] uses to encode and decode values of built-in types without
+// reflection. A codec is resolved once per type and cached, so Generic[int] dispatches to the same
+// encodeInt and decodeInt helpers as the Int type. Codecs of other types are registered with
+// RegisterCodec and RegisterExtCodec.
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// genericCodec encodes and decodes values of T without reflection.
+type genericCodec[T any] struct {
+	encode func(encoder *msgpack.Encoder, val T) error
+	decode func(decoder *msgpack.Decoder) (T, error)
+	// registered is set for codecs, registered with RegisterCodec or RegisterExtCodec.
+	registered bool
+}
+
+// genericCodecs caches resolved and registered codecs: reflect.Type -> *genericCodec[T]. Types
+// without a codec are cached as a nil *genericCodec[T].
+var genericCodecs sync.Map //nolint:gochecknoglobals
+
+// extCodeTypes maps extension codes, registered with RegisterExtCodec and RegisterExtType, to their types.
+var extCodeTypes = struct { //nolint:gochecknoglobals
+	sync.Mutex
+
+	types map[int8]reflect.Type
+}{types: map[int8]reflect.Type{}} //nolint:exhaustruct
+
+// RegisterCodec registers functions, that Generic[T] and Nullable[T] use to encode and decode
+// values of T instead of reflection. It allows to customize the encoding of third-party types, that
+// don't implement msgpack.CustomEncoder and msgpack.CustomDecoder, without wrapping them. nil is
+// handled by the optional itself, so the functions are called for present values only.
+//
+// The registration replaces the previous one for T. It is safe for concurrent use, but it
+// should be done before the first encoding or decoding of Generic[T] or Nullable[T], e.g. in init().
+func RegisterCodec[T any](
+	encode func(encoder *msgpack.Encoder, val T) error,
+	decode func(decoder *msgpack.Decoder) (T, error),
+) {
+	genericCodecs.Store(reflect.TypeFor[T](), &genericCodec[T]{encode: encode, decode: decode, registered: true})
+}
+
+// RegisterExtCodec registers functions, that Generic[T] and Nullable[T] use to encode values of T
+// as a MessagePack extension with the given code. marshal returns the payload of the extension and
+// unmarshal decodes it. They have the same signatures as functions, passed to gentypes with
+// -marshal-func and -unmarshal-func, so Generic[T] produces the same bytes as the optional type,
+// generated by gentypes with the same functions and extension code:
+//
+//	option.RegisterExtCodec(3, encodeUUID, decodeUUID)
+//
+//	// The same bytes as msgpack.Marshal(SomeOptionalUUID(id)).
+//	data, err := msgpack.Marshal(option.Some(id))
+//
+// Panics with ErrDuplicateExtCode, if the code is already registered for another type.
+// It is safe for concurrent use.
+func RegisterExtCodec[T any](
+	extCode int8,
+	marshal func(val T) ([]byte, error),
+	unmarshal func(val *T, data []byte) error,
+) {
+	RegisterExtType(extCode, reflect.TypeFor[T]())
+	RegisterCodec(
+		func(encoder *msgpack.Encoder, val T) error {
+			payload, err := marshal(val)
+			if err != nil {
+				return err
+			}
+
+			return encodeExtPayload(encoder, extCode, payload)
+		},
+		func(decoder *msgpack.Decoder) (T, error) {
+			var val T
+
+			payload, err := decodeExtPayload(decoder, extCode)
+			if err != nil {
+				return val, err
+			}
+
+			err = unmarshal(&val, payload)
+
+			return val, err
+		},
+	)
+}
+
+// RegisterExtType registers the extension code for values of typ without any codec, so a conflict
+// with another type is detected at startup. Optional types, generated by gentypes with -register,
+// call it in init() before they register the type with msgpack.RegisterExtEncoder and
+// msgpack.RegisterExtDecoder, that replace previous registrations silently.
+//
+// Panics with ErrDuplicateExtCode, if the code is already registered for another type.
+// It is safe for concurrent use.
+func RegisterExtType(extCode int8, typ reflect.Type) {
+	extCodeTypes.Lock()
+	defer extCodeTypes.Unlock()
+
+	if registered, ok := extCodeTypes.types[extCode]; ok && registered != typ {
+		panic(fmt.Errorf("%w: %d is registered for %s, can't register it for %s",
+			ErrDuplicateExtCode, extCode, registered, typ))
+	}
+
+	extCodeTypes.types[extCode] = typ
+}
+
+// getGenericCodec returns the codec for T or nil, if values of T are encoded with reflection
+// or their own EncodeMsgpack and DecodeMsgpack methods.
+func getGenericCodec[T any]() *genericCodec[T] {
+	typ := reflect.TypeFor[T]()
+
+	if cached, ok := genericCodecs.Load(typ); ok {
+		codec, _ := cached.(*genericCodec[T])
+		return codec
+	}
+
+	cached, _ := genericCodecs.LoadOrStore(typ, resolveGenericCodec[T]())
+	codec, _ := cached.(*genericCodec[T])
+
+	return codec
+}
+
+// newGenericCodec creates a codec for T from the helpers for V. T and V must be the same type.
+func newGenericCodec[T, V any](
+	encode func(*msgpack.Encoder, V) error,
+	decode func(*msgpack.Decoder) (V, error),
+) *genericCodec[T] {
+	encodeT, _ := any(encode).(func(*msgpack.Encoder, T) error)
+	decodeT, _ := any(decode).(func(*msgpack.Decoder) (T, error))
+
+	return &genericCodec[T]{encode: encodeT, decode: decodeT}
+}
+
+// resolveGenericCodec returns the codec for a built-in T. The encodings are the same, as used by
+// msgpack.Encoder and msgpack.Decoder for values of these types with the default options.
+func resolveGenericCodec[T any]() *genericCodec[T] { //nolint:cyclop
+	switch any(zero[T]()).(type) {
+	case int:
+		return newGenericCodec[T](encodeInt, decodeInt)
+	case int8:
+		return newGenericCodec[T](encodeInt8, decodeInt8)
+	case int16:
+		return newGenericCodec[T](encodeInt16, decodeInt16)
+	case int32:
+		return newGenericCodec[T](encodeInt32, decodeInt32)
+	case int64:
+		return newGenericCodec[T](encodeInt64, decodeInt64)
+	case uint:
+		return newGenericCodec[T](encodeUint, decodeUint)
+	case uint8:
+		return newGenericCodec[T](encodeUint8, decodeUint8)
+	case uint16:
+		return newGenericCodec[T](encodeUint16, decodeUint16)
+	case uint32:
+		return newGenericCodec[T](encodeUint32, decodeUint32)
+	case uint64:
+		return newGenericCodec[T](encodeUint64, decodeUint64)
+	case float32:
+		return newGenericCodec[T](encodeFloat32, decodeFloat32)
+	case float64:
+		return newGenericCodec[T](encodeFloat64, decodeFloat64)
+	case string:
+		return newGenericCodec[T](encodeString, decodeString)
+	case []byte:
+		return newGenericCodec[T](encodeBytes, decodeBytes)
+	case bool:
+		return newGenericCodec[T](encodeBool, decodeBool)
+	case time.Time:
+		return newGenericCodec[T](encodeTime, decodeGenericTime)
+	case time.Duration:
+		return newGenericCodec[T](encodeGenericDuration, decodeDuration)
+	default:
+		return nil
+	}
+}
+
+// decodeGenericTime decodes time in the local location, as msgpack.Decoder does. Unlike decodeTime
+// it doesn't convert time to UTC.
+func decodeGenericTime(decoder *msgpack.Decoder) (time.Time, error) {
+	return decoder.DecodeTime() //nolint:wrapcheck
+}
+
+// encodeGenericDuration encodes the duration as int64, as msgpack.Encoder does. Unlike encodeDuration
+// it doesn't use the compact encoding.
+func encodeGenericDuration(encoder *msgpack.Encoder, val time.Duration) error {
+	return encoder.EncodeInt64(int64(val)) //nolint:wrapcheck
+}
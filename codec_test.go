@@ -0,0 +1,283 @@
+package option_test
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+// requireGenericCodec checks, that Generic[T] encodes the value in the same way as msgpack.Encoder
+// encodes it with reflection, and decodes it back.
+func requireGenericCodec[T any](t *testing.T, value T) {
+	t.Helper()
+
+	expected, err := msgpack.Marshal(&value)
+	require.NoError(t, err)
+
+	data, err := msgpack.Marshal(option.Some(value))
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	var opt option.Generic[T]
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, option.Some(value), opt)
+}
+
+func TestGeneric_Codec(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(t *testing.T){
+		"int":      func(t *testing.T) { requireGenericCodec(t, -100000) },
+		"int8":     func(t *testing.T) { requireGenericCodec(t, int8(-1)) },
+		"int16":    func(t *testing.T) { requireGenericCodec(t, int16(300)) },
+		"int32":    func(t *testing.T) { requireGenericCodec(t, int32(-70000)) },
+		"int64":    func(t *testing.T) { requireGenericCodec(t, int64(1)) },
+		"uint":     func(t *testing.T) { requireGenericCodec(t, uint(1<<40)) },
+		"uint8":    func(t *testing.T) { requireGenericCodec(t, uint8(200)) },
+		"uint16":   func(t *testing.T) { requireGenericCodec(t, uint16(1)) },
+		"uint32":   func(t *testing.T) { requireGenericCodec(t, uint32(70000)) },
+		"uint64":   func(t *testing.T) { requireGenericCodec(t, uint64(1<<63)) },
+		"float32":  func(t *testing.T) { requireGenericCodec(t, float32(1.5)) },
+		"float64":  func(t *testing.T) { requireGenericCodec(t, -2.25) },
+		"string":   func(t *testing.T) { requireGenericCodec(t, "hello") },
+		"bytes":    func(t *testing.T) { requireGenericCodec(t, []byte("hello")) },
+		"bool":     func(t *testing.T) { requireGenericCodec(t, true) },
+		"time":     func(t *testing.T) { requireGenericCodec(t, time.Unix(1700000000, 123)) },
+		"duration": func(t *testing.T) { requireGenericCodec(t, time.Second) },
+		"struct":   func(t *testing.T) { requireGenericCodec(t, appendTestStruct{Name: "a", Value: 1}) },
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			test(t)
+		})
+	}
+}
+
+func TestGeneric_CodecNamedType(t *testing.T) {
+	t.Parallel()
+
+	type myInt int8
+
+	// Named types are not built-in, so they are encoded with reflection.
+	requireGenericCodec(t, myInt(-1))
+}
+
+// AllocsPerRun is not reliable in parallel tests.
+func TestGeneric_CodecAllocations(t *testing.T) { //nolint:paralleltest
+	var buf bytes.Buffer
+
+	buf.Grow(64)
+
+	encoder := msgpack.NewEncoder(&buf)
+	decoder := msgpack.NewDecoder(&buf)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		opt := option.Some(42)
+		_ = opt.EncodeMsgpack(encoder)
+		_ = opt.DecodeMsgpack(decoder)
+
+		buf.Reset()
+	})
+	assert.Zero(t, allocs)
+}
+
+// codecVersion is a third-party type, that is encoded as a string with a registered codec.
+type codecVersion struct {
+	Major, Minor int
+}
+
+// codecUUID is a third-party type, that is encoded as Tarantool uuid with a registered ext codec.
+type codecUUID uuid.UUID
+
+// codecConflict is registered with the extension code of codecUUID.
+type codecConflict struct{}
+
+func init() { //nolint:gochecknoinits // Registration must precede the first decoding.
+	option.RegisterCodec(
+		func(encoder *msgpack.Encoder, val codecVersion) error {
+			return encoder.EncodeString(strconv.Itoa(val.Major) + "." + strconv.Itoa(val.Minor))
+		},
+		func(decoder *msgpack.Decoder) (codecVersion, error) {
+			str, err := decoder.DecodeString()
+			if err != nil {
+				return codecVersion{}, err //nolint:wrapcheck
+			}
+
+			var val codecVersion
+
+			_, err = fmt.Sscanf(str, "%d.%d", &val.Major, &val.Minor)
+
+			return val, err //nolint:wrapcheck
+		},
+	)
+
+	option.RegisterExtCodec(option.UUIDExtCode,
+		func(val codecUUID) ([]byte, error) {
+			return val[:], nil
+		},
+		func(val *codecUUID, data []byte) error {
+			copy(val[:], data)
+			return nil
+		},
+	)
+}
+
+func TestRegisterCodec(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(option.Some(codecVersion{Major: 1, Minor: 2}))
+	require.NoError(t, err)
+
+	expected, err := msgpack.Marshal("1.2")
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	appended, err := option.Some(codecVersion{Major: 1, Minor: 2}).AppendMsgpack(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, appended)
+
+	var opt option.Generic[codecVersion]
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, option.Some(codecVersion{Major: 1, Minor: 2}), opt)
+
+	// nil is handled by Generic[T].
+	data, err = msgpack.Marshal(option.None[codecVersion]())
+	require.NoError(t, err)
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, option.None[codecVersion](), opt)
+}
+
+func TestRegisterExtCodec(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")
+
+	// The same bytes as the UUID type, that is encoded as the Tarantool uuid extension.
+	expected, err := msgpack.Marshal(option.SomeUUID(id))
+	require.NoError(t, err)
+
+	data, err := msgpack.Marshal(option.Some(codecUUID(id)))
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	var opt option.Generic[codecUUID]
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.Equal(t, option.Some(codecUUID(id)), opt)
+
+	data, err = msgpack.Marshal(option.SomeDecimal(option.MustParseDecimalValue("1")))
+	require.NoError(t, err)
+	require.ErrorIs(t, msgpack.Unmarshal(data, &opt), option.ErrInvalidExtCode)
+}
+
+func TestRegisterExtCodec_Nullable(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")
+
+	expected, err := msgpack.Marshal(option.SomeUUID(id))
+	require.NoError(t, err)
+
+	data, err := msgpack.Marshal(option.SomeNullable(codecUUID(id)))
+	require.NoError(t, err)
+	assert.Equal(t, expected, data)
+
+	appended, err := option.SomeNullable(codecUUID(id)).AppendMsgpack(nil)
+	require.NoError(t, err)
+	assert.Equal(t, expected, appended)
+
+	var opt option.Nullable[codecUUID]
+	require.NoError(t, msgpack.Unmarshal(appended, &opt))
+	assert.Equal(t, option.SomeNullable(codecUUID(id)), opt)
+
+	data, err = msgpack.Marshal(option.SomeDecimal(option.MustParseDecimalValue("1")))
+	require.NoError(t, err)
+	require.ErrorIs(t, msgpack.Unmarshal(data, &opt), option.ErrInvalidExtCode)
+}
+
+func TestRegisterExtCodec_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	register := func() (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+
+		option.RegisterExtCodec(option.UUIDExtCode,
+			func(codecConflict) ([]byte, error) { return nil, nil },
+			func(*codecConflict, []byte) error { return nil },
+		)
+
+		return nil
+	}
+
+	err := register()
+	require.ErrorIs(t, err, option.ErrDuplicateExtCode)
+	assert.Contains(t, err.Error(), "option_test.codecUUID")
+}
+
+func TestRegisterExtType(t *testing.T) {
+	t.Parallel()
+
+	// The code is reserved by RegisterExtCodec for codecUUID.
+	require.NotPanics(t, func() { option.RegisterExtType(option.UUIDExtCode, reflect.TypeFor[codecUUID]()) })
+
+	err := func() (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+
+		option.RegisterExtType(option.UUIDExtCode, reflect.TypeFor[codecConflict]())
+
+		return nil
+	}()
+	require.ErrorIs(t, err, option.ErrDuplicateExtCode)
+}
+
+func TestRegisterCodec_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	type concurrentValue int
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			option.RegisterCodec(
+				func(encoder *msgpack.Encoder, val concurrentValue) error {
+					return encoder.EncodeInt(int64(val))
+				},
+				func(decoder *msgpack.Decoder) (concurrentValue, error) {
+					val, err := decoder.DecodeInt64()
+					return concurrentValue(val), err //nolint:wrapcheck
+				},
+			)
+
+			_, err := msgpack.Marshal(option.Some(concurrentValue(1)))
+			assert.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	data, err := msgpack.Marshal(option.Some(concurrentValue(1)))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x01}, data)
+}
@@ -0,0 +1,134 @@
+package option
+
+// Pair is a pair of values, produced by Zip and consumed by Unzip.
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// Map applies fn to the contained value and returns the result wrapped into Generic[U].
+// If o is None, fn is not called and None is returned.
+//
+// Example:
+//
+//	length := option.Map(option.Some("hello"), func(s string) int { return len(s) })
+//	fmt.Println(length.Unwrap()) // prints 5
+func Map[T, U any](o Generic[T], fn func(T) U) Generic[U] {
+	if !o.exists {
+		return None[U]()
+	}
+
+	return Some(fn(o.value))
+}
+
+// FlatMap applies fn to the contained value and returns its result as is.
+// If o is None, fn is not called and None is returned.
+//
+// It's useful for chaining operations, that may fail to produce a value.
+func FlatMap[T, U any](o Generic[T], fn func(T) Generic[U]) Generic[U] {
+	if !o.exists {
+		return None[U]()
+	}
+
+	return fn(o.value)
+}
+
+// Filter returns o if it contains a value, that satisfies predicate, otherwise returns None.
+func Filter[T any](o Generic[T], predicate func(T) bool) Generic[T] {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return None[T]()
+}
+
+// Or returns o if it contains a value, otherwise returns other.
+func Or[T any](o, other Generic[T]) Generic[T] {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns o if it contains a value, otherwise calls fn and returns its result.
+//
+// This is useful when the alternative is expensive to compute.
+func OrElse[T any](o Generic[T], fn func() Generic[T]) Generic[T] {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// And returns None if o is None, otherwise returns other.
+func And[T, U any](o Generic[T], other Generic[U]) Generic[U] {
+	if !o.exists {
+		return None[U]()
+	}
+
+	return other
+}
+
+// Xor returns the one of o and other, that contains a value, if exactly one of them does.
+// Otherwise, returns None.
+func Xor[T any](o, other Generic[T]) Generic[T] {
+	switch {
+	case o.exists && !other.exists:
+		return o
+	case !o.exists && other.exists:
+		return other
+	default:
+		return None[T]()
+	}
+}
+
+// Zip returns a Pair of both contained values if both o and other contain a value.
+// Otherwise, returns None.
+func Zip[T, U any](o Generic[T], other Generic[U]) Generic[Pair[T, U]] {
+	if !o.exists || !other.exists {
+		return None[Pair[T, U]]()
+	}
+
+	return Some(Pair[T, U]{First: o.value, Second: other.value})
+}
+
+// Unzip splits an optional Pair into a pair of optionals.
+// If o is None, both results are None.
+func Unzip[T, U any](o Generic[Pair[T, U]]) (Generic[T], Generic[U]) {
+	if !o.exists {
+		return None[T](), None[U]()
+	}
+
+	return Some(o.value.First), Some(o.value.Second)
+}
+
+// Flatten removes one level of nesting from an optional of optional.
+func Flatten[T any](o Generic[Generic[T]]) Generic[T] {
+	if !o.exists {
+		return None[T]()
+	}
+
+	return o.value
+}
+
+// Filter is a method form of the Filter function.
+func (o Generic[T]) Filter(predicate func(T) bool) Generic[T] {
+	return Filter(o, predicate)
+}
+
+// Or is a method form of the Or function.
+func (o Generic[T]) Or(other Generic[T]) Generic[T] {
+	return Or(o, other)
+}
+
+// OrElse is a method form of the OrElse function.
+func (o Generic[T]) OrElse(fn func() Generic[T]) Generic[T] {
+	return OrElse(o, fn)
+}
+
+// Xor is a method form of the Xor function.
+func (o Generic[T]) Xor(other Generic[T]) Generic[T] {
+	return Xor(o, other)
+}
@@ -0,0 +1,144 @@
+package option_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	length := func(s string) int {
+		called = true
+		return len(s)
+	}
+
+	assert.Equal(t, option.Some(5), option.Map(option.Some("hello"), length))
+	assert.True(t, called)
+
+	called = false
+
+	assert.Equal(t, option.None[int](), option.Map(option.None[string](), length))
+	assert.False(t, called)
+}
+
+func TestFlatMap(t *testing.T) {
+	t.Parallel()
+
+	parse := func(s string) option.Generic[int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return option.None[int]()
+		}
+
+		return option.Some(v)
+	}
+
+	assert.Equal(t, option.Some(42), option.FlatMap(option.Some("42"), parse))
+	assert.Equal(t, option.None[int](), option.FlatMap(option.Some("forty two"), parse))
+	assert.Equal(t, option.None[int](), option.FlatMap(option.None[string](), parse))
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	assert.Equal(t, option.Some(2), option.Filter(option.Some(2), isEven))
+	assert.Equal(t, option.None[int](), option.Filter(option.Some(3), isEven))
+	assert.Equal(t, option.None[int](), option.None[int]().Filter(isEven))
+	assert.Equal(t, option.Some(4), option.Some(4).Filter(isEven))
+}
+
+func TestOr(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some(1), option.Or(option.Some(1), option.Some(2)))
+	assert.Equal(t, option.Some(2), option.Or(option.None[int](), option.Some(2)))
+	assert.Equal(t, option.None[int](), option.None[int]().Or(option.None[int]()))
+	assert.Equal(t, option.Some(1), option.Some(1).Or(option.None[int]()))
+}
+
+func TestOrElse(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	fallback := func() option.Generic[int] {
+		called = true
+		return option.Some(2)
+	}
+
+	assert.Equal(t, option.Some(1), option.OrElse(option.Some(1), fallback))
+	assert.False(t, called)
+
+	assert.Equal(t, option.Some(2), option.None[int]().OrElse(fallback))
+	assert.True(t, called)
+}
+
+func TestAnd(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some("x"), option.And(option.Some(1), option.Some("x")))
+	assert.Equal(t, option.None[string](), option.And(option.None[int](), option.Some("x")))
+	assert.Equal(t, option.None[string](), option.And(option.Some(1), option.None[string]()))
+}
+
+func TestXor(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some(1), option.Xor(option.Some(1), option.None[int]()))
+	assert.Equal(t, option.Some(2), option.Xor(option.None[int](), option.Some(2)))
+	assert.Equal(t, option.None[int](), option.Xor(option.Some(1), option.Some(2)))
+	assert.Equal(t, option.None[int](), option.None[int]().Xor(option.None[int]()))
+}
+
+func TestZipUnzip(t *testing.T) {
+	t.Parallel()
+
+	zipped := option.Zip(option.Some(1), option.Some("one"))
+	assert.Equal(t, option.Some(option.Pair[int, string]{First: 1, Second: "one"}), zipped)
+
+	first, second := option.Unzip(zipped)
+	assert.Equal(t, option.Some(1), first)
+	assert.Equal(t, option.Some("one"), second)
+
+	assert.True(t, option.Zip(option.None[int](), option.Some("one")).IsZero())
+	assert.True(t, option.Zip(option.Some(1), option.None[string]()).IsZero())
+
+	first, second = option.Unzip(option.None[option.Pair[int, string]]())
+	assert.True(t, first.IsZero())
+	assert.True(t, second.IsZero())
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some(1), option.Flatten(option.Some(option.Some(1))))
+	assert.Equal(t, option.None[int](), option.Flatten(option.Some(option.None[int]())))
+	assert.Equal(t, option.None[int](), option.Flatten(option.None[option.Generic[int]]()))
+}
+
+func ExampleMap() {
+	opt := option.Map(option.Some("hello"), func(s string) int {
+		return len(s)
+	})
+
+	fmt.Println(opt.Unwrap())
+	// Output: 5
+}
+
+func ExampleGeneric_Filter() {
+	isPositive := func(v int) bool { return v > 0 }
+
+	fmt.Println(option.Some(12).Filter(isPositive).IsSome())
+	fmt.Println(option.Some(-12).Filter(isPositive).IsSome())
+	// Output:
+	// true
+	// false
+}
@@ -0,0 +1,192 @@
+package option
+
+// This file provides equality and ordering helpers for optionals. None is equal only to None
+// and sorts before any value. Values are compared with the helpers below, that are used by the
+// Equal and Compare methods of generated types.
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Equal returns true if both optionals are None, or both contain equal values.
+// Values are compared with ==, except that NaNs are equal, as in cmp.Compare.
+//
+// It could be used with maps.EqualFunc and slices.EqualFunc.
+func Equal[T comparable](a, b Generic[T]) bool {
+	if a.exists != b.exists {
+		return false
+	}
+
+	return !a.exists || equalComparable(a.value, b.value)
+}
+
+// Compare returns
+//
+//	-1 if a is less than b,
+//	 0 if a equals b,
+//	+1 if a is greater than b.
+//
+// None is less than any value. Values are compared with cmp.Compare.
+// It could be used with slices.SortFunc, slices.BinarySearchFunc, etc.
+func Compare[T cmp.Ordered](a, b Generic[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare, but values are compared with the given function.
+//
+// Example:
+//
+//	slices.SortFunc(times, func(a, b option.Generic[time.Time]) int {
+//	    return option.CompareFunc(a, b, time.Time.Compare)
+//	})
+func CompareFunc[T any](a, b Generic[T], compare func(T, T) int) int {
+	return compareOptional(a.exists, b.exists, func() int { return compare(a.value, b.value) })
+}
+
+// compareOptional compares presence flags of optionals and calls compareValues only if both
+// values are present.
+func compareOptional(aExists, bExists bool, compareValues func() int) int {
+	switch {
+	case aExists && bExists:
+		return compareValues()
+	case aExists:
+		return 1
+	case bExists:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// equalComparable compares values with ==, but treats NaNs as equal, so it is consistent
+// with equalOrdered for floats.
+func equalComparable[T comparable](a, b T) bool {
+	if a == b {
+		return true
+	}
+
+	if kind := reflect.TypeFor[T]().Kind(); kind != reflect.Float32 && kind != reflect.Float64 {
+		return false
+	}
+
+	return math.IsNaN(reflect.ValueOf(a).Float()) && math.IsNaN(reflect.ValueOf(b).Float())
+}
+
+// equalOrdered compares values with cmp.Compare, so NaNs are equal.
+func equalOrdered[T cmp.Ordered](a, b T) bool {
+	return cmp.Compare(a, b) == 0
+}
+
+func compareOrdered[T cmp.Ordered](a, b T) int {
+	return cmp.Compare(a, b)
+}
+
+func equalBytes(a, b []byte) bool {
+	return bytes.Equal(a, b)
+}
+
+func compareBytes(a, b []byte) int {
+	return bytes.Compare(a, b)
+}
+
+func equalBool(a, b bool) bool {
+	return a == b
+}
+
+// compareBool compares booleans, false is less than true.
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// equalDecimal compares decimals numerically, so 1.50 equals 1.5.
+func equalDecimal(a, b DecimalValue) bool {
+	return compareDecimal(a, b) == 0
+}
+
+func compareDecimal(a, b DecimalValue) int {
+	return a.Rat().Cmp(b.Rat())
+}
+
+func equalUUID(a, b uuid.UUID) bool {
+	return a == b
+}
+
+func compareUUID(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
+
+// equalTime compares time instants, ignoring locations.
+func equalTime(a, b time.Time) bool {
+	return a.Equal(b)
+}
+
+func compareTime(a, b time.Time) int {
+	return a.Compare(b)
+}
+
+func equalInterval(a, b IntervalValue) bool {
+	return a == b
+}
+
+// compareInterval compares intervals field by field in order of their declaration.
+// Intervals have no natural order, since months have different lengths,
+// but the order is consistent with equalInterval.
+func compareInterval(a, b IntervalValue) int {
+	return cmp.Or(
+		cmp.Compare(a.Year, b.Year),
+		cmp.Compare(a.Month, b.Month),
+		cmp.Compare(a.Week, b.Week),
+		cmp.Compare(a.Day, b.Day),
+		cmp.Compare(a.Hour, b.Hour),
+		cmp.Compare(a.Min, b.Min),
+		cmp.Compare(a.Sec, b.Sec),
+		cmp.Compare(a.Nsec, b.Nsec),
+		cmp.Compare(a.Adjust, b.Adjust),
+	)
+}
+
+func equalAny(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// compareAny compares values of the same ordered kind (integers, floats and strings)
+// by value. Other values are ordered by their type name and then by the default fmt
+// representation, which gives a consistent, but arbitrary order.
+func compareAny(a, b any) int {
+	if equalAny(a, b) {
+		return 0
+	}
+
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	if aValue.IsValid() && bValue.IsValid() && aValue.Kind() == bValue.Kind() {
+		switch aValue.Kind() { //nolint:exhaustive
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return cmp.Compare(aValue.Int(), bValue.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return cmp.Compare(aValue.Uint(), bValue.Uint())
+		case reflect.Float32, reflect.Float64:
+			return cmp.Compare(aValue.Float(), bValue.Float())
+		case reflect.String:
+			return cmp.Compare(aValue.String(), bValue.String())
+		}
+	}
+
+	return cmp.Or(
+		cmp.Compare(fmt.Sprintf("%T", a), fmt.Sprintf("%T", b)),
+		cmp.Compare(fmt.Sprint(a), fmt.Sprint(b)),
+	)
+}
@@ -0,0 +1,94 @@
+package option_test
+
+import (
+	"maps"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, option.Equal(option.Some(1), option.Some(1)))
+	assert.True(t, option.Equal(option.None[int](), option.None[int]()))
+	assert.False(t, option.Equal(option.Some(1), option.Some(2)))
+	assert.False(t, option.Equal(option.Some(0), option.None[int]()))
+
+	first := map[string]option.Generic[int]{"a": option.Some(1), "b": option.None[int]()}
+	second := map[string]option.Generic[int]{"a": option.Some(1), "b": option.None[int]()}
+	assert.True(t, maps.EqualFunc(first, second, option.Equal[int]))
+
+	second["b"] = option.Some(0)
+	assert.False(t, maps.EqualFunc(first, second, option.Equal[int]))
+}
+
+func TestEqual_NaN(t *testing.T) {
+	t.Parallel()
+
+	type celsius float32
+
+	// NaN is equal to itself, as in Compare and Float64.Equal.
+	assert.True(t, option.Equal(option.Some(math.NaN()), option.Some(math.NaN())))
+	assert.True(t, option.Equal(option.Some(celsius(math.NaN())), option.Some(celsius(math.NaN()))))
+	assert.False(t, option.Equal(option.Some(math.NaN()), option.Some(0.0)))
+	assert.Zero(t, option.Compare(option.Some(math.NaN()), option.Some(math.NaN())))
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	values := []option.Generic[int]{option.Some(3), option.None[int](), option.Some(-1), option.None[int]()}
+	slices.SortFunc(values, option.Compare[int])
+
+	assert.Equal(t, []option.Generic[int]{
+		option.None[int](), option.None[int](), option.Some(-1), option.Some(3),
+	}, values)
+}
+
+func TestCompareFunc(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)
+	values := []option.Generic[time.Time]{option.Some(base.Add(time.Hour)), option.None[time.Time](), option.Some(base)}
+
+	slices.SortFunc(values, func(a, b option.Generic[time.Time]) int {
+		return option.CompareFunc(a, b, time.Time.Compare)
+	})
+
+	assert.Equal(t, []option.Generic[time.Time]{
+		option.None[time.Time](), option.Some(base), option.Some(base.Add(time.Hour)),
+	}, values)
+}
+
+func TestCompare_Typed(t *testing.T) {
+	t.Parallel()
+
+	moscow := time.FixedZone("MSK", 3*60*60)
+	instant := time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)
+
+	// Bytes are not comparable with ==.
+	assert.True(t, option.SomeBytes([]byte("abc")).Equal(option.SomeBytes([]byte("abc"))))
+	assert.Equal(t, -1, option.SomeBytes([]byte("abc")).Compare(option.SomeBytes([]byte("abd"))))
+
+	// NaN is equal to itself and less than any other number, as in cmp.Compare.
+	assert.True(t, option.SomeFloat64(math.NaN()).Equal(option.SomeFloat64(math.NaN())))
+	assert.True(t, option.SomeFloat32(float32(math.NaN())).Equal(option.SomeFloat32(float32(math.NaN()))))
+	assert.False(t, option.SomeFloat64(math.NaN()).Equal(option.SomeFloat64(0)))
+	assert.Equal(t, -1, option.SomeFloat64(math.NaN()).Compare(option.SomeFloat64(math.Inf(-1))))
+
+	assert.Equal(t, -1, option.SomeBool(false).Compare(option.SomeBool(true)))
+	assert.True(t, option.SomeDecimal(option.MustParseDecimalValue("1.50")).Equal(
+		option.SomeDecimal(option.MustParseDecimalValue("1.5"))))
+	assert.True(t, option.SomeDatetime(instant).Equal(option.SomeDatetime(instant.In(moscow))))
+
+	assert.Equal(t, -1, option.SomeAny(2).Compare(option.SomeAny(10)))
+	assert.Equal(t, 1, option.SomeAny("b").Compare(option.SomeAny("a")))
+	assert.True(t, option.SomeAny([]int{1}).Equal(option.SomeAny([]int{1})))
+	assert.Equal(t, option.SomeAny(1).Compare(option.SomeAny("1")), -option.SomeAny("1").Compare(option.SomeAny(1)))
+}
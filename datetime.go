@@ -0,0 +1,92 @@
+package option
+
+// This file implements conversion of time.Time to/from the MessagePack extension format
+// used by Tarantool datetime type (MP_EXT type 4). All fields are little-endian:
+//
+//	+---------+-----------------------------------------+
+//	| seconds | nsec    | tzoffset | tzindex            |
+//	| int64   | int32   | int16    | int16              |
+//	+---------+-----------------------------------------+
+//	|<- required ->|<- optional, omitted if all zero  ->|
+//
+// seconds is the number of seconds since Unix epoch in UTC, tzoffset is the time zone offset
+// in minutes and tzindex is the index of the time zone in the Tarantool time zone table.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DatetimeExtCode is the MessagePack extension code of Tarantool datetime type.
+	DatetimeExtCode = 4
+)
+
+const (
+	datetimeSecondsSize  = 8
+	datetimeNsecSize     = 4
+	datetimeTzOffsetSize = 2
+	datetimeTzIndexSize  = 2
+	datetimeFullSize     = datetimeSecondsSize + datetimeNsecSize + datetimeTzOffsetSize + datetimeTzIndexSize
+	datetimeMaxNsec      = 999999999
+	secondsPerMinute     = 60
+)
+
+var (
+	errDatetimeLength = errors.New("invalid datetime payload length")
+	errDatetimeNsec   = errors.New("datetime nanoseconds are out of range")
+)
+
+// appendDatetimePayload appends the Tarantool MP_EXT payload of the time to dst.
+//
+// The time zone offset of the time is preserved, the time zone index is always 0,
+// since Go time zone names can't be mapped to the Tarantool time zone table reliably.
+func appendDatetimePayload(dst []byte, val time.Time) []byte {
+	_, offset := val.Zone()
+
+	nsec := int32(val.Nanosecond()) //nolint:gosec
+	tzOffset := int16(offset / secondsPerMinute)
+
+	dst = binary.LittleEndian.AppendUint64(dst, uint64(val.Unix())) //nolint:gosec
+	if nsec == 0 && tzOffset == 0 {
+		return dst
+	}
+
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(nsec))
+	dst = binary.LittleEndian.AppendUint16(dst, uint16(tzOffset))
+	dst = binary.LittleEndian.AppendUint16(dst, 0)
+
+	return dst
+}
+
+// parseDatetimePayload parses the Tarantool MP_EXT payload of the datetime.
+//
+// The result is in UTC if there's no time zone offset, otherwise it's in a fixed zone with
+// the transmitted offset (also when the time zone index is set).
+func parseDatetimePayload(payload []byte) (time.Time, error) {
+	if len(payload) != datetimeSecondsSize && len(payload) != datetimeFullSize {
+		return time.Time{}, fmt.Errorf("%w: %d", errDatetimeLength, len(payload))
+	}
+
+	seconds := int64(binary.LittleEndian.Uint64(payload)) //nolint:gosec
+	if len(payload) == datetimeSecondsSize {
+		return time.Unix(seconds, 0).UTC(), nil
+	}
+
+	tail := payload[datetimeSecondsSize:]
+	nsec := int32(binary.LittleEndian.Uint32(tail))                        //nolint:gosec
+	tzOffset := int16(binary.LittleEndian.Uint16(tail[datetimeNsecSize:])) //nolint:gosec
+
+	if nsec < 0 || nsec > datetimeMaxNsec {
+		return time.Time{}, fmt.Errorf("%w: %d", errDatetimeNsec, nsec)
+	}
+
+	val := time.Unix(seconds, int64(nsec))
+	if tzOffset == 0 {
+		return val.UTC(), nil
+	}
+
+	return val.In(time.FixedZone("", int(tzOffset)*secondsPerMinute)), nil
+}
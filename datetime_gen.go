@@ -0,0 +1,413 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Datetime represents an optional value of type time.Time.
+// It can either hold a valid time.Time (IsSome == true) or be empty (IsZero == true).
+type Datetime struct {
+	value  time.Time
+	exists bool
+}
+
+var _ commonInterface[time.Time] = (*Datetime)(nil)
+
+// SomeDatetime creates an optional Datetime with the given time.Time value.
+// The returned Datetime will have IsSome() == true and IsZero() == false.
+func SomeDatetime(value time.Time) Datetime {
+	return Datetime{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneDatetime creates an empty optional Datetime value.
+// The returned Datetime will have IsSome() == false and IsZero() == true.
+func NoneDatetime() Datetime {
+	return Datetime{
+		exists: false,
+		value:  zero[time.Time](),
+	}
+}
+
+// IsSome returns true if the Datetime contains a value.
+// This indicates the value is explicitly set (not None).
+func (o Datetime) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the Datetime does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o Datetime) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o Datetime) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of time.Time, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o Datetime) Get() (time.Time, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o Datetime) MustGet() time.Time {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Time.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o Datetime) Unwrap() time.Time {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Datetime) UnwrapOr(defaultValue time.Time) time.Time {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o Datetime) UnwrapOrElse(defaultValue func() time.Time) time.Time {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Datetime) All() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Datetimes are None, or both contain equal values.
+func (o Datetime) Equal(other Datetime) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalTime(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneDatetime() is less than any value.
+func (o Datetime) Compare(other Datetime) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareTime(o.value, other.value)
+	})
+}
+
+// Filter returns the Datetime itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneDatetime().
+func (o Datetime) Filter(predicate func(time.Time) bool) Datetime {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneDatetime()
+}
+
+// Or returns the Datetime itself if it contains a value.
+// Otherwise, returns other.
+func (o Datetime) Or(other Datetime) Datetime {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Datetime itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Datetime) OrElse(fn func() Datetime) Datetime {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the Datetime value using MessagePack format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o Datetime) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("Datetime", encodeDatetime(encoder, o.value))
+	}
+
+	return newEncodeError("Datetime", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Datetime value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Datetime) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDatetime(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Datetime", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Datetime value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneDatetime)
+//   - time.Time: interpreted as a present value (SomeDatetime)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on time.Time: exists = true, value = decoded value
+func (o *Datetime) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Datetime", "time.Time", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("Datetime", "time.Time", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Time
+
+		val, err = decodeDatetime(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Datetime", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("Datetime", "time.Time", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as time.Time.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Datetime) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueDatetime(o.value)
+	if err != nil {
+		return nil, newEncodeError("Datetime", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneDatetime)
+//   - time.Time: interpreted as a present value (SomeDatetime)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into time.Time.
+func (o *Datetime) Scan(src any) error {
+	if src == nil {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanDatetime(src)
+	if err != nil {
+		return newDecodeError("Datetime", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Datetime value using JSON format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Datetime) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Datetime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Datetime value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneDatetime)
+//   - time.Time: interpreted as a present value (SomeDatetime)
+//
+// Returns an error if the input can't be decoded as time.Time.
+func (o *Datetime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Datetime", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Time.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Datetime) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextTime(o.value)
+	if err != nil {
+		return nil, newEncodeError("Datetime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneDatetime)
+//   - text representation of time.Time: interpreted as a present value (SomeDatetime)
+//
+// Returns an error if the text can't be parsed as time.Time.
+func (o *Datetime) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextTime(text)
+	if err != nil {
+		return newDecodeError("Datetime", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Datetime.
+// The Datetime stays None until the flag is passed, the value is parsed
+// as text representation of time.Time. Get of the returned flag.Getter returns the Datetime.
+//
+// Example:
+//
+//	var opt option.Datetime
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Datetime) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextTime([]byte(text))
+			if err != nil {
+				return newDecodeError("Datetime", err)
+			}
+
+			*o = SomeDatetime(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
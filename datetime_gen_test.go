@@ -0,0 +1,663 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"time"
+
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestDatetime_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.True(t, someDatetime.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.False(t, emptyDatetime.IsSome())
+	})
+}
+
+func TestDatetime_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.False(t, someDatetime.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.True(t, emptyDatetime.IsZero())
+	})
+}
+
+func TestDatetime_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.False(t, someDatetime.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.True(t, emptyDatetime.IsNil())
+	})
+}
+
+func TestDatetime_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		val, ok := someDatetime.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		_, ok := emptyDatetime.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestDatetime_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyDatetime.MustGet()
+		})
+	})
+}
+
+func TestDatetime_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.NotPanics(t, func() {
+			emptyDatetime.Unwrap()
+		})
+	})
+}
+
+func TestDatetime_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyDatetime.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestDatetime_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.UnwrapOrElse(func() time.Time {
+			return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyDatetime.UnwrapOrElse(func() time.Time {
+			return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}))
+	})
+}
+
+func TestDatetime_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneDatetime().All()))
+	})
+}
+
+func TestDatetime_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	otherDatetime := option.SomeDatetime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	emptyDatetime := option.NoneDatetime()
+
+	assert.True(t, someDatetime.Equal(option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))))
+	assert.True(t, emptyDatetime.Equal(option.NoneDatetime()))
+	assert.False(t, someDatetime.Equal(otherDatetime))
+	assert.False(t, someDatetime.Equal(emptyDatetime))
+	assert.False(t, emptyDatetime.Equal(someDatetime))
+
+	assert.Zero(t, someDatetime.Compare(option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))))
+	assert.Zero(t, emptyDatetime.Compare(option.NoneDatetime()))
+	assert.Equal(t, -otherDatetime.Compare(someDatetime), someDatetime.Compare(otherDatetime))
+	assert.NotZero(t, someDatetime.Compare(otherDatetime))
+	assert.Equal(t, 1, someDatetime.Compare(emptyDatetime))
+	assert.Equal(t, -1, emptyDatetime.Compare(someDatetime))
+}
+
+func TestDatetime_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.True(t, someDatetime.Filter(func(time.Time) bool { return true }).IsSome())
+		assert.False(t, someDatetime.Filter(func(time.Time) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.False(t, emptyDatetime.Filter(func(time.Time) bool { return true }).IsSome())
+	})
+}
+
+func TestDatetime_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		other := option.SomeDatetime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		other := option.SomeDatetime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyDatetime.Or(other).Unwrap())
+	})
+}
+
+func TestDatetime_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someDatetime.OrElse(func() option.Datetime {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyDatetime.OrElse(func() option.Datetime {
+			return option.SomeDatetime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		}).Unwrap())
+	})
+}
+
+func TestDatetime_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err := someDatetime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Datetime
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC))
+		err := someDatetime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Datetime
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDatetime := option.SomeDatetime(time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC))
+		err := someDatetime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Datetime
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyDatetime := option.NoneDatetime()
+		err := emptyDatetime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Datetime
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDatetime_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		require.NoError(t, someDatetime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDatetime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC))
+		require.NoError(t, someDatetime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDatetime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDatetime := option.SomeDatetime(time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC))
+		require.NoError(t, someDatetime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDatetime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneDatetime().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestDatetime_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		val, err := someDatetime.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Datetime
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		val, err := emptyDatetime.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestDatetime_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		data, err := json.Marshal(someDatetime)
+		require.NoError(t, err)
+
+		var unmarshaled option.Datetime
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		data, err := json.Marshal(emptyDatetime)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Datetime
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Datetime", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDatetime_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDatetime := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		data, err := someDatetime.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Datetime
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDatetime := option.NoneDatetime()
+		data, err := emptyDatetime.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDatetime_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Datetime
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Datetime
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeDatetime() {
+	opt := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: 2025-12-02 10:30:00 +0000 UTC
+}
+
+func ExampleNoneDatetime() {
+	opt := option.NoneDatetime()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleDatetime_IsSome() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleDatetime_IsZero() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleDatetime_IsNil() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleDatetime_Get() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC true
+	// 0001-01-01 00:00:00 +0000 UTC false
+}
+
+func ExampleDatetime_MustGet() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	fmt.Println(some.MustGet())
+	// Output: 2025-12-02 10:30:00 +0000 UTC
+}
+
+func ExampleDatetime_MustGet_panic() {
+	none := option.NoneDatetime()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleDatetime_Unwrap() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 0001-01-01 00:00:00 +0000 UTC
+}
+
+func ExampleDatetime_UnwrapOr() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	fmt.Println(none.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 2000-01-01 00:00:00 +0000 UTC
+}
+
+func ExampleDatetime_UnwrapOrElse() {
+	some := option.SomeDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneDatetime()
+	fmt.Println(some.UnwrapOrElse(func() time.Time {
+		return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}))
+	fmt.Println(none.UnwrapOrElse(func() time.Time {
+		return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}))
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 2000-01-01 00:00:00 +0000 UTC
+}
@@ -0,0 +1,300 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableDatetime represents a nullable value of type time.Time with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableDatetime struct {
+	value time.Time
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableDatetime]()
+}
+
+// SomeNullableDatetime creates a NullableDatetime with the given time.Time value.
+func SomeNullableDatetime(value time.Time) NullableDatetime {
+	return NullableDatetime{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableDatetime creates a NullableDatetime, that is explicitly set to null.
+func NullNullableDatetime() NullableDatetime {
+	return NullableDatetime{
+		value: zero[time.Time](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableDatetime creates a NullableDatetime, that is not set.
+// It is equal to the zero value of NullableDatetime.
+func UnsetNullableDatetime() NullableDatetime {
+	return NullableDatetime{
+		value: zero[time.Time](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableDatetime contains a value.
+func (o NullableDatetime) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableDatetime is explicitly set to null.
+func (o NullableDatetime) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableDatetime is either null or contains a value.
+func (o NullableDatetime) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableDatetime is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableDatetime) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of time.Time, false).
+func (o NullableDatetime) Get() (time.Time, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableDatetime) MustGet() time.Time {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Time.
+func (o NullableDatetime) Unwrap() time.Time {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableDatetime) UnwrapOr(defaultValue time.Time) time.Time {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableDatetime) UnwrapOrElse(defaultValue func() time.Time) time.Time {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableDatetime to Datetime. Both Null and Unset are converted to NoneDatetime().
+func (o NullableDatetime) Option() Datetime {
+	if o.state == nullableSome {
+		return SomeDatetime(o.value)
+	}
+
+	return NoneDatetime()
+}
+
+// Equal returns true if both NullableDatetimes have the same state and, if present, equal values.
+func (o NullableDatetime) Equal(other NullableDatetime) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalTime(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableDatetime) Compare(other NullableDatetime) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareTime(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableDatetime value using MessagePack format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableDatetime) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableDatetime", encodeDatetime(encoder, o.value))
+	}
+
+	return newEncodeError("NullableDatetime", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableDatetime value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableDatetime) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDatetime(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableDatetime", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableDatetime value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableDatetime)
+//   - time.Time: interpreted as a present value (SomeNullableDatetime)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDatetime) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableDatetime", "time.Time", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableDatetime", "time.Time", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Time
+
+		val, err = decodeDatetime(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableDatetime", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableDatetime", "time.Time", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableDatetime value using JSON format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableDatetime) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDatetime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableDatetime value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableDatetime)
+//   - time.Time: interpreted as a present value (SomeNullableDatetime)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDatetime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableDatetime", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Time.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableDatetime) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextTime(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDatetime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableDatetime)
+//   - text representation of time.Time: interpreted as a present value (SomeNullableDatetime)
+func (o *NullableDatetime) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextTime(text)
+	if err != nil {
+		return newDecodeError("NullableDatetime", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
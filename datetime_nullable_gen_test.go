@@ -0,0 +1,220 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"time"
+
+	"cmp"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestNullableDatetime_State(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.True(t, some.IsSome())
+		assert.False(t, some.IsNull())
+		assert.True(t, some.IsSet())
+		assert.False(t, some.IsZero())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullableDatetime()
+		assert.False(t, null.IsSome())
+		assert.True(t, null.IsNull())
+		assert.True(t, null.IsSet())
+		assert.False(t, null.IsZero())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		unset := option.UnsetNullableDatetime()
+		assert.False(t, unset.IsSome())
+		assert.False(t, unset.IsNull())
+		assert.False(t, unset.IsSet())
+		assert.True(t, unset.IsZero())
+		assert.Equal(t, option.NullableDatetime{}, unset)
+	})
+}
+
+func TestNullableDatetime_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		val, ok := some.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), val)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), some.MustGet())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), some.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		assert.True(t, some.Option().IsSome())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		null := option.NullNullableDatetime()
+		_, ok := null.Get()
+		require.False(t, ok)
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			null.MustGet()
+		})
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), null.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+		assert.False(t, null.Option().IsSome())
+	})
+}
+
+func TestNullableDatetime_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	ordered := []option.NullableDatetime{
+		option.UnsetNullableDatetime(),
+		option.NullNullableDatetime(),
+		option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)),
+	}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			assert.Equal(t, i == j, a.Equal(b))
+			assert.Equal(t, cmp.Compare(i, j), a.Compare(b))
+		}
+	}
+
+	assert.False(t, ordered[2].Equal(option.SomeNullableDatetime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))))
+}
+
+func TestNullableDatetime_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	for name, value := range map[string]option.NullableDatetime{
+		"some":  option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)),
+		"null":  option.NullNullableDatetime(),
+		"unset": option.UnsetNullableDatetime(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, err := msgpack.Marshal(value)
+			require.NoError(t, err)
+
+			data, err := value.AppendMsgpack(nil)
+			require.NoError(t, err)
+			assert.Equal(t, expected, data)
+		})
+	}
+}
+
+func TestNullableDatetime_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.NullableDatetime `msgpack:"field,omitempty"`
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.NullNullableDatetime()})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(patch{Field: option.UnsetNullableDatetime()})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x80}, data)
+
+		var unmarshaled patch
+		require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+}
+
+func TestNullableDatetime_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type patch struct {
+		Field option.NullableDatetime `json:"field,omitzero"`
+	}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.SomeNullableDatetime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))})
+		require.NoError(t, err)
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Field.Unwrap())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.NullNullableDatetime()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"field":null}`, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.True(t, unmarshaled.Field.IsNull())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(patch{Field: option.UnsetNullableDatetime()})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{}`, string(data))
+
+		var unmarshaled patch
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.False(t, unmarshaled.Field.IsSet())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.NullableDatetime
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "NullableDatetime", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSet())
+	})
+}
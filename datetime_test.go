@@ -0,0 +1,139 @@
+package option_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+// datetimeFixtures contains datetimes encoded by Tarantool.
+var datetimeFixtures = []struct {
+	name string
+	time time.Time
+	data []byte
+}{
+	{
+		name: "epoch",
+		time: time.Unix(0, 0).UTC(),
+		data: []byte{0xd7, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+	},
+	{
+		name: "seconds only",
+		time: time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC),
+		data: []byte{0xd7, 0x04, 0xa8, 0xbf, 0x2e, 0x69, 0x00, 0x00, 0x00, 0x00},
+	},
+	{
+		name: "before epoch",
+		time: time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC),
+		data: []byte{0xd7, 0x04, 0xe4, 0x95, 0x27, 0xff, 0xff, 0xff, 0xff, 0xff},
+	},
+	{
+		name: "nanoseconds and offset",
+		time: time.Date(2025, time.December, 2, 13, 30, 0, 123456789, time.FixedZone("", 3*60*60)),
+		data: []byte{
+			0xd8, 0x04,
+			0xa8, 0xbf, 0x2e, 0x69, 0x00, 0x00, 0x00, 0x00,
+			0x15, 0xcd, 0x5b, 0x07, 0xb4, 0x00, 0x00, 0x00,
+		},
+	},
+	{
+		name: "negative offset",
+		time: time.Date(2025, time.December, 2, 5, 30, 0, 0, time.FixedZone("", -5*60*60)),
+		data: []byte{
+			0xd8, 0x04,
+			0xa8, 0xbf, 0x2e, 0x69, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0xd4, 0xfe, 0x00, 0x00,
+		},
+	},
+}
+
+func TestDatetime_EncodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range datetimeFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := msgpack.Marshal(option.SomeDatetime(fixture.time))
+			require.NoError(t, err)
+			assert.Equal(t, fixture.data, data)
+		})
+	}
+}
+
+func TestDatetime_DecodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range datetimeFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Datetime
+
+			err := msgpack.Unmarshal(fixture.data, &opt)
+			require.NoError(t, err)
+			require.True(t, opt.IsSome())
+			assert.True(t, fixture.time.Equal(opt.Unwrap()))
+
+			_, expectedOffset := fixture.time.Zone()
+			_, actualOffset := opt.Unwrap().Zone()
+			assert.Equal(t, expectedOffset, actualOffset)
+		})
+	}
+}
+
+func TestDatetime_DecodeTimezoneIndex(t *testing.T) {
+	t.Parallel()
+
+	// 2025-12-02T13:30:00+03:00 with a time zone index (947) set.
+	data := []byte{
+		0xd8, 0x04,
+		0xa8, 0xbf, 0x2e, 0x69, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0xb4, 0x00, 0xb3, 0x03,
+	}
+
+	var opt option.Datetime
+
+	err := msgpack.Unmarshal(data, &opt)
+	require.NoError(t, err)
+
+	_, offset := opt.Unwrap().Zone()
+	assert.Equal(t, 3*60*60, offset)
+	assert.Equal(t, 13, opt.Unwrap().Hour())
+}
+
+func TestDatetime_DecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"wrong ext code", []byte{0xd7, 0x05, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"short payload", []byte{0xd6, 0x04, 0, 0, 0, 0}},
+		{"nanoseconds overflow", []byte{
+			0xd8, 0x04,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			0x00, 0xca, 0x9a, 0x3b, 0x00, 0x00, 0x00, 0x00,
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Datetime
+
+			err := msgpack.Unmarshal(tc.data, &opt)
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, "Datetime", decodeErr.Type)
+		})
+	}
+}
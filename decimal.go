@@ -0,0 +1,308 @@
+package option
+
+// This file implements DecimalValue, a self-contained representation of Tarantool decimal numbers,
+// and conversion to/from the MessagePack extension format used by Tarantool (MP_EXT type 1):
+//
+//	+--------+-------------------+------------------------------------------------+
+//	| MP_EXT | scale             | packed BCD: digit nibbles, last nibble is sign |
+//	| type 1 | (MP_INT/MP_UINT)  | (0x0b or 0x0d - negative, otherwise positive)  |
+//	+--------+-------------------+------------------------------------------------+
+//
+// The value of a decimal is coefficient * 10^(-scale).
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DecimalExtCode is the MessagePack extension code of Tarantool decimal type.
+	DecimalExtCode = 1
+)
+
+const (
+	bcdPositive        = 0x0c
+	bcdNegative        = 0x0d
+	bcdNegativeAlt     = 0x0b
+	bcdMinSign         = 0x0a
+	bcdMaxDigit        = 9
+	bcdNibbleBits      = 4
+	bcdLowNibbleMask   = 0x0f
+	decimalStringBase  = 10
+	decimalScaleBits   = 32
+	decimalExponentSep = "eE"
+)
+
+var (
+	errDecimalSyntax    = errors.New("invalid decimal syntax")
+	errDecimalRange     = errors.New("decimal scale is out of range")
+	errDecimalTruncated = errors.New("decimal payload is truncated")
+	errDecimalBCD       = errors.New("invalid BCD nibble in decimal payload")
+)
+
+// DecimalValue is an arbitrary precision decimal number, compatible with Tarantool decimal type.
+//
+// It's represented as a coefficient and a scale: the value equals coefficient * 10^(-scale).
+// The scale is preserved as is, so "1.50" and "1.5" are different (but numerically equal) values.
+// DecimalValue is comparable and its zero value is 0.
+type DecimalValue struct {
+	digits   string // Digits of the coefficient without leading zeros, empty for zero.
+	negative bool
+	scale    int32
+}
+
+// NewDecimalValue creates a DecimalValue equal to coefficient * 10^(-scale).
+func NewDecimalValue(coefficient *big.Int, scale int32) DecimalValue {
+	digits := coefficient.Text(decimalStringBase)
+	negative := strings.HasPrefix(digits, "-")
+
+	return newDecimalValue(strings.TrimPrefix(digits, "-"), negative, scale)
+}
+
+func newDecimalValue(digits string, negative bool, scale int32) DecimalValue {
+	digits = strings.TrimLeft(digits, "0")
+
+	return DecimalValue{
+		digits:   digits,
+		negative: negative && digits != "",
+		scale:    scale,
+	}
+}
+
+// ParseDecimalValue parses a decimal number in the form "[+-]digits[.digits][e[+-]digits]".
+func ParseDecimalValue(str string) (DecimalValue, error) {
+	input := str
+
+	negative := false
+	if len(str) > 0 && (str[0] == '+' || str[0] == '-') {
+		negative = str[0] == '-'
+		str = str[1:]
+	}
+
+	exponent := int64(0)
+
+	if idx := strings.IndexAny(str, decimalExponentSep); idx >= 0 {
+		var err error
+
+		exponent, err = strconv.ParseInt(str[idx+1:], decimalStringBase, decimalScaleBits)
+		if err != nil {
+			return DecimalValue{}, fmt.Errorf("%w: %q", errDecimalSyntax, input)
+		}
+
+		str = str[:idx]
+	}
+
+	intPart, fracPart, _ := strings.Cut(str, ".")
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return DecimalValue{}, fmt.Errorf("%w: %q", errDecimalSyntax, input)
+	}
+
+	scale := int64(len(fracPart)) - exponent
+	if scale < math.MinInt32 || scale > math.MaxInt32 {
+		return DecimalValue{}, fmt.Errorf("%w: %q", errDecimalRange, input)
+	}
+
+	return newDecimalValue(intPart+fracPart, negative, int32(scale)), nil
+}
+
+// MustParseDecimalValue is like ParseDecimalValue, but panics if the string can't be parsed.
+func MustParseDecimalValue(str string) DecimalValue {
+	val, err := ParseDecimalValue(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return val
+}
+
+func isDigits(str string) bool {
+	for _, c := range []byte(str) {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Coefficient returns the coefficient of the decimal.
+func (d DecimalValue) Coefficient() *big.Int {
+	coefficient := new(big.Int)
+	if d.digits == "" {
+		return coefficient
+	}
+
+	coefficient.SetString(d.digits, decimalStringBase)
+
+	if d.negative {
+		coefficient.Neg(coefficient)
+	}
+
+	return coefficient
+}
+
+// Scale returns the scale of the decimal.
+func (d DecimalValue) Scale() int32 {
+	return d.scale
+}
+
+// Rat returns the decimal as a rational number.
+func (d DecimalValue) Rat() *big.Rat {
+	pow := new(big.Int).Exp(big.NewInt(decimalStringBase), big.NewInt(absInt32(d.scale)), nil)
+	if d.scale >= 0 {
+		return new(big.Rat).SetFrac(d.Coefficient(), pow)
+	}
+
+	return new(big.Rat).SetInt(pow.Mul(pow, d.Coefficient()))
+}
+
+func absInt32(val int32) int64 {
+	if val < 0 {
+		return -int64(val)
+	}
+
+	return int64(val)
+}
+
+// String returns the text representation of the decimal without an exponent, e.g. "-12.34".
+func (d DecimalValue) String() string {
+	digits := d.digits
+	if digits == "" {
+		digits = "0"
+	}
+
+	var builder strings.Builder
+
+	if d.negative {
+		builder.WriteByte('-')
+	}
+
+	switch {
+	case d.scale <= 0:
+		builder.WriteString(digits)
+		builder.WriteString(strings.Repeat("0", int(-int64(d.scale))))
+	case len(digits) > int(d.scale):
+		point := len(digits) - int(d.scale)
+		builder.WriteString(digits[:point])
+		builder.WriteByte('.')
+		builder.WriteString(digits[point:])
+	default:
+		builder.WriteString("0.")
+		builder.WriteString(strings.Repeat("0", int(d.scale)-len(digits)))
+		builder.WriteString(digits)
+	}
+
+	return builder.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (d DecimalValue) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (d *DecimalValue) UnmarshalText(text []byte) error {
+	val, err := ParseDecimalValue(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = val
+
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both JSON strings and JSON numbers are accepted.
+func (d *DecimalValue) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if strings.HasPrefix(text, `"`) {
+		unquoted, err := strconv.Unquote(text)
+		if err != nil {
+			return fmt.Errorf("%w: %s", errDecimalSyntax, text)
+		}
+
+		text = unquoted
+	}
+
+	return d.UnmarshalText([]byte(text))
+}
+
+// appendDecimalPayload appends the Tarantool MP_EXT payload (scale and packed BCD) of the decimal to dst.
+func appendDecimalPayload(dst []byte, val DecimalValue) []byte {
+	dst = appendMsgpackInt(dst, int64(val.scale))
+
+	digits := val.digits
+	if digits == "" {
+		digits = "0"
+	}
+
+	sign := byte(bcdPositive)
+	if val.negative {
+		sign = bcdNegative
+	}
+
+	// Total amount of nibbles must be even, so pad the number with a leading zero if needed.
+	nibbles := make([]byte, 0, len(digits)+2) //nolint:mnd
+	if len(digits)%2 == 0 {
+		nibbles = append(nibbles, 0)
+	}
+
+	for _, c := range []byte(digits) {
+		nibbles = append(nibbles, c-'0')
+	}
+
+	nibbles = append(nibbles, sign)
+
+	for i := 0; i < len(nibbles); i += 2 {
+		dst = append(dst, nibbles[i]<<bcdNibbleBits|nibbles[i+1])
+	}
+
+	return dst
+}
+
+// parseDecimalPayload parses the Tarantool MP_EXT payload (scale and packed BCD) of the decimal.
+func parseDecimalPayload(payload []byte) (DecimalValue, error) {
+	scale, rest, err := readMsgpackInt(payload)
+	switch {
+	case err != nil:
+		return DecimalValue{}, fmt.Errorf("failed to read scale: %w", err)
+	case scale < math.MinInt32 || scale > math.MaxInt32:
+		return DecimalValue{}, errDecimalRange
+	case len(rest) == 0:
+		return DecimalValue{}, errDecimalTruncated
+	}
+
+	digits := make([]byte, 0, 2*len(rest)) //nolint:mnd
+
+	for i, b := range rest {
+		high, low := b>>bcdNibbleBits, b&bcdLowNibbleMask
+		if high > bcdMaxDigit {
+			return DecimalValue{}, errDecimalBCD
+		}
+
+		digits = append(digits, '0'+high)
+
+		if i == len(rest)-1 {
+			if low < bcdMinSign {
+				return DecimalValue{}, errDecimalBCD
+			}
+
+			negative := low == bcdNegative || low == bcdNegativeAlt
+
+			return newDecimalValue(string(digits), negative, int32(scale)), nil
+		}
+
+		if low > bcdMaxDigit {
+			return DecimalValue{}, errDecimalBCD
+		}
+
+		digits = append(digits, '0'+low)
+	}
+
+	return DecimalValue{}, errDecimalTruncated // Unreachable, since rest is not empty.
+}
@@ -0,0 +1,411 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Decimal represents an optional value of type DecimalValue.
+// It can either hold a valid DecimalValue (IsSome == true) or be empty (IsZero == true).
+type Decimal struct {
+	value  DecimalValue
+	exists bool
+}
+
+var _ commonInterface[DecimalValue] = (*Decimal)(nil)
+
+// SomeDecimal creates an optional Decimal with the given DecimalValue value.
+// The returned Decimal will have IsSome() == true and IsZero() == false.
+func SomeDecimal(value DecimalValue) Decimal {
+	return Decimal{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneDecimal creates an empty optional Decimal value.
+// The returned Decimal will have IsSome() == false and IsZero() == true.
+func NoneDecimal() Decimal {
+	return Decimal{
+		exists: false,
+		value:  zero[DecimalValue](),
+	}
+}
+
+// IsSome returns true if the Decimal contains a value.
+// This indicates the value is explicitly set (not None).
+func (o Decimal) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the Decimal does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o Decimal) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o Decimal) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of DecimalValue, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o Decimal) Get() (DecimalValue, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o Decimal) MustGet() DecimalValue {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for DecimalValue.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o Decimal) Unwrap() DecimalValue {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Decimal) UnwrapOr(defaultValue DecimalValue) DecimalValue {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o Decimal) UnwrapOrElse(defaultValue func() DecimalValue) DecimalValue {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Decimal) All() iter.Seq[DecimalValue] {
+	return func(yield func(DecimalValue) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Decimals are None, or both contain equal values.
+func (o Decimal) Equal(other Decimal) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalDecimal(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneDecimal() is less than any value.
+func (o Decimal) Compare(other Decimal) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareDecimal(o.value, other.value)
+	})
+}
+
+// Filter returns the Decimal itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneDecimal().
+func (o Decimal) Filter(predicate func(DecimalValue) bool) Decimal {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneDecimal()
+}
+
+// Or returns the Decimal itself if it contains a value.
+// Otherwise, returns other.
+func (o Decimal) Or(other Decimal) Decimal {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Decimal itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Decimal) OrElse(fn func() Decimal) Decimal {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the Decimal value using MessagePack format.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o Decimal) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("Decimal", encodeDecimal(encoder, o.value))
+	}
+
+	return newEncodeError("Decimal", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Decimal value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Decimal) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDecimal(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Decimal", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Decimal value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneDecimal)
+//   - DecimalValue: interpreted as a present value (SomeDecimal)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on DecimalValue: exists = true, value = decoded value
+func (o *Decimal) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Decimal", "DecimalValue", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("Decimal", "DecimalValue", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val DecimalValue
+
+		val, err = decodeDecimal(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Decimal", "DecimalValue", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("Decimal", "DecimalValue", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as DecimalValue.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Decimal) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueDecimal(o.value)
+	if err != nil {
+		return nil, newEncodeError("Decimal", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneDecimal)
+//   - DecimalValue: interpreted as a present value (SomeDecimal)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into DecimalValue.
+func (o *Decimal) Scan(src any) error {
+	if src == nil {
+		o.value = zero[DecimalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanDecimal(src)
+	if err != nil {
+		return newDecodeError("Decimal", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Decimal value using JSON format.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Decimal) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Decimal", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Decimal value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneDecimal)
+//   - DecimalValue: interpreted as a present value (SomeDecimal)
+//
+// Returns an error if the input can't be decoded as DecimalValue.
+func (o *Decimal) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[DecimalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Decimal", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of DecimalValue.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Decimal) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextDecimal(o.value)
+	if err != nil {
+		return nil, newEncodeError("Decimal", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneDecimal)
+//   - text representation of DecimalValue: interpreted as a present value (SomeDecimal)
+//
+// Returns an error if the text can't be parsed as DecimalValue.
+func (o *Decimal) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[DecimalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextDecimal(text)
+	if err != nil {
+		return newDecodeError("Decimal", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Decimal.
+// The Decimal stays None until the flag is passed, the value is parsed
+// as text representation of DecimalValue. Get of the returned flag.Getter returns the Decimal.
+//
+// Example:
+//
+//	var opt option.Decimal
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Decimal) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextDecimal([]byte(text))
+			if err != nil {
+				return newDecodeError("Decimal", err)
+			}
+
+			*o = SomeDecimal(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
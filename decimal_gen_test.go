@@ -0,0 +1,661 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestDecimal_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.True(t, someDecimal.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.False(t, emptyDecimal.IsSome())
+	})
+}
+
+func TestDecimal_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.False(t, someDecimal.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.True(t, emptyDecimal.IsZero())
+	})
+}
+
+func TestDecimal_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.False(t, someDecimal.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.True(t, emptyDecimal.IsNil())
+	})
+}
+
+func TestDecimal_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		val, ok := someDecimal.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		_, ok := emptyDecimal.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestDecimal_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyDecimal.MustGet()
+		})
+	})
+}
+
+func TestDecimal_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.NotPanics(t, func() {
+			emptyDecimal.Unwrap()
+		})
+	})
+}
+
+func TestDecimal_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.UnwrapOr(option.MustParseDecimalValue("56.78")))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.EqualValues(t, option.MustParseDecimalValue("56.78"), emptyDecimal.UnwrapOr(option.MustParseDecimalValue("56.78")))
+	})
+}
+
+func TestDecimal_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.UnwrapOrElse(func() option.DecimalValue {
+			return option.MustParseDecimalValue("56.78")
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.EqualValues(t, option.MustParseDecimalValue("56.78"), emptyDecimal.UnwrapOrElse(func() option.DecimalValue {
+			return option.MustParseDecimalValue("56.78")
+		}))
+	})
+}
+
+func TestDecimal_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeDecimal(option.MustParseDecimalValue("12.34")).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneDecimal().All()))
+	})
+}
+
+func TestDecimal_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	otherDecimal := option.SomeDecimal(option.MustParseDecimalValue("56.78"))
+	emptyDecimal := option.NoneDecimal()
+
+	assert.True(t, someDecimal.Equal(option.SomeDecimal(option.MustParseDecimalValue("12.34"))))
+	assert.True(t, emptyDecimal.Equal(option.NoneDecimal()))
+	assert.False(t, someDecimal.Equal(otherDecimal))
+	assert.False(t, someDecimal.Equal(emptyDecimal))
+	assert.False(t, emptyDecimal.Equal(someDecimal))
+
+	assert.Zero(t, someDecimal.Compare(option.SomeDecimal(option.MustParseDecimalValue("12.34"))))
+	assert.Zero(t, emptyDecimal.Compare(option.NoneDecimal()))
+	assert.Equal(t, -otherDecimal.Compare(someDecimal), someDecimal.Compare(otherDecimal))
+	assert.NotZero(t, someDecimal.Compare(otherDecimal))
+	assert.Equal(t, 1, someDecimal.Compare(emptyDecimal))
+	assert.Equal(t, -1, emptyDecimal.Compare(someDecimal))
+}
+
+func TestDecimal_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.True(t, someDecimal.Filter(func(option.DecimalValue) bool { return true }).IsSome())
+		assert.False(t, someDecimal.Filter(func(option.DecimalValue) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.False(t, emptyDecimal.Filter(func(option.DecimalValue) bool { return true }).IsSome())
+	})
+}
+
+func TestDecimal_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		other := option.SomeDecimal(option.MustParseDecimalValue("56.78"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		other := option.SomeDecimal(option.MustParseDecimalValue("56.78"))
+		assert.EqualValues(t, option.MustParseDecimalValue("56.78"), emptyDecimal.Or(other).Unwrap())
+	})
+}
+
+func TestDecimal_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), someDecimal.OrElse(func() option.Decimal {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		assert.EqualValues(t, option.MustParseDecimalValue("56.78"), emptyDecimal.OrElse(func() option.Decimal {
+			return option.SomeDecimal(option.MustParseDecimalValue("56.78"))
+		}).Unwrap())
+	})
+}
+
+func TestDecimal_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		err := someDecimal.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Decimal
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("-0.001"))
+		err := someDecimal.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Decimal
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("-0.001"), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("1234567890123456789012345678901234567.8"))
+		err := someDecimal.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Decimal
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("1234567890123456789012345678901234567.8"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyDecimal := option.NoneDecimal()
+		err := emptyDecimal.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Decimal
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDecimal_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		require.NoError(t, someDecimal.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDecimal.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("-0.001"))
+		require.NoError(t, someDecimal.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDecimal.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("1234567890123456789012345678901234567.8"))
+		require.NoError(t, someDecimal.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDecimal.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneDecimal().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestDecimal_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		val, err := someDecimal.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Decimal
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		val, err := emptyDecimal.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestDecimal_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		data, err := json.Marshal(someDecimal)
+		require.NoError(t, err)
+
+		var unmarshaled option.Decimal
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		data, err := json.Marshal(emptyDecimal)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Decimal
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Decimal", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDecimal_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDecimal := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		data, err := someDecimal.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Decimal
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDecimal := option.NoneDecimal()
+		data, err := emptyDecimal.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDecimal_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeDecimal(option.MustParseDecimalValue("12.34")).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Decimal
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, option.MustParseDecimalValue("12.34"), opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Decimal
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeDecimal() {
+	opt := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: 12.34
+}
+
+func ExampleNoneDecimal() {
+	opt := option.NoneDecimal()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleDecimal_IsSome() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleDecimal_IsZero() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleDecimal_IsNil() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleDecimal_Get() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// 12.34 true
+	// 0 false
+}
+
+func ExampleDecimal_MustGet() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	fmt.Println(some.MustGet())
+	// Output: 12.34
+}
+
+func ExampleDecimal_MustGet_panic() {
+	none := option.NoneDecimal()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleDecimal_Unwrap() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// 12.34
+	// 0
+}
+
+func ExampleDecimal_UnwrapOr() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.UnwrapOr(option.MustParseDecimalValue("56.78")))
+	fmt.Println(none.UnwrapOr(option.MustParseDecimalValue("56.78")))
+	// Output:
+	// 12.34
+	// 56.78
+}
+
+func ExampleDecimal_UnwrapOrElse() {
+	some := option.SomeDecimal(option.MustParseDecimalValue("12.34"))
+	none := option.NoneDecimal()
+	fmt.Println(some.UnwrapOrElse(func() option.DecimalValue {
+		return option.MustParseDecimalValue("56.78")
+	}))
+	fmt.Println(none.UnwrapOrElse(func() option.DecimalValue {
+		return option.MustParseDecimalValue("56.78")
+	}))
+	// Output:
+	// 12.34
+	// 56.78
+}
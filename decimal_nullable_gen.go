@@ -0,0 +1,298 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableDecimal represents a nullable value of type DecimalValue with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableDecimal struct {
+	value DecimalValue
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableDecimal]()
+}
+
+// SomeNullableDecimal creates a NullableDecimal with the given DecimalValue value.
+func SomeNullableDecimal(value DecimalValue) NullableDecimal {
+	return NullableDecimal{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableDecimal creates a NullableDecimal, that is explicitly set to null.
+func NullNullableDecimal() NullableDecimal {
+	return NullableDecimal{
+		value: zero[DecimalValue](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableDecimal creates a NullableDecimal, that is not set.
+// It is equal to the zero value of NullableDecimal.
+func UnsetNullableDecimal() NullableDecimal {
+	return NullableDecimal{
+		value: zero[DecimalValue](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableDecimal contains a value.
+func (o NullableDecimal) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableDecimal is explicitly set to null.
+func (o NullableDecimal) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableDecimal is either null or contains a value.
+func (o NullableDecimal) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableDecimal is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableDecimal) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of DecimalValue, false).
+func (o NullableDecimal) Get() (DecimalValue, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableDecimal) MustGet() DecimalValue {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for DecimalValue.
+func (o NullableDecimal) Unwrap() DecimalValue {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableDecimal) UnwrapOr(defaultValue DecimalValue) DecimalValue {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableDecimal) UnwrapOrElse(defaultValue func() DecimalValue) DecimalValue {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableDecimal to Decimal. Both Null and Unset are converted to NoneDecimal().
+func (o NullableDecimal) Option() Decimal {
+	if o.state == nullableSome {
+		return SomeDecimal(o.value)
+	}
+
+	return NoneDecimal()
+}
+
+// Equal returns true if both NullableDecimals have the same state and, if present, equal values.
+func (o NullableDecimal) Equal(other NullableDecimal) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalDecimal(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableDecimal) Compare(other NullableDecimal) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareDecimal(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableDecimal value using MessagePack format.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableDecimal) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableDecimal", encodeDecimal(encoder, o.value))
+	}
+
+	return newEncodeError("NullableDecimal", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableDecimal value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableDecimal) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDecimal(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableDecimal", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableDecimal value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableDecimal)
+//   - DecimalValue: interpreted as a present value (SomeNullableDecimal)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDecimal) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableDecimal", "DecimalValue", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[DecimalValue]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableDecimal", "DecimalValue", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val DecimalValue
+
+		val, err = decodeDecimal(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableDecimal", "DecimalValue", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableDecimal", "DecimalValue", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableDecimal value using JSON format.
+// - If the value is present, it is encoded as DecimalValue.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableDecimal) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDecimal", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableDecimal value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableDecimal)
+//   - DecimalValue: interpreted as a present value (SomeNullableDecimal)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDecimal) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[DecimalValue]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableDecimal", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of DecimalValue.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableDecimal) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextDecimal(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDecimal", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableDecimal)
+//   - text representation of DecimalValue: interpreted as a present value (SomeNullableDecimal)
+func (o *NullableDecimal) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[DecimalValue]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextDecimal(text)
+	if err != nil {
+		return newDecodeError("NullableDecimal", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package option_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+// decimalFixtures contains decimals encoded by Tarantool.
+var decimalFixtures = []struct {
+	str  string
+	data []byte
+}{
+	{"0", []byte{0xd5, 0x01, 0x00, 0x0c}},
+	{"1", []byte{0xd5, 0x01, 0x00, 0x1c}},
+	{"-1", []byte{0xd5, 0x01, 0x00, 0x1d}},
+	{"0.1", []byte{0xd5, 0x01, 0x01, 0x1c}},
+	{"-0.1", []byte{0xd5, 0x01, 0x01, 0x1d}},
+	{"0.001", []byte{0xd5, 0x01, 0x03, 0x1c}},
+	{"12.34", []byte{0xd6, 0x01, 0x02, 0x01, 0x23, 0x4c}},
+	{"-12.34", []byte{0xd6, 0x01, 0x02, 0x01, 0x23, 0x4d}},
+	{"1234567890", []byte{0xc7, 0x07, 0x01, 0x00, 0x01, 0x23, 0x45, 0x67, 0x89, 0x0c}},
+	{"-1234567890.1", []byte{0xc7, 0x07, 0x01, 0x01, 0x12, 0x34, 0x56, 0x78, 0x90, 0x1d}},
+	{
+		"99999999999999999999999999999999999999",
+		[]byte{
+			0xc7, 0x15, 0x01, 0x00,
+			0x09, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99,
+			0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x99, 0x9c,
+		},
+	},
+	{
+		"0.0000000000000000000000000000000000001",
+		[]byte{0xd5, 0x01, 0x25, 0x1c},
+	},
+}
+
+func TestDecimal_EncodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range decimalFixtures {
+		t.Run(fixture.str, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := msgpack.Marshal(option.SomeDecimal(option.MustParseDecimalValue(fixture.str)))
+			require.NoError(t, err)
+			assert.Equal(t, fixture.data, data)
+		})
+	}
+}
+
+func TestDecimal_DecodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range decimalFixtures {
+		t.Run(fixture.str, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Decimal
+
+			err := msgpack.Unmarshal(fixture.data, &opt)
+			require.NoError(t, err)
+			assert.True(t, opt.IsSome())
+			assert.Equal(t, fixture.str, opt.Unwrap().String())
+		})
+	}
+}
+
+func TestDecimal_DecodeAlternativeEncodings(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+		str  string
+	}{
+		{"sign 0x0a", []byte{0xd5, 0x01, 0x00, 0x1a}, "1"},
+		{"sign 0x0b", []byte{0xd5, 0x01, 0x00, 0x1b}, "-1"},
+		{"sign 0x0e", []byte{0xd5, 0x01, 0x00, 0x1e}, "1"},
+		{"sign 0x0f", []byte{0xd5, 0x01, 0x00, 0x1f}, "1"},
+		{"negative zero", []byte{0xd5, 0x01, 0x00, 0x0d}, "0"},
+		{"leading zeros", []byte{0xd6, 0x01, 0x00, 0x00, 0x00, 0x1c}, "1"},
+		{"negative scale", []byte{0xd5, 0x01, 0xfe, 0x1c}, "100"},
+		{"uint8 scale", []byte{0xd6, 0x01, 0xcc, 0x02, 0x00, 0x1c}, "0.01"},
+		{"int16 scale", []byte{0xc7, 0x04, 0x01, 0xd1, 0xff, 0xff, 0x1c}, "10"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Decimal
+
+			err := msgpack.Unmarshal(tc.data, &opt)
+			require.NoError(t, err)
+			assert.Equal(t, tc.str, opt.Unwrap().String())
+		})
+	}
+}
+
+func TestDecimal_DecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"wrong ext code", []byte{0xd5, 0x02, 0x00, 0x1c}},
+		{"digit nibble", []byte{0xd5, 0x01, 0x00, 0xac}},
+		{"sign nibble", []byte{0xd5, 0x01, 0x00, 0x11}},
+		{"no digits", []byte{0xd4, 0x01, 0x00}},
+		{"truncated scale", []byte{0xd4, 0x01, 0xd1}},
+		{"invalid scale code", []byte{0xd5, 0x01, 0xc0, 0x1c}},
+		{"not ext", []byte{0xa1, 0x31}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Decimal
+
+			err := msgpack.Unmarshal(tc.data, &opt)
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, "Decimal", decodeErr.Type)
+		})
+	}
+}
+
+func TestDecimal_None(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(option.NoneDecimal())
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xc0}, data)
+
+	opt := option.SomeDecimal(option.MustParseDecimalValue("1"))
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.True(t, opt.IsZero())
+}
+
+func TestParseDecimalValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		str   string
+		coef  int64
+		scale int32
+	}{
+		{"0", "0", 0, 0},
+		{"-0", "0", 0, 0},
+		{"+12.34", "12.34", 1234, 2},
+		{"-12.340", "-12.340", -12340, 3},
+		{".5", "0.5", 5, 1},
+		{"5.", "5", 5, 0},
+		{"007", "7", 7, 0},
+		{"1e3", "1000", 1, -3},
+		{"1.5E-3", "0.0015", 15, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			val, err := option.ParseDecimalValue(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.str, val.String())
+			assert.Equal(t, big.NewInt(tc.coef), val.Coefficient())
+			assert.Equal(t, tc.scale, val.Scale())
+			assert.Equal(t, val, option.NewDecimalValue(big.NewInt(tc.coef), tc.scale))
+		})
+	}
+
+	for _, input := range []string{"", "-", ".", "1.2.3", "abc", "1e", "1e1.5", "--1", "1e99999999999"} {
+		_, err := option.ParseDecimalValue(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestDecimalValue_Rat(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, big.NewRat(-617, 50), option.MustParseDecimalValue("-12.34").Rat())
+	assert.Equal(t, big.NewRat(1200, 1), option.MustParseDecimalValue("12e2").Rat())
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(option.SomeDecimal(option.MustParseDecimalValue("-12.34")))
+	require.NoError(t, err)
+	assert.JSONEq(t, `"-12.34"`, string(data))
+
+	var opt option.Decimal
+
+	require.NoError(t, json.Unmarshal([]byte(`12.5`), &opt))
+	assert.Equal(t, option.SomeDecimal(option.MustParseDecimalValue("12.5")), opt)
+}
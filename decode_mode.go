@@ -0,0 +1,114 @@
+package option
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"weak"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// DecodeMode defines which MessagePack encodings are accepted by DecodeMsgpack
+// methods of the pre-generated optional types.
+type DecodeMode uint32
+
+const (
+	// DecodeModeDefault accepts only the native encoding of a type.
+	DecodeModeDefault DecodeMode = iota
+	// DecodeModeLenient additionally accepts alternative encodings of a type.
+	// For example, option.Time is decoded from an integer or float Unix epoch
+	// and from an RFC3339 string, option.Duration is decoded from a string
+	// in time.ParseDuration format. Numeric types accept numbers encoded as
+	// strings, booleans as 0 and 1, and integer types accept integral floats.
+	// option.Bool accepts 0, 1 and strings, option.String accepts numbers.
+	// Conversions, that lose precision or don't fit into the type, fail.
+	DecodeModeLenient
+	// DecodeModeStrict rejects numbers, that can't be represented by a numeric type exactly,
+	// instead of narrowing them. Integers must fit into the range of the type, negative integers
+	// are not accepted by unsigned types, floats and integers are accepted by float types only
+	// if no precision is lost. The rejected value and its code are reported in DecodeError.
+	// It applies to numeric pre-generated types, Generic[T] and Nullable[T] of numeric types.
+	DecodeModeStrict
+)
+
+// decodeModeUnset marks decoders, whose mode is reset with ResetDecodeMode. The entry is kept,
+// so the cleanup of the decoder is registered only once.
+const decodeModeUnset = ^DecodeMode(0)
+
+var ( //nolint:gochecknoglobals
+	// defaultDecodeMode is used by decoders without their own mode.
+	defaultDecodeMode atomic.Uint32
+
+	// decoderModes maps weak.Pointer[msgpack.Decoder] to DecodeMode. Entries are
+	// removed when the decoder is garbage collected.
+	decoderModes      sync.Map
+	decoderModesCount atomic.Int64
+)
+
+// SetDefaultDecodeMode sets the decode mode, that is used by all decoders,
+// which have no mode set with SetDecodeMode.
+//
+// It is safe to call SetDefaultDecodeMode concurrently with decoding.
+func SetDefaultDecodeMode(mode DecodeMode) {
+	defaultDecodeMode.Store(uint32(mode))
+}
+
+// DefaultDecodeMode returns the decode mode set by SetDefaultDecodeMode.
+func DefaultDecodeMode() DecodeMode {
+	return DecodeMode(defaultDecodeMode.Load())
+}
+
+// SetDecodeMode sets the decode mode for the given decoder only. It takes
+// precedence over the mode set with SetDefaultDecodeMode and is kept
+// after decoder.Reset.
+//
+// The mode is kept by decoders, that are returned to the pool with msgpack.PutDecoder, too,
+// so it is passed to the next user of msgpack.GetDecoder. Call ResetDecodeMode before
+// msgpack.PutDecoder to avoid it.
+func SetDecodeMode(decoder *msgpack.Decoder, mode DecodeMode) {
+	key := weak.Make(decoder)
+
+	_, loaded := decoderModes.Swap(key, mode)
+	if loaded {
+		return
+	}
+
+	decoderModesCount.Add(1)
+	runtime.AddCleanup(decoder, func(key weak.Pointer[msgpack.Decoder]) {
+		decoderModes.Delete(key)
+		decoderModesCount.Add(-1)
+	}, key)
+}
+
+// ResetDecodeMode removes the decode mode, set with SetDecodeMode, from the decoder, so it
+// uses the mode set with SetDefaultDecodeMode again.
+func ResetDecodeMode(decoder *msgpack.Decoder) {
+	key := weak.Make(decoder)
+	if _, ok := decoderModes.Load(key); ok {
+		decoderModes.Store(key, decodeModeUnset)
+	}
+}
+
+// UnmarshalWithMode decodes the MessagePack-encoded data into v, like msgpack.Unmarshal does,
+// using the given decode mode. It allows to set the mode for a single call, without changing
+// the default mode and the mode of other decoders.
+func UnmarshalWithMode(data []byte, v any, mode DecodeMode) error {
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	SetDecodeMode(decoder, mode)
+
+	return decoder.Decode(v) //nolint:wrapcheck
+}
+
+// getDecodeMode returns the decode mode of the decoder.
+func getDecodeMode(decoder *msgpack.Decoder) DecodeMode {
+	// Fast path: avoid creating a weak pointer if no per-decoder modes are set.
+	if decoderModesCount.Load() > 0 {
+		if mode, ok := decoderModes.Load(weak.Make(decoder)); ok && mode != decodeModeUnset {
+			return mode.(DecodeMode) //nolint:forcetypeassert
+		}
+	}
+
+	return DefaultDecodeMode()
+}
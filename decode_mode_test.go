@@ -0,0 +1,206 @@
+package option_test
+
+import (
+	"bytes"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestDecodeMode_DefaultRejectsAlternativeEncoding(t *testing.T) {
+	t.Parallel()
+
+	data := encodeRaw(t, "2025-12-02T10:30:00Z")
+
+	var opt option.Time
+
+	var decodeErr option.DecodeError
+	require.ErrorAs(t, msgpack.Unmarshal(data, &opt), &decodeErr)
+	assert.True(t, decodeErr.Code.IsSome())
+	assert.False(t, opt.IsSome())
+}
+
+func TestDecodeMode_PerDecoder(t *testing.T) {
+	t.Parallel()
+
+	data := encodeRaw(t, "1m")
+
+	lenient := msgpack.NewDecoder(bytes.NewReader(data))
+	option.SetDecodeMode(lenient, option.DecodeModeLenient)
+
+	var opt option.Duration
+	require.NoError(t, lenient.Decode(&opt))
+	assert.True(t, opt.IsSome())
+
+	// Other decoders are not affected.
+	other := msgpack.NewDecoder(bytes.NewReader(data))
+	require.Error(t, other.Decode(&opt))
+
+	// The mode could be switched back.
+	lenient.Reset(bytes.NewReader(data))
+	option.SetDecodeMode(lenient, option.DecodeModeDefault)
+	require.Error(t, lenient.Decode(&opt))
+}
+
+//nolint:paralleltest // Modifies the package-level decode mode.
+func TestDecodeMode_Default(t *testing.T) {
+	data := encodeRaw(t, int64(0))
+
+	require.Equal(t, option.DecodeModeDefault, option.DefaultDecodeMode())
+
+	option.SetDefaultDecodeMode(option.DecodeModeLenient)
+	defer option.SetDefaultDecodeMode(option.DecodeModeDefault)
+
+	assert.Equal(t, option.DecodeModeLenient, option.DefaultDecodeMode())
+
+	var opt option.Time
+	require.NoError(t, msgpack.Unmarshal(data, &opt))
+	assert.True(t, opt.IsSome())
+
+	// The decoder mode takes precedence.
+	strict := msgpack.NewDecoder(bytes.NewReader(data))
+	option.SetDecodeMode(strict, option.DecodeModeDefault)
+	require.Error(t, strict.Decode(&opt))
+}
+
+// decodeStrict decodes data into opt in DecodeModeStrict.
+func decodeStrict(data []byte, opt msgpack.CustomDecoder) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	option.SetDecodeMode(dec, option.DecodeModeStrict)
+
+	return opt.DecodeMsgpack(dec)
+}
+
+func TestDecodeMode_StrictAccepts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		int8Opt    option.Int8
+		uint64Opt  option.Uint64
+		float32Opt option.Float32
+		float64Opt option.Float64
+	)
+
+	require.NoError(t, decodeStrict(encodeRaw(t, int64(-128)), &int8Opt))
+	assert.Equal(t, option.SomeInt8(-128), int8Opt)
+
+	// Signedness of the code doesn't matter, if the value fits.
+	require.NoError(t, decodeStrict(encodeRaw(t, uint64(127)), &int8Opt))
+	assert.Equal(t, option.SomeInt8(127), int8Opt)
+	require.NoError(t, decodeStrict(encodeRaw(t, int64(5)), &uint64Opt))
+	assert.Equal(t, option.SomeUint64(5), uint64Opt)
+
+	require.NoError(t, decodeStrict(encodeRaw(t, 0.5), &float32Opt))
+	assert.Equal(t, option.SomeFloat32(0.5), float32Opt)
+	require.NoError(t, decodeStrict(encodeRaw(t, 1<<24), &float32Opt))
+	assert.Equal(t, option.SomeFloat32(1<<24), float32Opt)
+	require.NoError(t, decodeStrict(encodeRaw(t, int64(-1)<<53), &float64Opt))
+	assert.Equal(t, option.SomeFloat64(-1<<53), float64Opt)
+
+	require.NoError(t, decodeStrict(encodeRaw(t, nil), &int8Opt))
+	assert.False(t, int8Opt.IsSome())
+}
+
+func TestDecodeMode_StrictRejects(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value any
+		opt   msgpack.CustomDecoder
+		code  byte
+		typ   string
+		bad   any
+	}{
+		"int8 overflow":     {uint16(300), &option.Int8{}, msgpcode.Uint16, "Int8", uint64(300)},
+		"int8 underflow":    {int16(-129), &option.Int8{}, msgpcode.Int16, "Int8", int64(-129)},
+		"int64 overflow":    {uint64(math.MaxUint64), &option.Int64{}, msgpcode.Uint64, "Int64", uint64(math.MaxUint64)},
+		"negative uint":     {int8(-1), &option.Uint{}, msgpcode.Int8, "Uint", int64(-1)},
+		"negative byte":     {-1, &option.Byte{}, 0xff, "Byte", int64(-1)},
+		"uint16 overflow":   {70000, &option.Uint16{}, msgpcode.Uint32, "Uint16", uint64(70000)},
+		"float32 narrowing": {0.1, &option.Float32{}, msgpcode.Double, "Float32", 0.1},
+		"float32 from int":  {1<<24 + 1, &option.Float32{}, msgpcode.Uint32, "Float32", uint64(1<<24 + 1)},
+		"float64 from int":  {int64(-1)<<53 - 1, &option.Float64{}, msgpcode.Int64, "Float64", int64(-1)<<53 - 1},
+		"float64 from uint": {uint64(math.MaxUint64), &option.Float64{}, msgpcode.Uint64, "Float64", uint64(math.MaxUint64)},
+		"nullable":          {-5, &option.NullableUint32{}, 0xfb, "NullableUint32", int64(-5)},
+		"generic":           {1000, &option.Generic[int8]{}, msgpcode.Uint16, "Generic[int8]", uint64(1000)},
+		"generic float":     {0.1, &option.Generic[float32]{}, msgpcode.Double, "Generic[float32]", 0.1},
+		"nullable generic":  {-1, &option.Nullable[uint16]{}, 0xff, "Nullable[uint16]", int64(-1)},
+		"int8 from uint8":   {uint8(200), &option.Int8{}, msgpcode.Uint8, "Int8", uint64(200)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, decodeStrict(encodeRaw(t, test.value), test.opt), &decodeErr)
+			assert.Equal(t, test.typ, decodeErr.Type)
+			assert.Equal(t, option.SomeByte(test.code), decodeErr.Code)
+			assert.Equal(t, test.bad, decodeErr.Value)
+		})
+	}
+}
+
+func TestDecodeMode_DefaultNarrows(t *testing.T) {
+	t.Parallel()
+
+	var opt option.Int8
+	require.NoError(t, msgpack.Unmarshal(encodeRaw(t, uint16(300)), &opt))
+	assert.Equal(t, option.SomeInt8(44), opt)
+}
+
+func TestDecodeMode_ErrorKeepsValue(t *testing.T) {
+	t.Parallel()
+
+	int8Opt := option.SomeInt8(5)
+	require.Error(t, decodeStrict(encodeRaw(t, uint16(300)), &int8Opt))
+	assert.Equal(t, option.SomeInt8(5), int8Opt)
+
+	nullableOpt := option.SomeNullableUint32(5)
+	require.Error(t, decodeStrict(encodeRaw(t, -5), &nullableOpt))
+	assert.Equal(t, option.SomeNullableUint32(5), nullableOpt)
+
+	genericOpt := option.Some[int8](5)
+	require.Error(t, decodeStrict(encodeRaw(t, 1000), &genericOpt))
+	assert.Equal(t, option.Some[int8](5), genericOpt)
+
+	nullableGenericOpt := option.SomeNullable[uint16](5)
+	require.Error(t, decodeStrict(encodeRaw(t, -1), &nullableGenericOpt))
+	assert.Equal(t, option.SomeNullable[uint16](5), nullableGenericOpt)
+
+	// Values, that fail the lenient conversion, are not assigned too.
+	durationOpt := option.SomeDuration(time.Second)
+	dec := msgpack.NewDecoder(bytes.NewReader(encodeRaw(t, "invalid")))
+	option.SetDecodeMode(dec, option.DecodeModeLenient)
+	require.Error(t, durationOpt.DecodeMsgpack(dec))
+	assert.Equal(t, option.SomeDuration(time.Second), durationOpt)
+}
+
+func TestDecodeMode_Reset(t *testing.T) {
+	t.Parallel()
+
+	data := encodeRaw(t, "1m")
+
+	decoder := msgpack.GetDecoder()
+	decoder.Reset(bytes.NewReader(data))
+	option.SetDecodeMode(decoder, option.DecodeModeLenient)
+
+	var opt option.Duration
+	require.NoError(t, decoder.Decode(&opt))
+
+	// The mode is not passed to the next user of the pooled decoder.
+	option.ResetDecodeMode(decoder)
+	decoder.Reset(bytes.NewReader(data))
+	require.Error(t, decoder.Decode(&opt))
+	msgpack.PutDecoder(decoder)
+
+	// Decoders without a mode are not affected.
+	option.ResetDecodeMode(msgpack.NewDecoder(bytes.NewReader(data)))
+}
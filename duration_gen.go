@@ -0,0 +1,425 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Duration represents an optional value of type time.Duration.
+// It can either hold a valid time.Duration (IsSome == true) or be empty (IsZero == true).
+type Duration struct {
+	value  time.Duration
+	exists bool
+}
+
+var _ commonInterface[time.Duration] = (*Duration)(nil)
+
+// SomeDuration creates an optional Duration with the given time.Duration value.
+// The returned Duration will have IsSome() == true and IsZero() == false.
+func SomeDuration(value time.Duration) Duration {
+	return Duration{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneDuration creates an empty optional Duration value.
+// The returned Duration will have IsSome() == false and IsZero() == true.
+func NoneDuration() Duration {
+	return Duration{
+		exists: false,
+		value:  zero[time.Duration](),
+	}
+}
+
+// IsSome returns true if the Duration contains a value.
+// This indicates the value is explicitly set (not None).
+func (o Duration) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the Duration does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o Duration) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o Duration) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of time.Duration, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o Duration) Get() (time.Duration, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o Duration) MustGet() time.Duration {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Duration.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o Duration) Unwrap() time.Duration {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Duration) UnwrapOr(defaultValue time.Duration) time.Duration {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o Duration) UnwrapOrElse(defaultValue func() time.Duration) time.Duration {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Duration) All() iter.Seq[time.Duration] {
+	return func(yield func(time.Duration) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Durations are None, or both contain equal values.
+func (o Duration) Equal(other Duration) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneDuration() is less than any value.
+func (o Duration) Compare(other Duration) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Duration itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneDuration().
+func (o Duration) Filter(predicate func(time.Duration) bool) Duration {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneDuration()
+}
+
+// Or returns the Duration itself if it contains a value.
+// Otherwise, returns other.
+func (o Duration) Or(other Duration) Duration {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Duration itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Duration) OrElse(fn func() Duration) Duration {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the Duration value using MessagePack format.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o Duration) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("Duration", encodeDuration(encoder, o.value))
+	}
+
+	return newEncodeError("Duration", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Duration value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Duration) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDuration(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Duration", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Duration value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneDuration)
+//   - time.Duration: interpreted as a present value (SomeDuration)
+//
+// In DecodeModeLenient alternative encodings of time.Duration are accepted as well.
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on time.Duration: exists = true, value = decoded value
+func (o *Duration) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Duration", "time.Duration", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("Duration", "time.Duration", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Duration
+
+		val, err = decodeDuration(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Duration", "time.Duration", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkDurationLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeDurationLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Duration", "time.Duration", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("Duration", "time.Duration", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as time.Duration.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Duration) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueDuration(o.value)
+	if err != nil {
+		return nil, newEncodeError("Duration", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneDuration)
+//   - time.Duration: interpreted as a present value (SomeDuration)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into time.Duration.
+func (o *Duration) Scan(src any) error {
+	if src == nil {
+		o.value = zero[time.Duration]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanDuration(src)
+	if err != nil {
+		return newDecodeError("Duration", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Duration value using JSON format.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Duration) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Duration", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Duration value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneDuration)
+//   - time.Duration: interpreted as a present value (SomeDuration)
+//
+// Returns an error if the input can't be decoded as time.Duration.
+func (o *Duration) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Duration]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Duration", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Duration.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Duration) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextDuration(o.value)
+	if err != nil {
+		return nil, newEncodeError("Duration", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneDuration)
+//   - text representation of time.Duration: interpreted as a present value (SomeDuration)
+//
+// Returns an error if the text can't be parsed as time.Duration.
+func (o *Duration) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Duration]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextDuration(text)
+	if err != nil {
+		return newDecodeError("Duration", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Duration.
+// The Duration stays None until the flag is passed, the value is parsed
+// as text representation of time.Duration. Get of the returned flag.Getter returns the Duration.
+//
+// Example:
+//
+//	var opt option.Duration
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Duration) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextDuration([]byte(text))
+			if err != nil {
+				return newDecodeError("Duration", err)
+			}
+
+			*o = SomeDuration(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
@@ -0,0 +1,663 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"time"
+
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestDuration_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.True(t, someDuration.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.False(t, emptyDuration.IsSome())
+	})
+}
+
+func TestDuration_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.False(t, someDuration.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.True(t, emptyDuration.IsZero())
+	})
+}
+
+func TestDuration_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.False(t, someDuration.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.True(t, emptyDuration.IsNil())
+	})
+}
+
+func TestDuration_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		val, ok := someDuration.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, 90*time.Second, val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		_, ok := emptyDuration.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestDuration_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.EqualValues(t, 90*time.Second, someDuration.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyDuration.MustGet()
+		})
+	})
+}
+
+func TestDuration_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.EqualValues(t, 90*time.Second, someDuration.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.NotPanics(t, func() {
+			emptyDuration.Unwrap()
+		})
+	})
+}
+
+func TestDuration_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.EqualValues(t, 90*time.Second, someDuration.UnwrapOr(time.Hour))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.EqualValues(t, time.Hour, emptyDuration.UnwrapOr(time.Hour))
+	})
+}
+
+func TestDuration_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.EqualValues(t, 90*time.Second, someDuration.UnwrapOrElse(func() time.Duration {
+			return time.Hour
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.EqualValues(t, time.Hour, emptyDuration.UnwrapOrElse(func() time.Duration {
+			return time.Hour
+		}))
+	})
+}
+
+func TestDuration_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeDuration(90 * time.Second).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 90*time.Second, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneDuration().All()))
+	})
+}
+
+func TestDuration_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someDuration := option.SomeDuration(90 * time.Second)
+	otherDuration := option.SomeDuration(time.Hour)
+	emptyDuration := option.NoneDuration()
+
+	assert.True(t, someDuration.Equal(option.SomeDuration(90*time.Second)))
+	assert.True(t, emptyDuration.Equal(option.NoneDuration()))
+	assert.False(t, someDuration.Equal(otherDuration))
+	assert.False(t, someDuration.Equal(emptyDuration))
+	assert.False(t, emptyDuration.Equal(someDuration))
+
+	assert.Zero(t, someDuration.Compare(option.SomeDuration(90*time.Second)))
+	assert.Zero(t, emptyDuration.Compare(option.NoneDuration()))
+	assert.Equal(t, -otherDuration.Compare(someDuration), someDuration.Compare(otherDuration))
+	assert.NotZero(t, someDuration.Compare(otherDuration))
+	assert.Equal(t, 1, someDuration.Compare(emptyDuration))
+	assert.Equal(t, -1, emptyDuration.Compare(someDuration))
+}
+
+func TestDuration_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.True(t, someDuration.Filter(func(time.Duration) bool { return true }).IsSome())
+		assert.False(t, someDuration.Filter(func(time.Duration) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.False(t, emptyDuration.Filter(func(time.Duration) bool { return true }).IsSome())
+	})
+}
+
+func TestDuration_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		other := option.SomeDuration(time.Hour)
+		assert.EqualValues(t, 90*time.Second, someDuration.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		other := option.SomeDuration(time.Hour)
+		assert.EqualValues(t, time.Hour, emptyDuration.Or(other).Unwrap())
+	})
+}
+
+func TestDuration_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		assert.EqualValues(t, 90*time.Second, someDuration.OrElse(func() option.Duration {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		assert.EqualValues(t, time.Hour, emptyDuration.OrElse(func() option.Duration {
+			return option.SomeDuration(time.Hour)
+		}).Unwrap())
+	})
+}
+
+func TestDuration_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		err := someDuration.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Duration
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 90*time.Second, unmarshaled.Unwrap())
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDuration := option.SomeDuration(-1500 * time.Microsecond)
+		err := someDuration.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Duration
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, -1500*time.Microsecond, unmarshaled.Unwrap())
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someDuration := option.SomeDuration(time.Duration(1<<63 - 1))
+		err := someDuration.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Duration
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Duration(1<<63-1), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyDuration := option.NoneDuration()
+		err := emptyDuration.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Duration
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDuration_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		require.NoError(t, someDuration.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDuration.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDuration := option.SomeDuration(-1500 * time.Microsecond)
+		require.NoError(t, someDuration.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDuration.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someDuration := option.SomeDuration(time.Duration(1<<63 - 1))
+		require.NoError(t, someDuration.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someDuration.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneDuration().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestDuration_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		val, err := someDuration.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Duration
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 90*time.Second, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		val, err := emptyDuration.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeDuration(90 * time.Second)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestDuration_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		data, err := json.Marshal(someDuration)
+		require.NoError(t, err)
+
+		var unmarshaled option.Duration
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 90*time.Second, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		data, err := json.Marshal(emptyDuration)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeDuration(90 * time.Second)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Duration
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Duration", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDuration_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someDuration := option.SomeDuration(90 * time.Second)
+		data, err := someDuration.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Duration
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 90*time.Second, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyDuration := option.NoneDuration()
+		data, err := emptyDuration.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeDuration(90 * time.Second)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestDuration_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeDuration(90 * time.Second).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Duration
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 90*time.Second, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Duration
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeDuration() {
+	opt := option.SomeDuration(90 * time.Second)
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: 1m30s
+}
+
+func ExampleNoneDuration() {
+	opt := option.NoneDuration()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleDuration_IsSome() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleDuration_IsZero() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleDuration_IsNil() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleDuration_Get() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// 1m30s true
+	// 0s false
+}
+
+func ExampleDuration_MustGet() {
+	some := option.SomeDuration(90 * time.Second)
+	fmt.Println(some.MustGet())
+	// Output: 1m30s
+}
+
+func ExampleDuration_MustGet_panic() {
+	none := option.NoneDuration()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleDuration_Unwrap() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// 1m30s
+	// 0s
+}
+
+func ExampleDuration_UnwrapOr() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.UnwrapOr(time.Hour))
+	fmt.Println(none.UnwrapOr(time.Hour))
+	// Output:
+	// 1m30s
+	// 1h0m0s
+}
+
+func ExampleDuration_UnwrapOrElse() {
+	some := option.SomeDuration(90 * time.Second)
+	none := option.NoneDuration()
+	fmt.Println(some.UnwrapOrElse(func() time.Duration {
+		return time.Hour
+	}))
+	fmt.Println(none.UnwrapOrElse(func() time.Duration {
+		return time.Hour
+	}))
+	// Output:
+	// 1m30s
+	// 1h0m0s
+}
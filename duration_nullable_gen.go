@@ -0,0 +1,312 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableDuration represents a nullable value of type time.Duration with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableDuration struct {
+	value time.Duration
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableDuration]()
+}
+
+// SomeNullableDuration creates a NullableDuration with the given time.Duration value.
+func SomeNullableDuration(value time.Duration) NullableDuration {
+	return NullableDuration{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableDuration creates a NullableDuration, that is explicitly set to null.
+func NullNullableDuration() NullableDuration {
+	return NullableDuration{
+		value: zero[time.Duration](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableDuration creates a NullableDuration, that is not set.
+// It is equal to the zero value of NullableDuration.
+func UnsetNullableDuration() NullableDuration {
+	return NullableDuration{
+		value: zero[time.Duration](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableDuration contains a value.
+func (o NullableDuration) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableDuration is explicitly set to null.
+func (o NullableDuration) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableDuration is either null or contains a value.
+func (o NullableDuration) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableDuration is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableDuration) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of time.Duration, false).
+func (o NullableDuration) Get() (time.Duration, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableDuration) MustGet() time.Duration {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Duration.
+func (o NullableDuration) Unwrap() time.Duration {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableDuration) UnwrapOr(defaultValue time.Duration) time.Duration {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableDuration) UnwrapOrElse(defaultValue func() time.Duration) time.Duration {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableDuration to Duration. Both Null and Unset are converted to NoneDuration().
+func (o NullableDuration) Option() Duration {
+	if o.state == nullableSome {
+		return SomeDuration(o.value)
+	}
+
+	return NoneDuration()
+}
+
+// Equal returns true if both NullableDurations have the same state and, if present, equal values.
+func (o NullableDuration) Equal(other NullableDuration) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableDuration) Compare(other NullableDuration) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableDuration value using MessagePack format.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableDuration) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableDuration", encodeDuration(encoder, o.value))
+	}
+
+	return newEncodeError("NullableDuration", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableDuration value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableDuration) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendDuration(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableDuration", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableDuration value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableDuration)
+//   - time.Duration: interpreted as a present value (SomeNullableDuration)
+//
+// In DecodeModeLenient alternative encodings of time.Duration are accepted as well.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDuration) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableDuration", "time.Duration", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[time.Duration]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableDuration", "time.Duration", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Duration
+
+		val, err = decodeDuration(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableDuration", "time.Duration", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkDurationLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeDurationLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableDuration", "time.Duration", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableDuration", "time.Duration", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableDuration value using JSON format.
+// - If the value is present, it is encoded as time.Duration.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableDuration) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDuration", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableDuration value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableDuration)
+//   - time.Duration: interpreted as a present value (SomeNullableDuration)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableDuration) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Duration]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableDuration", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Duration.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableDuration) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextDuration(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableDuration", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableDuration)
+//   - text representation of time.Duration: interpreted as a present value (SomeNullableDuration)
+func (o *NullableDuration) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Duration]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextDuration(text)
+	if err != nil {
+		return newDecodeError("NullableDuration", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
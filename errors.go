@@ -1,30 +1,156 @@
 package option
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var (
+	// ErrNone is the error, that MustGet methods panic with, if the optional has no value.
+	ErrNone = errors.New("optional value is not set")
+	// ErrUnexpectedCode is reported, if the MessagePack code is not expected for the decoded type.
+	ErrUnexpectedCode = errors.New("unexpected code")
+	// ErrInvalidExtCode is reported, if the MessagePack extension code differs from the expected one.
+	ErrInvalidExtCode = errors.New("invalid extension code")
+	// ErrOverflow is reported, if a value doesn't fit into the type or can't be represented
+	// by it exactly.
+	ErrOverflow = errors.New("value overflows type")
+	// ErrDuplicateExtCode is the error, that RegisterExtCodec and RegisterExtType panic with, if the extension code
+	// is already registered for another type.
+	ErrDuplicateExtCode = errors.New("extension code is already registered")
 )
 
 // DecodeError is returned when decoding failed due to invalid code in msgpack stream.
+//
+// It wraps the Parent error, or ErrUnexpectedCode if there is no parent, so it could be
+// checked with errors.Is and errors.As.
 type DecodeError struct {
-	Type   string
-	Code   Byte
+	Type string
+	// Expected is the Go type of the decoded value.
+	Expected string
+	Code     Byte
+	// Offset is the offset in the msgpack stream of the start of the value, that failed to decode.
+	// It is set, if the decoder reads from an io.Seeker, e.g. with msgpack.Unmarshal or bytes.Reader.
+	// The offset is the same for all errors of the value regardless of the decode mode.
+	Offset Int64
+	// Path is the path to the value in the decoded structure, e.g. ".items[3].id".
+	// It is accumulated with WithField and WithIndex.
+	Path string
+	// Value is the decoded value, that was rejected in DecodeModeStrict, since it can't be
+	// represented by Type exactly. It is nil for other errors.
+	Value  any
 	Parent error
 }
 
 // Error returns the text representation of error.
 func (d DecodeError) Error() string {
-	if d.Code.IsSome() {
-		return fmt.Sprintf("failed to decode %s, invalid code: %d", d.Type, d.Code.Unwrap())
+	prefix := "failed to decode " + d.Type + formatErrorLocation(d.Path, d.Offset)
+
+	switch {
+	case d.Code.IsSome() && d.Parent != nil:
+		return fmt.Sprintf("%s, code %s: %s", prefix, d.formatCode(), d.Parent)
+	case d.Code.IsSome() && d.Expected != "":
+		return fmt.Sprintf("%s: unexpected code %s, expected %s", prefix, d.formatCode(), d.Expected)
+	case d.Code.IsSome():
+		return fmt.Sprintf("%s: unexpected code %s", prefix, d.formatCode())
+	default:
+		return fmt.Sprintf("%s: %s", prefix, d.Parent)
 	}
+}
 
-	return fmt.Sprintf("failed to decode %s: %s", d.Type, d.Parent)
+// Unwrap returns the parent error. If there is no parent and the code is unexpected,
+// ErrUnexpectedCode is returned.
+func (d DecodeError) Unwrap() error {
+	if d.Parent == nil && d.Code.IsSome() {
+		return ErrUnexpectedCode
+	}
+
+	return d.Parent
 }
 
-func newDecodeWithCodeError(operationType string, code byte) error {
+// CodeName returns the name of the msgpack code, e.g. "uint16" or "fixstr".
+// It returns an empty string, if the code is not set.
+func (d DecodeError) CodeName() string {
+	code, ok := d.Code.Get()
+	if !ok {
+		return ""
+	}
+
+	return codeName(code)
+}
+
+func (d DecodeError) formatCode() string {
+	return fmt.Sprintf("%s (0x%02x)", d.CodeName(), d.Code.Unwrap())
+}
+
+// formatErrorLocation formats the path and the offset of an error, if they are known.
+func formatErrorLocation(path string, offset Int64) string {
+	location := ""
+	if path != "" {
+		location += " at " + path
+	}
+
+	if offset, ok := offset.Get(); ok {
+		location += fmt.Sprintf(" (offset %d)", offset)
+	}
+
+	return location
+}
+
+// DecoderOffset returns the offset of the decoder in the stream. The offset is known, if
+// the decoder reads from an io.Seeker, e.g. with msgpack.Unmarshal or bytes.Reader:
+// msgpack.Decoder doesn't buffer such readers, so the offset is exact.
+//
+// It is used by types, generated with gentypes, to fill DecodeError.Offset. The offset is taken
+// before the value is read, so errors of the value point to its start.
+func DecoderOffset(decoder *msgpack.Decoder) Int64 {
+	seeker, ok := decoder.Buffered().(io.Seeker)
+	if !ok {
+		return NoneInt64()
+	}
+
+	offset, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return NoneInt64()
+	}
+
+	return SomeInt64(offset)
+}
+
+// newDecodeWithCodeError creates an error of an unexpected code of the value, that starts at the offset.
+func newDecodeWithCodeError(operationType, expected string, offset Int64, code byte) error {
 	return DecodeError{
-		Type:   operationType,
-		Code:   SomeByte(code),
-		Parent: nil,
+		Type:     operationType,
+		Expected: expected,
+		Code:     SomeByte(code),
+		Offset:   offset,
+		Path:     "",
+		Value:    nil,
+		Parent:   nil,
+	}
+}
+
+// newDecodeStrictError creates an error of a strict decoder. If the decoded value is rejected,
+// the error contains the code and the value.
+func newDecodeStrictError(operationType, expected string, offset Int64, code byte, err error) error {
+	var inexactErr inexactValueError
+	if !errors.As(err, &inexactErr) {
+		return newDecodeMsgpackError(operationType, expected, offset, err)
+	}
+
+	return DecodeError{
+		Type:     operationType,
+		Expected: expected,
+		Code:     SomeByte(code),
+		Offset:   offset,
+		Path:     "",
+		Value:    inexactErr.value,
+		Parent:   err,
 	}
 }
 
@@ -32,15 +158,41 @@ func getGenericTypeName[T any]() string {
 	return fmt.Sprintf("Generic[%T]", zero[T]())
 }
 
+func getTypeName[T any]() string {
+	return fmt.Sprintf("%T", zero[T]())
+}
+
 func newDecodeError(operationType string, err error) error {
 	if err == nil {
 		return nil
 	}
 
 	return DecodeError{
-		Type:   operationType,
-		Code:   NoneByte(),
-		Parent: err,
+		Type:     operationType,
+		Expected: "",
+		Code:     NoneByte(),
+		Offset:   NoneInt64(),
+		Path:     "",
+		Value:    nil,
+		Parent:   err,
+	}
+}
+
+// newDecodeMsgpackError creates an error of decoding of the value, that starts at the offset
+// of the msgpack stream.
+func newDecodeMsgpackError(operationType, expected string, offset Int64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return DecodeError{
+		Type:     operationType,
+		Expected: expected,
+		Code:     NoneByte(),
+		Offset:   offset,
+		Path:     "",
+		Value:    nil,
+		Parent:   err,
 	}
 }
 
@@ -50,21 +202,38 @@ func newDecodeGenericError[T any](err error) error {
 	}
 
 	return DecodeError{
-		Type:   getGenericTypeName[T](),
-		Code:   NoneByte(),
-		Parent: err,
+		Type:     getGenericTypeName[T](),
+		Expected: getTypeName[T](),
+		Code:     NoneByte(),
+		Offset:   NoneInt64(),
+		Path:     "",
+		Value:    nil,
+		Parent:   err,
 	}
 }
 
+func newDecodeGenericMsgpackError[T any](offset Int64, err error) error {
+	return newDecodeMsgpackError(getGenericTypeName[T](), getTypeName[T](), offset, err)
+}
+
 // EncodeError is returned when encoding failed due to stream errors.
+// It wraps the Parent error.
 type EncodeError struct {
-	Type   string
+	Type string
+	// Path is the path to the value in the encoded structure, e.g. ".items[3].id".
+	// It is accumulated with WithField and WithIndex.
+	Path   string
 	Parent error
 }
 
 // Error returns the text representation of error.
 func (e EncodeError) Error() string {
-	return fmt.Sprintf("failed to encode %s: %s", e.Type, e.Parent)
+	return fmt.Sprintf("failed to encode %s%s: %s", e.Type, formatErrorLocation(e.Path, NoneInt64()), e.Parent)
+}
+
+// Unwrap returns the parent error.
+func (e EncodeError) Unwrap() error {
+	return e.Parent
 }
 
 func newEncodeError(operationType string, err error) error {
@@ -72,7 +241,7 @@ func newEncodeError(operationType string, err error) error {
 		return nil
 	}
 
-	return EncodeError{Type: operationType, Parent: err}
+	return EncodeError{Type: operationType, Path: "", Parent: err}
 }
 
 func newEncodeGenericError[T any](err error) error {
@@ -80,5 +249,93 @@ func newEncodeGenericError[T any](err error) error {
 		return nil
 	}
 
-	return EncodeError{Type: getGenericTypeName[T](), Parent: err}
+	return EncodeError{Type: getGenericTypeName[T](), Path: "", Parent: err}
+}
+
+// WithField prepends the field name to the path of DecodeError or EncodeError. It is used
+// by wrappers, that decode or encode structures, to report the field, that failed:
+//
+//	if err := row.ID.DecodeMsgpack(decoder); err != nil {
+//		return option.WithField(err, "id")
+//	}
+//
+// Other errors are returned as is.
+func WithField(err error, name string) error {
+	return withPathElement(err, "."+name)
+}
+
+// WithIndex prepends the index to the path of DecodeError or EncodeError. It is used by
+// wrappers, that decode or encode slices of optionals. Other errors are returned as is.
+func WithIndex(err error, index int) error {
+	return withPathElement(err, "["+strconv.Itoa(index)+"]")
+}
+
+func withPathElement(err error, element string) error {
+	switch typedErr := err.(type) { //nolint:errorlint // Only errors of the level are extended.
+	case DecodeError:
+		typedErr.Path = element + typedErr.Path
+		return typedErr
+	case *DecodeError:
+		typedErr.Path = element + typedErr.Path
+		return typedErr
+	case EncodeError:
+		typedErr.Path = element + typedErr.Path
+		return typedErr
+	case *EncodeError:
+		typedErr.Path = element + typedErr.Path
+		return typedErr
+	default:
+		return err
+	}
+}
+
+// codeName returns the name of the msgpack code as it is named in the specification.
+func codeName(code byte) string {
+	switch {
+	case code <= msgpcode.PosFixedNumHigh:
+		return "positive fixint"
+	case code <= msgpcode.FixedMapHigh:
+		return "fixmap"
+	case code <= msgpcode.FixedArrayHigh:
+		return "fixarray"
+	case code <= msgpcode.FixedStrHigh:
+		return "fixstr"
+	case code >= msgpcode.NegFixedNumLow:
+		return "negative fixint"
+	default:
+		return codeNames[code-msgpcode.Nil]
+	}
+}
+
+var codeNames = [...]string{ //nolint:gochecknoglobals
+	"nil", "never used", "false", "true",
+	"bin8", "bin16", "bin32",
+	"ext8", "ext16", "ext32",
+	"float32", "float64",
+	"uint8", "uint16", "uint32", "uint64",
+	"int8", "int16", "int32", "int64",
+	"fixext1", "fixext2", "fixext4", "fixext8", "fixext16",
+	"str8", "str16", "str32",
+	"array16", "array32",
+	"map16", "map32",
+}
+
+// overflowError is reported, if a value doesn't fit into the type. It wraps ErrOverflow.
+type overflowError struct {
+	value  any
+	target string
+}
+
+func newOverflowError[T any](value any) error {
+	return overflowError{value: value, target: fmt.Sprintf("%T", zero[T]())}
+}
+
+// Error returns the text representation of error.
+func (e overflowError) Error() string {
+	return fmt.Sprintf("value %v overflows %s", e.value, e.target)
+}
+
+// Unwrap returns ErrOverflow.
+func (e overflowError) Unwrap() error {
+	return ErrOverflow
 }
@@ -3,11 +3,16 @@ package option //nolint:testpackage
 // this is unit test, that checks internal logic of error constructing.
 
 import (
+	"bytes"
 	"errors"
+	"io"
 	"testing"
+	"testing/iotest"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
 )
 
 var (
@@ -75,10 +80,19 @@ func TestDecodeError_Error(t *testing.T) {
 	t.Run("newDecodeWithCodeError", func(t *testing.T) {
 		t.Parallel()
 
-		a := newDecodeWithCodeError("Byte", 1)
+		a := newDecodeWithCodeError("Byte", "uint8", SomeInt64(12), 0xa3)
 
 		require.Error(t, a)
-		assert.Equal(t, "failed to decode Byte, invalid code: 1", a.Error())
+		assert.Equal(t, "failed to decode Byte (offset 12): unexpected code fixstr (0xa3), expected uint8", a.Error())
+	})
+
+	t.Run("newDecodeWithCodeError without offset", func(t *testing.T) {
+		t.Parallel()
+
+		a := newDecodeWithCodeError("Byte", "", NoneInt64(), 1)
+
+		require.Error(t, a)
+		assert.Equal(t, "failed to decode Byte: unexpected code positive fixint (0x01)", a.Error())
 	})
 
 	t.Run("newDecodeGenericError", func(t *testing.T) {
@@ -97,4 +111,267 @@ func TestDecodeError_Error(t *testing.T) {
 
 		require.NoError(t, a)
 	})
+
+	t.Run("newDecodeStrictError", func(t *testing.T) {
+		t.Parallel()
+
+		a := newDecodeStrictError("Int8", "int8", SomeInt64(3), 0xcd, newInexactValueError[int8](uint64(300)))
+
+		require.Error(t, a)
+		assert.Equal(t, "failed to decode Int8 (offset 3), code uint16 (0xcd): "+
+			"value 300 can't be represented as int8 exactly", a.Error())
+	})
+
+	t.Run("newDecodeStrictError with other error", func(t *testing.T) {
+		t.Parallel()
+
+		a := newDecodeStrictError("Int8", "int8", SomeInt64(0), 0xcd, errTest)
+
+		require.Error(t, a)
+		assert.Equal(t, "failed to decode Int8 (offset 0): some error", a.Error())
+	})
+}
+
+func TestError_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("encode error", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, newEncodeError("Byte", errTest), errTest)
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, newDecodeError("Byte", errTest), errTest)
+		require.ErrorIs(t, newDecodeGenericError[int](errTest), errTest)
+	})
+
+	t.Run("unexpected code", func(t *testing.T) {
+		t.Parallel()
+
+		require.ErrorIs(t, newDecodeWithCodeError("Byte", "uint8", SomeInt64(0), 1), ErrUnexpectedCode)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := scanSigned[int8](int64(300))
+		require.ErrorIs(t, err, ErrOverflow)
+
+		err = newDecodeStrictError("Int8", "int8", SomeInt64(0), 0xcd, newInexactValueError[int8](uint64(300)))
+		require.ErrorIs(t, err, ErrOverflow)
+	})
+}
+
+func TestDecodeError_Is(t *testing.T) {
+	t.Parallel()
+
+	t.Run("truncated stream", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(SomeString("hello"))
+		require.NoError(t, err)
+
+		var opt String
+
+		err = msgpack.Unmarshal(data[:len(data)-1], &opt)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+		var decodeErr DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+
+		decoder := msgpack.NewDecoder(bytes.NewReader(data))
+		require.NoError(t, opt.DecodeMsgpack(decoder))
+		require.ErrorIs(t, opt.DecodeMsgpack(decoder), io.EOF)
+	})
+
+	t.Run("unexpected code", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal("hello")
+		require.NoError(t, err)
+
+		var opt Int
+		require.ErrorIs(t, msgpack.Unmarshal(data, &opt), ErrUnexpectedCode)
+	})
+
+	t.Run("invalid extension code", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := msgpack.Marshal(SomeDatetime(time.Unix(1700000000, 0)))
+		require.NoError(t, err)
+
+		var opt Decimal
+		require.ErrorIs(t, msgpack.Unmarshal(data, &opt), ErrInvalidExtCode)
+	})
+}
+
+func TestMustGet_PanicsWithErrNone(t *testing.T) {
+	t.Parallel()
+
+	classify := func(fn func()) (err error) {
+		defer func() {
+			err, _ = recover().(error)
+		}()
+
+		fn()
+
+		return nil
+	}
+
+	require.ErrorIs(t, classify(func() { None[int]().MustGet() }), ErrNone)
+	require.ErrorIs(t, classify(func() { NullNullable[int]().MustGet() }), ErrNone)
+	require.ErrorIs(t, classify(func() { NoneInt().MustGet() }), ErrNone)
+}
+
+type pathTestRow struct {
+	ID    Int
+	Items []Int
+}
+
+func (r *pathTestRow) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	if err := r.ID.DecodeMsgpack(decoder); err != nil {
+		return WithField(err, "id")
+	}
+
+	length, err := decoder.DecodeArrayLen()
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	r.Items = make([]Int, length)
+	for i := range r.Items {
+		if err := r.Items[i].DecodeMsgpack(decoder); err != nil {
+			return WithField(WithIndex(err, i), "items")
+		}
+	}
+
+	return nil
+}
+
+func TestDecodeError_Context(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal([]any{1, []any{2, "3"}})
+	require.NoError(t, err)
+
+	var row pathTestRow
+
+	err = row.DecodeMsgpack(msgpack.NewDecoder(bytes.NewReader(data[1:])))
+	require.Error(t, err)
+	assert.Equal(t, "failed to decode Int at .items[1] (offset 3): unexpected code fixstr (0xa1), expected int",
+		err.Error())
+
+	var decodeErr DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "int", decodeErr.Expected)
+	assert.Equal(t, "fixstr", decodeErr.CodeName())
+	assert.Equal(t, SomeInt64(3), decodeErr.Offset)
+	assert.Equal(t, ".items[1]", decodeErr.Path)
+
+	// The offset is unknown, if the reader is not an io.Seeker.
+	err = row.DecodeMsgpack(msgpack.NewDecoder(iotest.OneByteReader(bytes.NewReader(data[1:]))))
+	require.ErrorAs(t, err, &decodeErr)
+	assert.False(t, decodeErr.Offset.IsSome())
+}
+
+// decodeNested decodes the value of ["ab", [1, value]] into opt with the given mode.
+// The value starts at offset 6 of the stream.
+func decodeNested(t *testing.T, value any, mode DecodeMode, opt msgpack.CustomDecoder) error {
+	t.Helper()
+
+	data, err := msgpack.Marshal([]any{"ab", []any{1, value}})
+	require.NoError(t, err)
+
+	decoder := msgpack.NewDecoder(bytes.NewReader(data))
+	SetDecodeMode(decoder, mode)
+
+	_, err = decoder.DecodeArrayLen()
+	require.NoError(t, err)
+	require.NoError(t, decoder.Skip())
+	_, err = decoder.DecodeArrayLen()
+	require.NoError(t, err)
+	require.NoError(t, decoder.Skip())
+
+	return opt.DecodeMsgpack(decoder)
+}
+
+func TestDecodeError_Offset(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value any
+		mode  DecodeMode
+		opt   msgpack.CustomDecoder
+	}{
+		"unexpected code":          {"x", DecodeModeDefault, &Int8{}},
+		"strict":                   {uint16(300), DecodeModeStrict, &Int8{}},
+		"lenient":                  {"invalid", DecodeModeLenient, &Duration{}},
+		"nullable unexpected code": {"x", DecodeModeDefault, &NullableInt8{}},
+		"nullable strict":          {uint16(300), DecodeModeStrict, &NullableInt8{}},
+		"generic":                  {"x", DecodeModeDefault, &Generic[int8]{}},
+		"generic strict":           {uint16(300), DecodeModeStrict, &Generic[int8]{}},
+		"generic nullable":         {"x", DecodeModeDefault, &Nullable[int8]{}},
+		"generic nullable strict":  {uint16(300), DecodeModeStrict, &Nullable[int8]{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var decodeErr DecodeError
+			require.ErrorAs(t, decodeNested(t, test.value, test.mode, test.opt), &decodeErr)
+			assert.Equal(t, SomeInt64(6), decodeErr.Offset)
+		})
+	}
+}
+
+func TestWithPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("encode error", func(t *testing.T) {
+		t.Parallel()
+
+		err := WithField(WithIndex(newEncodeError("Int", errTest), 2), "items")
+		assert.Equal(t, "failed to encode Int at .items[2]: some error", err.Error())
+	})
+
+	t.Run("pointer", func(t *testing.T) {
+		t.Parallel()
+
+		err := WithField(&DecodeError{Type: "Point", Parent: errTest}, "point") //nolint:exhaustruct
+		assert.Equal(t, "failed to decode Point at .point: some error", err.Error())
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, errTest, WithField(errTest, "id"))
+		require.NoError(t, WithIndex(nil, 1))
+	})
+}
+
+func TestCodeName(t *testing.T) {
+	t.Parallel()
+
+	names := map[byte]string{
+		0x00: "positive fixint",
+		0x7f: "positive fixint",
+		0x80: "fixmap",
+		0x9f: "fixarray",
+		0xa0: "fixstr",
+		0xc0: "nil",
+		0xc1: "never used",
+		0xc7: "ext8",
+		0xd4: "fixext1",
+		0xdf: "map32",
+		0xe0: "negative fixint",
+		0xff: "negative fixint",
+	}
+
+	for code, name := range names {
+		assert.Equal(t, name, codeName(code), code)
+	}
 }
@@ -0,0 +1,104 @@
+package option
+
+// This file provides low-level helpers for MessagePack extension payloads of Tarantool types.
+// Payloads are usually small and contain plain MessagePack integers, so they are read and written
+// directly on byte slices without creating an intermediate msgpack.Decoder or msgpack.Encoder.
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+var (
+	errExtTruncated = errors.New("extension payload is truncated")
+	errExtIntCode   = errors.New("unexpected integer code in extension payload")
+	errExtIntRange  = errors.New("integer in extension payload is out of range")
+)
+
+// appendMsgpackInt appends the integer to dst using the most compact MessagePack representation.
+// Like Tarantool, it uses MP_UINT for non-negative values and MP_INT for negative ones.
+func appendMsgpackInt(dst []byte, val int64) []byte {
+	switch {
+	case val >= 0 && val <= int64(msgpcode.PosFixedNumHigh):
+		return append(dst, byte(val))
+	case val >= 0 && val <= math.MaxUint8:
+		return append(dst, msgpcode.Uint8, byte(val))
+	case val >= 0 && val <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(dst, msgpcode.Uint16), uint16(val))
+	case val >= 0 && val <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(dst, msgpcode.Uint32), uint32(val))
+	case val >= 0:
+		return binary.BigEndian.AppendUint64(append(dst, msgpcode.Uint64), uint64(val))
+	case val >= int64(int8(msgpcode.NegFixedNumLow)):
+		return append(dst, byte(int8(val)))
+	case val >= math.MinInt8:
+		return append(dst, msgpcode.Int8, byte(int8(val)))
+	case val >= math.MinInt16:
+		return binary.BigEndian.AppendUint16(append(dst, msgpcode.Int16), uint16(int16(val)))
+	case val >= math.MinInt32:
+		return binary.BigEndian.AppendUint32(append(dst, msgpcode.Int32), uint32(int32(val)))
+	default:
+		return binary.BigEndian.AppendUint64(append(dst, msgpcode.Int64), uint64(val))
+	}
+}
+
+// readMsgpackInt reads a MessagePack integer (MP_INT or MP_UINT) from the beginning of data.
+func readMsgpackInt(data []byte) (int64, []byte, error) { //nolint:cyclop
+	if len(data) == 0 {
+		return 0, nil, errExtTruncated
+	}
+
+	code, data := data[0], data[1:]
+	if msgpcode.IsFixedNum(code) {
+		return int64(int8(code)), data, nil
+	}
+
+	var size int
+
+	switch code {
+	case msgpcode.Uint8, msgpcode.Int8:
+		size = 1
+	case msgpcode.Uint16, msgpcode.Int16:
+		size = 2
+	case msgpcode.Uint32, msgpcode.Int32:
+		size = 4
+	case msgpcode.Uint64, msgpcode.Int64:
+		size = 8
+	default:
+		return 0, nil, fmt.Errorf("%w: %d", errExtIntCode, code)
+	}
+
+	if len(data) < size {
+		return 0, nil, errExtTruncated
+	}
+
+	raw, data := data[:size], data[size:]
+
+	switch code {
+	case msgpcode.Uint8:
+		return int64(raw[0]), data, nil
+	case msgpcode.Uint16:
+		return int64(binary.BigEndian.Uint16(raw)), data, nil
+	case msgpcode.Uint32:
+		return int64(binary.BigEndian.Uint32(raw)), data, nil
+	case msgpcode.Uint64:
+		val := binary.BigEndian.Uint64(raw)
+		if val > math.MaxInt64 {
+			return 0, nil, errExtIntRange
+		}
+
+		return int64(val), data, nil
+	case msgpcode.Int8:
+		return int64(int8(raw[0])), data, nil
+	case msgpcode.Int16:
+		return int64(int16(binary.BigEndian.Uint16(raw))), data, nil
+	case msgpcode.Int32:
+		return int64(int32(binary.BigEndian.Uint32(raw))), data, nil
+	default:
+		return int64(binary.BigEndian.Uint64(raw)), data, nil
+	}
+}
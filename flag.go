@@ -0,0 +1,89 @@
+package option
+
+// This file provides adapters of optional types to the flag.Value and flag.Getter interfaces.
+// An optional stays None until the flag is passed, any passed value (even an empty one) makes it Some.
+
+import (
+	"flag"
+	"fmt"
+)
+
+// flagValue adapts an optional to the flag.Getter interface.
+//
+// The zero value is valid, since the flag package creates it to check default values.
+type flagValue struct {
+	set    func(text string) error
+	str    func() string
+	get    func() any
+	isBool bool
+}
+
+var _ flag.Getter = flagValue{} //nolint:exhaustruct
+
+// String implements the flag.Value interface.
+func (f flagValue) String() string {
+	if f.str == nil {
+		return ""
+	}
+
+	return f.str()
+}
+
+// Set implements the flag.Value interface.
+func (f flagValue) Set(text string) error {
+	return f.set(text)
+}
+
+// Get implements the flag.Getter interface. It returns the optional itself.
+func (f flagValue) Get() any {
+	if f.get == nil {
+		return nil
+	}
+
+	return f.get()
+}
+
+// IsBoolFlag allows to pass boolean flags without a value, e.g. -verbose.
+func (f flagValue) IsBoolFlag() bool {
+	return f.isBool
+}
+
+// FlagVar defines a flag with the specified name and usage in the flag set.
+// The flag value is parsed with parse function and stored into opt.
+// If the flag is not passed, opt is left untouched (e.g. stays None).
+//
+// Example:
+//
+//	var timeout option.Generic[time.Duration]
+//	option.FlagVar(flag.CommandLine, &timeout, "timeout", "request timeout", time.ParseDuration)
+func FlagVar[T any](flagSet *flag.FlagSet, opt *Generic[T], name, usage string, parse func(string) (T, error)) {
+	flagSet.Var(newGenericFlag(opt, parse), name, usage)
+}
+
+func newGenericFlag[T any](opt *Generic[T], parse func(string) (T, error)) flagValue {
+	_, isBool := any(zero[T]()).(bool)
+
+	return flagValue{
+		set: func(text string) error {
+			val, err := parse(text)
+			if err != nil {
+				return newDecodeGenericError[T](err)
+			}
+
+			*opt = Some(val)
+
+			return nil
+		},
+		str: func() string {
+			if !opt.exists {
+				return ""
+			}
+
+			return fmt.Sprint(opt.value)
+		},
+		get: func() any {
+			return *opt
+		},
+		isBool: isBool,
+	}
+}
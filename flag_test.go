@@ -0,0 +1,135 @@
+package option_test
+
+import (
+	"bytes"
+	"flag"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tarantool/go-option"
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(&bytes.Buffer{})
+
+	return flagSet
+}
+
+func TestFlag_Typed(t *testing.T) {
+	t.Parallel()
+
+	var (
+		name    option.String
+		port    option.Int
+		verbose option.Bool
+		timeout option.Duration
+	)
+
+	flagSet := newTestFlagSet()
+	flagSet.Var(name.Flag(), "name", "name")
+	flagSet.Var(port.Flag(), "port", "port")
+	flagSet.Var(verbose.Flag(), "verbose", "verbose output")
+	flagSet.Var(timeout.Flag(), "timeout", "timeout")
+
+	require.NoError(t, flagSet.Parse([]string{"-name=", "-verbose", "-timeout", "1m"}))
+
+	// Passed empty value is not the same as not passed flag.
+	assert.Equal(t, option.SomeString(""), name)
+	assert.Equal(t, option.NoneInt(), port)
+	assert.Equal(t, option.SomeBool(true), verbose)
+	assert.Equal(t, option.SomeDuration(time.Minute), timeout)
+}
+
+func TestFlag_Invalid(t *testing.T) {
+	t.Parallel()
+
+	var port option.Uint16
+
+	flagSet := newTestFlagSet()
+	flagSet.Var(port.Flag(), "port", "port")
+
+	require.Error(t, flagSet.Parse([]string{"-port", "65536"}))
+	assert.False(t, port.IsSome())
+
+	var decodeErr option.DecodeError
+	require.ErrorAs(t, flagSet.Lookup("port").Value.Set("-1"), &decodeErr)
+	assert.Equal(t, "Uint16", decodeErr.Type)
+}
+
+func TestFlag_PrintDefaults(t *testing.T) {
+	t.Parallel()
+
+	port := option.SomeInt(3301)
+
+	var output bytes.Buffer
+
+	flagSet := newTestFlagSet()
+	flagSet.SetOutput(&output)
+	flagSet.Var(port.Flag(), "port", "listen `port`")
+	flagSet.PrintDefaults()
+
+	assert.Contains(t, output.String(), "-port port")
+	assert.Contains(t, output.String(), "(default 3301)")
+}
+
+func TestFlagVar(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		var timeout option.Generic[time.Duration]
+
+		flagSet := newTestFlagSet()
+		option.FlagVar(flagSet, &timeout, "timeout", "timeout", time.ParseDuration)
+
+		require.NoError(t, flagSet.Parse([]string{"-timeout", "0s"}))
+		assert.Equal(t, option.Some(time.Duration(0)), timeout)
+		assert.Equal(t, "0s", flagSet.Lookup("timeout").Value.String())
+
+		getter, ok := flagSet.Lookup("timeout").Value.(flag.Getter)
+		require.True(t, ok)
+		assert.Equal(t, timeout, getter.Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var timeout option.Generic[time.Duration]
+
+		flagSet := newTestFlagSet()
+		option.FlagVar(flagSet, &timeout, "timeout", "timeout", time.ParseDuration)
+
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, timeout.IsSome())
+	})
+
+	t.Run("bool", func(t *testing.T) {
+		t.Parallel()
+
+		var verbose option.Generic[bool]
+
+		flagSet := newTestFlagSet()
+		option.FlagVar(flagSet, &verbose, "verbose", "verbose output", strconv.ParseBool)
+
+		require.NoError(t, flagSet.Parse([]string{"-verbose"}))
+		assert.Equal(t, option.Some(true), verbose)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var timeout option.Generic[time.Duration]
+
+		flagSet := newTestFlagSet()
+		option.FlagVar(flagSet, &timeout, "timeout", "timeout", time.ParseDuration)
+
+		require.Error(t, flagSet.Parse([]string{"-timeout", "forever"}))
+		assert.False(t, timeout.IsSome())
+	})
+}
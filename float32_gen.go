@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Float32) Get() (float32, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Float32) MustGet() float32 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Float32) UnwrapOrElse(defaultValue func() float32) float32 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Float32) All() iter.Seq[float32] {
+	return func(yield func(float32) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Float32s are None, or both contain equal values.
+func (o Float32) Equal(other Float32) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneFloat32() is less than any value.
+func (o Float32) Compare(other Float32) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Float32 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneFloat32().
+func (o Float32) Filter(predicate func(float32) bool) Float32 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneFloat32()
+}
+
+// Or returns the Float32 itself if it contains a value.
+// Otherwise, returns other.
+func (o Float32) Or(other Float32) Float32 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Float32 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Float32) OrElse(fn func() Float32) Float32 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Float32 value using MessagePack format.
 // - If the value is present, it is encoded as float32.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Float32) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Float32", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Float32 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as float32.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Float32) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendFloat32(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Float32", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Float32 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneFloat32)
 //   - float32: interpreted as a present value (SomeFloat32)
 //
+// In DecodeModeLenient alternative encodings of float32 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as float32 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on float32: exists = true, value = decoded value
 func (o *Float32) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Float32", err)
+		return newDecodeMsgpackError("Float32", "float32", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Float32", decoder.Skip())
+		return newDecodeMsgpackError("Float32", "float32", offset, decoder.Skip())
 	case checkFloat(code):
-		o.value, err = decodeFloat32(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val float32
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeFloat32Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Float32", "float32", offset, code, err)
+			}
+		} else {
+			val, err = decodeFloat32(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Float32", "float32", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkFloatLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeFloat32Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Float32", err)
+			return newDecodeMsgpackError("Float32", "float32", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Float32", code)
+		return newDecodeWithCodeError("Float32", "float32", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as float32.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Float32) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueFloat32(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float32", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneFloat32)
+//   - float32: interpreted as a present value (SomeFloat32)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into float32.
+func (o *Float32) Scan(src any) error {
+	if src == nil {
+		o.value = zero[float32]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanFloat32(src)
+	if err != nil {
+		return newDecodeError("Float32", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Float32 value using JSON format.
+// - If the value is present, it is encoded as float32.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Float32) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Float32 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneFloat32)
+//   - float32: interpreted as a present value (SomeFloat32)
+//
+// Returns an error if the input can't be decoded as float32.
+func (o *Float32) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[float32]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Float32", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of float32.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Float32) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextFloat32(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneFloat32)
+//   - text representation of float32: interpreted as a present value (SomeFloat32)
+//
+// Returns an error if the text can't be parsed as float32.
+func (o *Float32) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[float32]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextFloat32(text)
+	if err != nil {
+		return newDecodeError("Float32", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Float32.
+// The Float32 stays None until the flag is passed, the value is parsed
+// as text representation of float32. Get of the returned flag.Getter returns the Float32.
+//
+// Example:
+//
+//	var opt option.Float32
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Float32) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextFloat32([]byte(text))
+			if err != nil {
+				return newDecodeError("Float32", err)
+			}
+
+			*o = SomeFloat32(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
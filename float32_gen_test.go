@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestFloat32_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyFloat32 := option.NoneFloat32()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyFloat32.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestFloat32_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestFloat32_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeFloat32(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneFloat32().All()))
+	})
+}
+
+func TestFloat32_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someFloat32 := option.SomeFloat32(12)
+	otherFloat32 := option.SomeFloat32(13)
+	emptyFloat32 := option.NoneFloat32()
+
+	assert.True(t, someFloat32.Equal(option.SomeFloat32(12)))
+	assert.True(t, emptyFloat32.Equal(option.NoneFloat32()))
+	assert.False(t, someFloat32.Equal(otherFloat32))
+	assert.False(t, someFloat32.Equal(emptyFloat32))
+	assert.False(t, emptyFloat32.Equal(someFloat32))
+
+	assert.Zero(t, someFloat32.Compare(option.SomeFloat32(12)))
+	assert.Zero(t, emptyFloat32.Compare(option.NoneFloat32()))
+	assert.Equal(t, -otherFloat32.Compare(someFloat32), someFloat32.Compare(otherFloat32))
+	assert.NotZero(t, someFloat32.Compare(otherFloat32))
+	assert.Equal(t, 1, someFloat32.Compare(emptyFloat32))
+	assert.Equal(t, -1, emptyFloat32.Compare(someFloat32))
+}
+
+func TestFloat32_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		assert.True(t, someFloat32.Filter(func(float32) bool { return true }).IsSome())
+		assert.False(t, someFloat32.Filter(func(float32) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		assert.False(t, emptyFloat32.Filter(func(float32) bool { return true }).IsSome())
+	})
+}
+
+func TestFloat32_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		other := option.SomeFloat32(13)
+		assert.EqualValues(t, 12, someFloat32.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		other := option.SomeFloat32(13)
+		assert.EqualValues(t, 13, emptyFloat32.Or(other).Unwrap())
+	})
+}
+
+func TestFloat32_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		assert.EqualValues(t, 12, someFloat32.OrElse(func() option.Float32 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		assert.EqualValues(t, 13, emptyFloat32.OrElse(func() option.Float32 {
+			return option.SomeFloat32(13)
+		}).Unwrap())
+	})
+}
+
 func TestFloat32_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestFloat32_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestFloat32_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someFloat32 := option.SomeFloat32(12)
+		require.NoError(t, someFloat32.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someFloat32.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneFloat32().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestFloat32_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		val, err := someFloat32.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Float32
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		val, err := emptyFloat32.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeFloat32(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestFloat32_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		data, err := json.Marshal(someFloat32)
+		require.NoError(t, err)
+
+		var unmarshaled option.Float32
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		data, err := json.Marshal(emptyFloat32)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeFloat32(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Float32
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Float32", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestFloat32_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat32 := option.SomeFloat32(12)
+		data, err := someFloat32.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Float32
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat32 := option.NoneFloat32()
+		data, err := emptyFloat32.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeFloat32(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestFloat32_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeFloat32(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Float32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Float32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeFloat32() {
 	opt := option.SomeFloat32(12)
 	if opt.IsSome() {
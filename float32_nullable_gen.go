@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableFloat32 represents a nullable value of type float32 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableFloat32 struct {
+	value float32
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableFloat32]()
+}
+
+// SomeNullableFloat32 creates a NullableFloat32 with the given float32 value.
+func SomeNullableFloat32(value float32) NullableFloat32 {
+	return NullableFloat32{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableFloat32 creates a NullableFloat32, that is explicitly set to null.
+func NullNullableFloat32() NullableFloat32 {
+	return NullableFloat32{
+		value: zero[float32](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableFloat32 creates a NullableFloat32, that is not set.
+// It is equal to the zero value of NullableFloat32.
+func UnsetNullableFloat32() NullableFloat32 {
+	return NullableFloat32{
+		value: zero[float32](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableFloat32 contains a value.
+func (o NullableFloat32) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableFloat32 is explicitly set to null.
+func (o NullableFloat32) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableFloat32 is either null or contains a value.
+func (o NullableFloat32) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableFloat32 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableFloat32) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of float32, false).
+func (o NullableFloat32) Get() (float32, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableFloat32) MustGet() float32 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for float32.
+func (o NullableFloat32) Unwrap() float32 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableFloat32) UnwrapOr(defaultValue float32) float32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableFloat32) UnwrapOrElse(defaultValue func() float32) float32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableFloat32 to Float32. Both Null and Unset are converted to NoneFloat32().
+func (o NullableFloat32) Option() Float32 {
+	if o.state == nullableSome {
+		return SomeFloat32(o.value)
+	}
+
+	return NoneFloat32()
+}
+
+// Equal returns true if both NullableFloat32s have the same state and, if present, equal values.
+func (o NullableFloat32) Equal(other NullableFloat32) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableFloat32) Compare(other NullableFloat32) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableFloat32 value using MessagePack format.
+// - If the value is present, it is encoded as float32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableFloat32) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableFloat32", encodeFloat32(encoder, o.value))
+	}
+
+	return newEncodeError("NullableFloat32", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableFloat32 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as float32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableFloat32) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendFloat32(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableFloat32", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableFloat32 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableFloat32)
+//   - float32: interpreted as a present value (SomeNullableFloat32)
+//
+// In DecodeModeLenient alternative encodings of float32 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as float32 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableFloat32) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableFloat32", "float32", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[float32]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableFloat32", "float32", offset, decoder.Skip())
+	case checkFloat(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val float32
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeFloat32Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableFloat32", "float32", offset, code, err)
+			}
+		} else {
+			val, err = decodeFloat32(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableFloat32", "float32", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkFloatLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeFloat32Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableFloat32", "float32", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableFloat32", "float32", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableFloat32 value using JSON format.
+// - If the value is present, it is encoded as float32.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableFloat32) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableFloat32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableFloat32 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableFloat32)
+//   - float32: interpreted as a present value (SomeNullableFloat32)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableFloat32) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[float32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableFloat32", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of float32.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableFloat32) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextFloat32(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableFloat32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableFloat32)
+//   - text representation of float32: interpreted as a present value (SomeNullableFloat32)
+func (o *NullableFloat32) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[float32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextFloat32(text)
+	if err != nil {
+		return newDecodeError("NullableFloat32", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Float64) Get() (float64, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Float64) MustGet() float64 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Float64) UnwrapOrElse(defaultValue func() float64) float64 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Float64) All() iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Float64s are None, or both contain equal values.
+func (o Float64) Equal(other Float64) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneFloat64() is less than any value.
+func (o Float64) Compare(other Float64) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Float64 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneFloat64().
+func (o Float64) Filter(predicate func(float64) bool) Float64 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneFloat64()
+}
+
+// Or returns the Float64 itself if it contains a value.
+// Otherwise, returns other.
+func (o Float64) Or(other Float64) Float64 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Float64 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Float64) OrElse(fn func() Float64) Float64 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Float64 value using MessagePack format.
 // - If the value is present, it is encoded as float64.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Float64) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Float64", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Float64 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as float64.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Float64) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendFloat64(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Float64", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Float64 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneFloat64)
 //   - float64: interpreted as a present value (SomeFloat64)
 //
+// In DecodeModeLenient alternative encodings of float64 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as float64 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on float64: exists = true, value = decoded value
 func (o *Float64) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Float64", err)
+		return newDecodeMsgpackError("Float64", "float64", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Float64", decoder.Skip())
+		return newDecodeMsgpackError("Float64", "float64", offset, decoder.Skip())
 	case checkFloat(code):
-		o.value, err = decodeFloat64(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val float64
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeFloat64Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Float64", "float64", offset, code, err)
+			}
+		} else {
+			val, err = decodeFloat64(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Float64", "float64", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkFloatLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeFloat64Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Float64", err)
+			return newDecodeMsgpackError("Float64", "float64", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Float64", code)
+		return newDecodeWithCodeError("Float64", "float64", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as float64.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Float64) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueFloat64(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float64", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneFloat64)
+//   - float64: interpreted as a present value (SomeFloat64)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into float64.
+func (o *Float64) Scan(src any) error {
+	if src == nil {
+		o.value = zero[float64]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanFloat64(src)
+	if err != nil {
+		return newDecodeError("Float64", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Float64 value using JSON format.
+// - If the value is present, it is encoded as float64.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Float64) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Float64 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneFloat64)
+//   - float64: interpreted as a present value (SomeFloat64)
+//
+// Returns an error if the input can't be decoded as float64.
+func (o *Float64) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[float64]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Float64", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of float64.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Float64) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextFloat64(o.value)
+	if err != nil {
+		return nil, newEncodeError("Float64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneFloat64)
+//   - text representation of float64: interpreted as a present value (SomeFloat64)
+//
+// Returns an error if the text can't be parsed as float64.
+func (o *Float64) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[float64]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextFloat64(text)
+	if err != nil {
+		return newDecodeError("Float64", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Float64.
+// The Float64 stays None until the flag is passed, the value is parsed
+// as text representation of float64. Get of the returned flag.Getter returns the Float64.
+//
+// Example:
+//
+//	var opt option.Float64
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Float64) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextFloat64([]byte(text))
+			if err != nil {
+				return newDecodeError("Float64", err)
+			}
+
+			*o = SomeFloat64(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestFloat64_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyFloat64 := option.NoneFloat64()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyFloat64.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestFloat64_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestFloat64_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeFloat64(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneFloat64().All()))
+	})
+}
+
+func TestFloat64_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someFloat64 := option.SomeFloat64(12)
+	otherFloat64 := option.SomeFloat64(13)
+	emptyFloat64 := option.NoneFloat64()
+
+	assert.True(t, someFloat64.Equal(option.SomeFloat64(12)))
+	assert.True(t, emptyFloat64.Equal(option.NoneFloat64()))
+	assert.False(t, someFloat64.Equal(otherFloat64))
+	assert.False(t, someFloat64.Equal(emptyFloat64))
+	assert.False(t, emptyFloat64.Equal(someFloat64))
+
+	assert.Zero(t, someFloat64.Compare(option.SomeFloat64(12)))
+	assert.Zero(t, emptyFloat64.Compare(option.NoneFloat64()))
+	assert.Equal(t, -otherFloat64.Compare(someFloat64), someFloat64.Compare(otherFloat64))
+	assert.NotZero(t, someFloat64.Compare(otherFloat64))
+	assert.Equal(t, 1, someFloat64.Compare(emptyFloat64))
+	assert.Equal(t, -1, emptyFloat64.Compare(someFloat64))
+}
+
+func TestFloat64_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		assert.True(t, someFloat64.Filter(func(float64) bool { return true }).IsSome())
+		assert.False(t, someFloat64.Filter(func(float64) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		assert.False(t, emptyFloat64.Filter(func(float64) bool { return true }).IsSome())
+	})
+}
+
+func TestFloat64_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		other := option.SomeFloat64(13)
+		assert.EqualValues(t, 12, someFloat64.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		other := option.SomeFloat64(13)
+		assert.EqualValues(t, 13, emptyFloat64.Or(other).Unwrap())
+	})
+}
+
+func TestFloat64_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		assert.EqualValues(t, 12, someFloat64.OrElse(func() option.Float64 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		assert.EqualValues(t, 13, emptyFloat64.OrElse(func() option.Float64 {
+			return option.SomeFloat64(13)
+		}).Unwrap())
+	})
+}
+
 func TestFloat64_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestFloat64_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestFloat64_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someFloat64 := option.SomeFloat64(12)
+		require.NoError(t, someFloat64.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someFloat64.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneFloat64().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestFloat64_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		val, err := someFloat64.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Float64
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		val, err := emptyFloat64.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeFloat64(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestFloat64_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		data, err := json.Marshal(someFloat64)
+		require.NoError(t, err)
+
+		var unmarshaled option.Float64
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		data, err := json.Marshal(emptyFloat64)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeFloat64(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Float64
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Float64", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestFloat64_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someFloat64 := option.SomeFloat64(12)
+		data, err := someFloat64.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Float64
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyFloat64 := option.NoneFloat64()
+		data, err := emptyFloat64.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeFloat64(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestFloat64_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeFloat64(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Float64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Float64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeFloat64() {
 	opt := option.SomeFloat64(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableFloat64 represents a nullable value of type float64 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableFloat64 struct {
+	value float64
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableFloat64]()
+}
+
+// SomeNullableFloat64 creates a NullableFloat64 with the given float64 value.
+func SomeNullableFloat64(value float64) NullableFloat64 {
+	return NullableFloat64{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableFloat64 creates a NullableFloat64, that is explicitly set to null.
+func NullNullableFloat64() NullableFloat64 {
+	return NullableFloat64{
+		value: zero[float64](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableFloat64 creates a NullableFloat64, that is not set.
+// It is equal to the zero value of NullableFloat64.
+func UnsetNullableFloat64() NullableFloat64 {
+	return NullableFloat64{
+		value: zero[float64](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableFloat64 contains a value.
+func (o NullableFloat64) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableFloat64 is explicitly set to null.
+func (o NullableFloat64) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableFloat64 is either null or contains a value.
+func (o NullableFloat64) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableFloat64 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableFloat64) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of float64, false).
+func (o NullableFloat64) Get() (float64, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableFloat64) MustGet() float64 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for float64.
+func (o NullableFloat64) Unwrap() float64 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableFloat64) UnwrapOr(defaultValue float64) float64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableFloat64) UnwrapOrElse(defaultValue func() float64) float64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableFloat64 to Float64. Both Null and Unset are converted to NoneFloat64().
+func (o NullableFloat64) Option() Float64 {
+	if o.state == nullableSome {
+		return SomeFloat64(o.value)
+	}
+
+	return NoneFloat64()
+}
+
+// Equal returns true if both NullableFloat64s have the same state and, if present, equal values.
+func (o NullableFloat64) Equal(other NullableFloat64) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableFloat64) Compare(other NullableFloat64) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableFloat64 value using MessagePack format.
+// - If the value is present, it is encoded as float64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableFloat64) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableFloat64", encodeFloat64(encoder, o.value))
+	}
+
+	return newEncodeError("NullableFloat64", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableFloat64 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as float64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableFloat64) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendFloat64(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableFloat64", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableFloat64 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableFloat64)
+//   - float64: interpreted as a present value (SomeNullableFloat64)
+//
+// In DecodeModeLenient alternative encodings of float64 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as float64 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableFloat64) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableFloat64", "float64", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[float64]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableFloat64", "float64", offset, decoder.Skip())
+	case checkFloat(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val float64
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeFloat64Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableFloat64", "float64", offset, code, err)
+			}
+		} else {
+			val, err = decodeFloat64(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableFloat64", "float64", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkFloatLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeFloat64Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableFloat64", "float64", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableFloat64", "float64", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableFloat64 value using JSON format.
+// - If the value is present, it is encoded as float64.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableFloat64) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableFloat64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableFloat64 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableFloat64)
+//   - float64: interpreted as a present value (SomeNullableFloat64)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableFloat64) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[float64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableFloat64", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of float64.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableFloat64) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextFloat64(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableFloat64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableFloat64)
+//   - text representation of float64: interpreted as a present value (SomeNullableFloat64)
+func (o *NullableFloat64) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[float64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextFloat64(text)
+	if err != nil {
+		return newDecodeError("NullableFloat64", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -1,6 +1,9 @@
 package option
 
 import (
+	"database/sql/driver"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -71,13 +74,13 @@ func (o Generic[T]) Get() (T, bool) {
 
 // MustGet returns the contained value if present.
 //
-// Panics if the optional is in the "none" state (i.e., no value is present).
+// Panics with ErrNone if the optional is in the "none" state (i.e., no value is present).
 //
 // Only use this method when you are certain the value exists.
 // For safer access, use Get() instead.
 func (o Generic[T]) MustGet() T {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -115,6 +118,22 @@ func (o Generic[T]) UnwrapOrElse(defaultValueFunc func() T) T {
 	return defaultValueFunc()
 }
 
+// All returns an iterator over the contained value.
+// It yields the value once if the optional is Some, and nothing if it is None.
+//
+// Example:
+//
+//	for value := range opt.All() {
+//	    fmt.Println(value)
+//	}
+func (o Generic[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
 // convertToEncoder checks whether the given value implements msgpack.CustomEncoder.
 //
 // Used internally during encoding to support custom MessagePack encoding logic.
@@ -128,6 +147,10 @@ func convertToEncoder(v any) (msgpack.CustomEncoder, bool) {
 // If the optional is empty (None), it encodes as a MessagePack nil.
 // If the optional contains a value (Some), it attempts to use a custom encoder if the value
 // implements msgpack.CustomEncoder; otherwise, it uses the standard encoder.
+// Values of built-in types (numbers, strings, byte slices, booleans, time.Time and time.Duration)
+// are encoded without reflection in the same way as by the standard encoder with default options.
+// Values of types, registered with RegisterCodec or RegisterExtCodec, are encoded with the registered
+// functions.
 func (o Generic[T]) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	if !o.exists {
 		err := encoder.EncodeNil()
@@ -138,16 +161,40 @@ func (o Generic[T]) EncodeMsgpack(encoder *msgpack.Encoder) error {
 		return nil
 	}
 
-	encoderValue, ok := convertToEncoder(&o.value)
+	if codec := getGenericCodec[T](); codec != nil {
+		return newEncodeGenericError[T](codec.encode(encoder, o.value))
+	}
 
-	var err error
-	if ok {
-		err = encoderValue.EncodeMsgpack(encoder)
-	} else {
-		err = encoder.Encode(&o.value)
+	return newEncodeGenericError[T](encodeGenericSlow(encoder, o.value))
+}
+
+// encodeGenericSlow encodes the value, that has no codec, with its EncodeMsgpack method or with
+// reflection. The value is passed by value, so only this path moves it to the heap.
+func encodeGenericSlow[T any](encoder *msgpack.Encoder, val T) error {
+	if encoderValue, ok := convertToEncoder(&val); ok {
+		return encoderValue.EncodeMsgpack(encoder)
 	}
 
-	return newEncodeGenericError[T](err)
+	return encoder.Encode(&val) //nolint:wrapcheck
+}
+
+// AppendMsgpack implements the AppendMarshaler interface.
+//
+// It appends the same bytes, as EncodeMsgpack writes with the default msgpack.Encoder options, to dst
+// and returns the extended buffer. Numbers, strings, byte slices, booleans and time values are appended
+// directly without allocations. Other values are appended with their AppendMsgpack method, if they
+// also implement msgpack.CustomEncoder, or encoded with msgpack.Encoder.
+func (o Generic[T]) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendGeneric(dst, o.value)
+	if err != nil {
+		return dst, newEncodeGenericError[T](err)
+	}
+
+	return out, nil
 }
 
 // convertToDecoder checks whether the given value implements msgpack.CustomDecoder.
@@ -163,36 +210,209 @@ func convertToDecoder(v any) (msgpack.CustomDecoder, bool) {
 // It reads a MessagePack value and decodes it into the Generic.
 //   - If the encoded value is nil, the optional is set to None.
 //   - Otherwise, it decodes into the internal value, using a custom decoder if available,
-//     and marks the optional as Some.
+//     and marks the optional as Some. Values of built-in types are decoded without reflection,
+//     values of types, registered with RegisterCodec or RegisterExtCodec, with the registered functions.
+//
+// In DecodeModeStrict numbers, that can't be represented by a numeric T exactly, are rejected.
 //
 // Note: This method modifies the receiver and must be called on a pointer.
 func (o *Generic[T]) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	switch {
 	case err != nil:
-		return newDecodeGenericError[T](err)
+		return newDecodeGenericMsgpackError[T](offset, err)
 	case code == msgpcode.Nil:
 		o.exists = false
 
 		err := decoder.Skip()
 		if err != nil {
-			return newDecodeGenericError[T](err)
+			return newDecodeGenericMsgpackError[T](offset, err)
 		}
 
 		return nil
 	}
 
-	decoderValue, ok := convertToDecoder(&o.value)
-	if ok {
+	if getDecodeMode(decoder) == DecodeModeStrict {
+		// The previous value is restored, so the optional is not changed on errors.
+		value := o.value
+
+		ok, err := decodeGenericStrict(decoder, code, &o.value)
+		if err != nil {
+			o.value = value
+
+			return newDecodeStrictError(getGenericTypeName[T](), getTypeName[T](), offset, code, err)
+		}
+
+		if ok {
+			o.exists = true
+
+			return nil
+		}
+	}
+
+	var value T
+
+	if codec := getGenericCodec[T](); codec != nil {
+		value, err = codec.decode(decoder)
+	} else {
+		value, err = decodeGenericSlow(decoder, o.value)
+	}
+
+	if err != nil {
+		return newDecodeGenericMsgpackError[T](offset, err)
+	}
+
+	o.value = value
+	o.exists = true
+
+	return nil
+}
+
+// decodeGenericSlow decodes the value, that has no codec, with its DecodeMsgpack method or with
+// reflection. The value is decoded into a copy of val, so only this path moves it to the heap.
+func decodeGenericSlow[T any](decoder *msgpack.Decoder, val T) (T, error) {
+	var err error
+	if decoderValue, ok := convertToDecoder(&val); ok {
 		err = decoderValue.DecodeMsgpack(decoder)
 	} else {
-		err = decoder.Decode(&o.value)
+		err = decoder.Decode(&val)
+	}
+
+	return val, err //nolint:wrapcheck
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// If the optional is empty (None), it is encoded as JSON null.
+// Otherwise, the contained value is encoded with encoding/json.
+func (o Generic[T]) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
 	}
 
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeGenericError[T](err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// JSON null sets the optional to None, any other value is decoded into T
+// with encoding/json and marks the optional as Some.
+//
+// Note: This method modifies the receiver and must be called on a pointer.
+func (o *Generic[T]) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[T]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeGenericError[T](err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// If the optional is empty (None), it is encoded as empty text.
+// Otherwise, numbers, strings, bytes, booleans, time.Time and time.Duration are encoded
+// as the pre-generated types do, values of other types are encoded with their MarshalText method.
+// Returns an error if T is not a built-in type and does not implement encoding.TextMarshaler.
+func (o Generic[T]) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextGeneric(&o.value)
+	if err != nil {
+		return nil, newEncodeGenericError[T](err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+//
+// Empty text sets the optional to None, any other text is decoded into T
+// in the format of MarshalText and marks the optional as Some.
+// Returns an error if T is not a built-in type and *T does not implement encoding.TextUnmarshaler.
+//
+// Note: This method modifies the receiver and must be called on a pointer.
+func (o *Generic[T]) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[T]()
+		o.exists = false
+
+		return nil
+	}
+
+	var val T
+
+	err := unmarshalTextGeneric(&val, text)
+	if err != nil {
+		return newDecodeGenericError[T](err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+//
+// If the optional is empty (None), it is passed to the database as NULL.
+// Otherwise, the contained value is converted with driver.DefaultParameterConverter,
+// so T may itself implement driver.Valuer.
+func (o Generic[T]) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueAny(o.value)
+	if err != nil {
+		return nil, newEncodeGenericError[T](err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// NULL sets the optional to None. Any other value is scanned into T, using its sql.Scanner
+// implementation if there is one, and marks the optional as Some. Numeric types accept any
+// integer width returned by the driver and report values that do not fit into T as an error.
+//
+// Note: This method modifies the receiver and must be called on a pointer.
+func (o *Generic[T]) Scan(src any) error {
+	if src == nil {
+		o.value = zero[T]()
+		o.exists = false
+
+		return nil
+	}
+
+	// The value is scanned into a local variable, so the optional is not changed on errors.
+	var val T
+
+	err := scanGeneric(&val, src)
 	if err != nil {
 		return newDecodeGenericError[T](err)
 	}
 
+	o.value = val
 	o.exists = true
 
 	return nil
@@ -1,6 +1,7 @@
 package option_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -292,3 +293,71 @@ func TestUnwrapOrElseLazyEvaluation(t *testing.T) {
 	assert.Equal(t, 10, result)
 	assert.False(t, called, "default function should not be called when value exists")
 }
+
+// TestJSONRoundTrip verifies full JSON encode-decode roundtrip for both Some and None.
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(option.Some("roundtrip-test"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `"roundtrip-test"`, string(data))
+
+	var decodedSome option.Generic[string]
+
+	err = json.Unmarshal(data, &decodedSome)
+	require.NoError(t, err)
+	assert.True(t, decodedSome.IsSome())
+	assert.Equal(t, "roundtrip-test", decodedSome.Unwrap())
+
+	data, err = json.Marshal(option.None[string]())
+	require.NoError(t, err)
+	assert.JSONEq(t, "null", string(data))
+
+	decodedNone := option.Some("overwritten")
+
+	err = json.Unmarshal(data, &decodedNone)
+	require.NoError(t, err)
+	assert.True(t, decodedNone.IsZero())
+	assert.Empty(t, decodedNone.Unwrap())
+}
+
+// TestJSONStructFields verifies that optionals work as struct fields, including omitzero.
+func TestJSONStructFields(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		Name  option.Generic[string] `json:"name"`
+		Phone option.Generic[string] `json:"phone,omitzero"`
+		Age   option.Generic[int]    `json:"age"`
+	}
+
+	data, err := json.Marshal(payload{
+		Name:  option.Some("Maryamu Efe"),
+		Phone: option.None[string](),
+		Age:   option.None[int](),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"Maryamu Efe","age":null}`, string(data))
+
+	var decoded payload
+
+	err = json.Unmarshal([]byte(`{"name":null,"phone":"+15056463408","age":42}`), &decoded)
+	require.NoError(t, err)
+	assert.True(t, decoded.Name.IsZero())
+	assert.Equal(t, option.Some("+15056463408"), decoded.Phone)
+	assert.Equal(t, option.Some(42), decoded.Age)
+}
+
+// TestJSONDecodeError verifies that JSON decoding errors are wrapped into DecodeError.
+func TestJSONDecodeError(t *testing.T) {
+	t.Parallel()
+
+	var opt option.Generic[int]
+
+	err := json.Unmarshal([]byte(`"not a number"`), &opt)
+
+	var decodeErr option.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "Generic[int]", decodeErr.Type)
+	assert.True(t, opt.IsZero())
+}
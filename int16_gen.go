@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Int16) Get() (int16, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Int16) MustGet() int16 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Int16) UnwrapOrElse(defaultValue func() int16) int16 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Int16) All() iter.Seq[int16] {
+	return func(yield func(int16) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Int16s are None, or both contain equal values.
+func (o Int16) Equal(other Int16) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneInt16() is less than any value.
+func (o Int16) Compare(other Int16) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Int16 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneInt16().
+func (o Int16) Filter(predicate func(int16) bool) Int16 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneInt16()
+}
+
+// Or returns the Int16 itself if it contains a value.
+// Otherwise, returns other.
+func (o Int16) Or(other Int16) Int16 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Int16 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Int16) OrElse(fn func() Int16) Int16 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Int16 value using MessagePack format.
 // - If the value is present, it is encoded as int16.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Int16) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Int16", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Int16 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int16.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Int16) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt16(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Int16", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Int16 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneInt16)
 //   - int16: interpreted as a present value (SomeInt16)
 //
+// In DecodeModeLenient alternative encodings of int16 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int16 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on int16: exists = true, value = decoded value
 func (o *Int16) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Int16", err)
+		return newDecodeMsgpackError("Int16", "int16", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Int16", decoder.Skip())
+		return newDecodeMsgpackError("Int16", "int16", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeInt16(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int16
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt16Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Int16", "int16", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt16(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Int16", "int16", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt16Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Int16", err)
+			return newDecodeMsgpackError("Int16", "int16", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Int16", code)
+		return newDecodeWithCodeError("Int16", "int16", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as int16.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Int16) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueInt16(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int16", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneInt16)
+//   - int16: interpreted as a present value (SomeInt16)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into int16.
+func (o *Int16) Scan(src any) error {
+	if src == nil {
+		o.value = zero[int16]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanInt16(src)
+	if err != nil {
+		return newDecodeError("Int16", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Int16 value using JSON format.
+// - If the value is present, it is encoded as int16.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Int16) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Int16 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneInt16)
+//   - int16: interpreted as a present value (SomeInt16)
+//
+// Returns an error if the input can't be decoded as int16.
+func (o *Int16) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int16]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Int16", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int16.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Int16) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt16(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneInt16)
+//   - text representation of int16: interpreted as a present value (SomeInt16)
+//
+// Returns an error if the text can't be parsed as int16.
+func (o *Int16) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int16]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt16(text)
+	if err != nil {
+		return newDecodeError("Int16", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Int16.
+// The Int16 stays None until the flag is passed, the value is parsed
+// as text representation of int16. Get of the returned flag.Getter returns the Int16.
+//
+// Example:
+//
+//	var opt option.Int16
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Int16) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextInt16([]byte(text))
+			if err != nil {
+				return newDecodeError("Int16", err)
+			}
+
+			*o = SomeInt16(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
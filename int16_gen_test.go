@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestInt16_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyInt16 := option.NoneInt16()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyInt16.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestInt16_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestInt16_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInt16(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInt16().All()))
+	})
+}
+
+func TestInt16_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInt16 := option.SomeInt16(12)
+	otherInt16 := option.SomeInt16(13)
+	emptyInt16 := option.NoneInt16()
+
+	assert.True(t, someInt16.Equal(option.SomeInt16(12)))
+	assert.True(t, emptyInt16.Equal(option.NoneInt16()))
+	assert.False(t, someInt16.Equal(otherInt16))
+	assert.False(t, someInt16.Equal(emptyInt16))
+	assert.False(t, emptyInt16.Equal(someInt16))
+
+	assert.Zero(t, someInt16.Compare(option.SomeInt16(12)))
+	assert.Zero(t, emptyInt16.Compare(option.NoneInt16()))
+	assert.Equal(t, -otherInt16.Compare(someInt16), someInt16.Compare(otherInt16))
+	assert.NotZero(t, someInt16.Compare(otherInt16))
+	assert.Equal(t, 1, someInt16.Compare(emptyInt16))
+	assert.Equal(t, -1, emptyInt16.Compare(someInt16))
+}
+
+func TestInt16_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		assert.True(t, someInt16.Filter(func(int16) bool { return true }).IsSome())
+		assert.False(t, someInt16.Filter(func(int16) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		assert.False(t, emptyInt16.Filter(func(int16) bool { return true }).IsSome())
+	})
+}
+
+func TestInt16_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		other := option.SomeInt16(13)
+		assert.EqualValues(t, 12, someInt16.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		other := option.SomeInt16(13)
+		assert.EqualValues(t, 13, emptyInt16.Or(other).Unwrap())
+	})
+}
+
+func TestInt16_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		assert.EqualValues(t, 12, someInt16.OrElse(func() option.Int16 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		assert.EqualValues(t, 13, emptyInt16.OrElse(func() option.Int16 {
+			return option.SomeInt16(13)
+		}).Unwrap())
+	})
+}
+
 func TestInt16_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestInt16_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestInt16_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInt16 := option.SomeInt16(12)
+		require.NoError(t, someInt16.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInt16.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInt16().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInt16_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		val, err := someInt16.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Int16
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		val, err := emptyInt16.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInt16(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInt16_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		data, err := json.Marshal(someInt16)
+		require.NoError(t, err)
+
+		var unmarshaled option.Int16
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		data, err := json.Marshal(emptyInt16)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInt16(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Int16
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Int16", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt16_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt16 := option.SomeInt16(12)
+		data, err := someInt16.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Int16
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt16 := option.NoneInt16()
+		data, err := emptyInt16.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInt16(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt16_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInt16(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Int16
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Int16
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeInt16() {
 	opt := option.SomeInt16(12)
 	if opt.IsSome() {
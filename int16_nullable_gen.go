@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInt16 represents a nullable value of type int16 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInt16 struct {
+	value int16
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInt16]()
+}
+
+// SomeNullableInt16 creates a NullableInt16 with the given int16 value.
+func SomeNullableInt16(value int16) NullableInt16 {
+	return NullableInt16{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInt16 creates a NullableInt16, that is explicitly set to null.
+func NullNullableInt16() NullableInt16 {
+	return NullableInt16{
+		value: zero[int16](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInt16 creates a NullableInt16, that is not set.
+// It is equal to the zero value of NullableInt16.
+func UnsetNullableInt16() NullableInt16 {
+	return NullableInt16{
+		value: zero[int16](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInt16 contains a value.
+func (o NullableInt16) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInt16 is explicitly set to null.
+func (o NullableInt16) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInt16 is either null or contains a value.
+func (o NullableInt16) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInt16 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInt16) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of int16, false).
+func (o NullableInt16) Get() (int16, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInt16) MustGet() int16 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for int16.
+func (o NullableInt16) Unwrap() int16 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInt16) UnwrapOr(defaultValue int16) int16 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInt16) UnwrapOrElse(defaultValue func() int16) int16 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInt16 to Int16. Both Null and Unset are converted to NoneInt16().
+func (o NullableInt16) Option() Int16 {
+	if o.state == nullableSome {
+		return SomeInt16(o.value)
+	}
+
+	return NoneInt16()
+}
+
+// Equal returns true if both NullableInt16s have the same state and, if present, equal values.
+func (o NullableInt16) Equal(other NullableInt16) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInt16) Compare(other NullableInt16) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInt16 value using MessagePack format.
+// - If the value is present, it is encoded as int16.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInt16) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInt16", encodeInt16(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInt16", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInt16 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int16.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInt16) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt16(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInt16", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInt16 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInt16)
+//   - int16: interpreted as a present value (SomeNullableInt16)
+//
+// In DecodeModeLenient alternative encodings of int16 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int16 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt16) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInt16", "int16", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[int16]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInt16", "int16", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int16
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt16Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableInt16", "int16", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt16(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableInt16", "int16", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt16Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInt16", "int16", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInt16", "int16", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInt16 value using JSON format.
+// - If the value is present, it is encoded as int16.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInt16) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInt16 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInt16)
+//   - int16: interpreted as a present value (SomeNullableInt16)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt16) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int16]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInt16", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int16.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInt16) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt16(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInt16)
+//   - text representation of int16: interpreted as a present value (SomeNullableInt16)
+func (o *NullableInt16) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int16]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt16(text)
+	if err != nil {
+		return newDecodeError("NullableInt16", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestInt32_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyInt32 := option.NoneInt32()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyInt32.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestInt32_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestInt32_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInt32(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInt32().All()))
+	})
+}
+
+func TestInt32_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInt32 := option.SomeInt32(12)
+	otherInt32 := option.SomeInt32(13)
+	emptyInt32 := option.NoneInt32()
+
+	assert.True(t, someInt32.Equal(option.SomeInt32(12)))
+	assert.True(t, emptyInt32.Equal(option.NoneInt32()))
+	assert.False(t, someInt32.Equal(otherInt32))
+	assert.False(t, someInt32.Equal(emptyInt32))
+	assert.False(t, emptyInt32.Equal(someInt32))
+
+	assert.Zero(t, someInt32.Compare(option.SomeInt32(12)))
+	assert.Zero(t, emptyInt32.Compare(option.NoneInt32()))
+	assert.Equal(t, -otherInt32.Compare(someInt32), someInt32.Compare(otherInt32))
+	assert.NotZero(t, someInt32.Compare(otherInt32))
+	assert.Equal(t, 1, someInt32.Compare(emptyInt32))
+	assert.Equal(t, -1, emptyInt32.Compare(someInt32))
+}
+
+func TestInt32_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		assert.True(t, someInt32.Filter(func(int32) bool { return true }).IsSome())
+		assert.False(t, someInt32.Filter(func(int32) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		assert.False(t, emptyInt32.Filter(func(int32) bool { return true }).IsSome())
+	})
+}
+
+func TestInt32_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		other := option.SomeInt32(13)
+		assert.EqualValues(t, 12, someInt32.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		other := option.SomeInt32(13)
+		assert.EqualValues(t, 13, emptyInt32.Or(other).Unwrap())
+	})
+}
+
+func TestInt32_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		assert.EqualValues(t, 12, someInt32.OrElse(func() option.Int32 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		assert.EqualValues(t, 13, emptyInt32.OrElse(func() option.Int32 {
+			return option.SomeInt32(13)
+		}).Unwrap())
+	})
+}
+
 func TestInt32_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestInt32_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestInt32_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInt32 := option.SomeInt32(12)
+		require.NoError(t, someInt32.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInt32.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInt32().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInt32_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		val, err := someInt32.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Int32
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		val, err := emptyInt32.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInt32(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInt32_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		data, err := json.Marshal(someInt32)
+		require.NoError(t, err)
+
+		var unmarshaled option.Int32
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		data, err := json.Marshal(emptyInt32)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInt32(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Int32
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Int32", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt32_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt32 := option.SomeInt32(12)
+		data, err := someInt32.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Int32
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt32 := option.NoneInt32()
+		data, err := emptyInt32.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInt32(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt32_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInt32(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Int32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Int32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeInt32() {
 	opt := option.SomeInt32(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInt32 represents a nullable value of type int32 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInt32 struct {
+	value int32
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInt32]()
+}
+
+// SomeNullableInt32 creates a NullableInt32 with the given int32 value.
+func SomeNullableInt32(value int32) NullableInt32 {
+	return NullableInt32{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInt32 creates a NullableInt32, that is explicitly set to null.
+func NullNullableInt32() NullableInt32 {
+	return NullableInt32{
+		value: zero[int32](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInt32 creates a NullableInt32, that is not set.
+// It is equal to the zero value of NullableInt32.
+func UnsetNullableInt32() NullableInt32 {
+	return NullableInt32{
+		value: zero[int32](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInt32 contains a value.
+func (o NullableInt32) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInt32 is explicitly set to null.
+func (o NullableInt32) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInt32 is either null or contains a value.
+func (o NullableInt32) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInt32 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInt32) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of int32, false).
+func (o NullableInt32) Get() (int32, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInt32) MustGet() int32 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for int32.
+func (o NullableInt32) Unwrap() int32 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInt32) UnwrapOr(defaultValue int32) int32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInt32) UnwrapOrElse(defaultValue func() int32) int32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInt32 to Int32. Both Null and Unset are converted to NoneInt32().
+func (o NullableInt32) Option() Int32 {
+	if o.state == nullableSome {
+		return SomeInt32(o.value)
+	}
+
+	return NoneInt32()
+}
+
+// Equal returns true if both NullableInt32s have the same state and, if present, equal values.
+func (o NullableInt32) Equal(other NullableInt32) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInt32) Compare(other NullableInt32) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInt32 value using MessagePack format.
+// - If the value is present, it is encoded as int32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInt32) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInt32", encodeInt32(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInt32", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInt32 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInt32) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt32(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInt32", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInt32 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInt32)
+//   - int32: interpreted as a present value (SomeNullableInt32)
+//
+// In DecodeModeLenient alternative encodings of int32 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int32 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt32) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInt32", "int32", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[int32]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInt32", "int32", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int32
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt32Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableInt32", "int32", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt32(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableInt32", "int32", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt32Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInt32", "int32", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInt32", "int32", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInt32 value using JSON format.
+// - If the value is present, it is encoded as int32.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInt32) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInt32 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInt32)
+//   - int32: interpreted as a present value (SomeNullableInt32)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt32) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInt32", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int32.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInt32) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt32(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInt32)
+//   - text representation of int32: interpreted as a present value (SomeNullableInt32)
+func (o *NullableInt32) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt32(text)
+	if err != nil {
+		return newDecodeError("NullableInt32", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
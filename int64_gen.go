@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Int64) Get() (int64, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Int64) MustGet() int64 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Int64) UnwrapOrElse(defaultValue func() int64) int64 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Int64) All() iter.Seq[int64] {
+	return func(yield func(int64) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Int64s are None, or both contain equal values.
+func (o Int64) Equal(other Int64) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneInt64() is less than any value.
+func (o Int64) Compare(other Int64) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Int64 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneInt64().
+func (o Int64) Filter(predicate func(int64) bool) Int64 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneInt64()
+}
+
+// Or returns the Int64 itself if it contains a value.
+// Otherwise, returns other.
+func (o Int64) Or(other Int64) Int64 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Int64 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Int64) OrElse(fn func() Int64) Int64 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Int64 value using MessagePack format.
 // - If the value is present, it is encoded as int64.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Int64) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Int64", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Int64 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int64.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Int64) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt64(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Int64", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Int64 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneInt64)
 //   - int64: interpreted as a present value (SomeInt64)
 //
+// In DecodeModeLenient alternative encodings of int64 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int64 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on int64: exists = true, value = decoded value
 func (o *Int64) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Int64", err)
+		return newDecodeMsgpackError("Int64", "int64", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Int64", decoder.Skip())
+		return newDecodeMsgpackError("Int64", "int64", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeInt64(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int64
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt64Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Int64", "int64", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt64(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Int64", "int64", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt64Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Int64", err)
+			return newDecodeMsgpackError("Int64", "int64", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Int64", code)
+		return newDecodeWithCodeError("Int64", "int64", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as int64.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Int64) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueInt64(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int64", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneInt64)
+//   - int64: interpreted as a present value (SomeInt64)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into int64.
+func (o *Int64) Scan(src any) error {
+	if src == nil {
+		o.value = zero[int64]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanInt64(src)
+	if err != nil {
+		return newDecodeError("Int64", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Int64 value using JSON format.
+// - If the value is present, it is encoded as int64.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Int64) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Int64 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneInt64)
+//   - int64: interpreted as a present value (SomeInt64)
+//
+// Returns an error if the input can't be decoded as int64.
+func (o *Int64) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int64]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Int64", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int64.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Int64) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt64(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneInt64)
+//   - text representation of int64: interpreted as a present value (SomeInt64)
+//
+// Returns an error if the text can't be parsed as int64.
+func (o *Int64) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int64]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt64(text)
+	if err != nil {
+		return newDecodeError("Int64", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Int64.
+// The Int64 stays None until the flag is passed, the value is parsed
+// as text representation of int64. Get of the returned flag.Getter returns the Int64.
+//
+// Example:
+//
+//	var opt option.Int64
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Int64) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextInt64([]byte(text))
+			if err != nil {
+				return newDecodeError("Int64", err)
+			}
+
+			*o = SomeInt64(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
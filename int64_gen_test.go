@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestInt64_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyInt64 := option.NoneInt64()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyInt64.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestInt64_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestInt64_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInt64(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInt64().All()))
+	})
+}
+
+func TestInt64_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInt64 := option.SomeInt64(12)
+	otherInt64 := option.SomeInt64(13)
+	emptyInt64 := option.NoneInt64()
+
+	assert.True(t, someInt64.Equal(option.SomeInt64(12)))
+	assert.True(t, emptyInt64.Equal(option.NoneInt64()))
+	assert.False(t, someInt64.Equal(otherInt64))
+	assert.False(t, someInt64.Equal(emptyInt64))
+	assert.False(t, emptyInt64.Equal(someInt64))
+
+	assert.Zero(t, someInt64.Compare(option.SomeInt64(12)))
+	assert.Zero(t, emptyInt64.Compare(option.NoneInt64()))
+	assert.Equal(t, -otherInt64.Compare(someInt64), someInt64.Compare(otherInt64))
+	assert.NotZero(t, someInt64.Compare(otherInt64))
+	assert.Equal(t, 1, someInt64.Compare(emptyInt64))
+	assert.Equal(t, -1, emptyInt64.Compare(someInt64))
+}
+
+func TestInt64_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		assert.True(t, someInt64.Filter(func(int64) bool { return true }).IsSome())
+		assert.False(t, someInt64.Filter(func(int64) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		assert.False(t, emptyInt64.Filter(func(int64) bool { return true }).IsSome())
+	})
+}
+
+func TestInt64_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		other := option.SomeInt64(13)
+		assert.EqualValues(t, 12, someInt64.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		other := option.SomeInt64(13)
+		assert.EqualValues(t, 13, emptyInt64.Or(other).Unwrap())
+	})
+}
+
+func TestInt64_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		assert.EqualValues(t, 12, someInt64.OrElse(func() option.Int64 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		assert.EqualValues(t, 13, emptyInt64.OrElse(func() option.Int64 {
+			return option.SomeInt64(13)
+		}).Unwrap())
+	})
+}
+
 func TestInt64_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestInt64_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestInt64_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInt64 := option.SomeInt64(12)
+		require.NoError(t, someInt64.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInt64.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInt64().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInt64_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		val, err := someInt64.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Int64
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		val, err := emptyInt64.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInt64(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInt64_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		data, err := json.Marshal(someInt64)
+		require.NoError(t, err)
+
+		var unmarshaled option.Int64
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		data, err := json.Marshal(emptyInt64)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInt64(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Int64
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Int64", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt64_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt64 := option.SomeInt64(12)
+		data, err := someInt64.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Int64
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt64 := option.NoneInt64()
+		data, err := emptyInt64.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInt64(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt64_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInt64(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Int64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Int64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeInt64() {
 	opt := option.SomeInt64(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInt64 represents a nullable value of type int64 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInt64 struct {
+	value int64
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInt64]()
+}
+
+// SomeNullableInt64 creates a NullableInt64 with the given int64 value.
+func SomeNullableInt64(value int64) NullableInt64 {
+	return NullableInt64{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInt64 creates a NullableInt64, that is explicitly set to null.
+func NullNullableInt64() NullableInt64 {
+	return NullableInt64{
+		value: zero[int64](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInt64 creates a NullableInt64, that is not set.
+// It is equal to the zero value of NullableInt64.
+func UnsetNullableInt64() NullableInt64 {
+	return NullableInt64{
+		value: zero[int64](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInt64 contains a value.
+func (o NullableInt64) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInt64 is explicitly set to null.
+func (o NullableInt64) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInt64 is either null or contains a value.
+func (o NullableInt64) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInt64 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInt64) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of int64, false).
+func (o NullableInt64) Get() (int64, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInt64) MustGet() int64 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for int64.
+func (o NullableInt64) Unwrap() int64 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInt64) UnwrapOr(defaultValue int64) int64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInt64) UnwrapOrElse(defaultValue func() int64) int64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInt64 to Int64. Both Null and Unset are converted to NoneInt64().
+func (o NullableInt64) Option() Int64 {
+	if o.state == nullableSome {
+		return SomeInt64(o.value)
+	}
+
+	return NoneInt64()
+}
+
+// Equal returns true if both NullableInt64s have the same state and, if present, equal values.
+func (o NullableInt64) Equal(other NullableInt64) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInt64) Compare(other NullableInt64) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInt64 value using MessagePack format.
+// - If the value is present, it is encoded as int64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInt64) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInt64", encodeInt64(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInt64", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInt64 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInt64) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt64(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInt64", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInt64 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInt64)
+//   - int64: interpreted as a present value (SomeNullableInt64)
+//
+// In DecodeModeLenient alternative encodings of int64 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int64 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt64) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInt64", "int64", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[int64]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInt64", "int64", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int64
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt64Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableInt64", "int64", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt64(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableInt64", "int64", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt64Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInt64", "int64", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInt64", "int64", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInt64 value using JSON format.
+// - If the value is present, it is encoded as int64.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInt64) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInt64 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInt64)
+//   - int64: interpreted as a present value (SomeNullableInt64)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt64) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInt64", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int64.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInt64) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt64(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInt64)
+//   - text representation of int64: interpreted as a present value (SomeNullableInt64)
+func (o *NullableInt64) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt64(text)
+	if err != nil {
+		return newDecodeError("NullableInt64", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
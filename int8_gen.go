@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Int8) Get() (int8, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Int8) MustGet() int8 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Int8) UnwrapOrElse(defaultValue func() int8) int8 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Int8) All() iter.Seq[int8] {
+	return func(yield func(int8) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Int8s are None, or both contain equal values.
+func (o Int8) Equal(other Int8) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneInt8() is less than any value.
+func (o Int8) Compare(other Int8) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Int8 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneInt8().
+func (o Int8) Filter(predicate func(int8) bool) Int8 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneInt8()
+}
+
+// Or returns the Int8 itself if it contains a value.
+// Otherwise, returns other.
+func (o Int8) Or(other Int8) Int8 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Int8 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Int8) OrElse(fn func() Int8) Int8 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Int8 value using MessagePack format.
 // - If the value is present, it is encoded as int8.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Int8) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Int8", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Int8 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int8.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Int8) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt8(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Int8", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Int8 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneInt8)
 //   - int8: interpreted as a present value (SomeInt8)
 //
+// In DecodeModeLenient alternative encodings of int8 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int8 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on int8: exists = true, value = decoded value
 func (o *Int8) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Int8", err)
+		return newDecodeMsgpackError("Int8", "int8", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Int8", decoder.Skip())
+		return newDecodeMsgpackError("Int8", "int8", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeInt8(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int8
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt8Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Int8", "int8", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt8(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Int8", "int8", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt8Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Int8", err)
+			return newDecodeMsgpackError("Int8", "int8", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Int8", code)
+		return newDecodeWithCodeError("Int8", "int8", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as int8.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Int8) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueInt8(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int8", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneInt8)
+//   - int8: interpreted as a present value (SomeInt8)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into int8.
+func (o *Int8) Scan(src any) error {
+	if src == nil {
+		o.value = zero[int8]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanInt8(src)
+	if err != nil {
+		return newDecodeError("Int8", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Int8 value using JSON format.
+// - If the value is present, it is encoded as int8.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Int8) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Int8 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneInt8)
+//   - int8: interpreted as a present value (SomeInt8)
+//
+// Returns an error if the input can't be decoded as int8.
+func (o *Int8) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int8]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Int8", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int8.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Int8) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt8(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneInt8)
+//   - text representation of int8: interpreted as a present value (SomeInt8)
+//
+// Returns an error if the text can't be parsed as int8.
+func (o *Int8) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int8]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt8(text)
+	if err != nil {
+		return newDecodeError("Int8", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Int8.
+// The Int8 stays None until the flag is passed, the value is parsed
+// as text representation of int8. Get of the returned flag.Getter returns the Int8.
+//
+// Example:
+//
+//	var opt option.Int8
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Int8) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextInt8([]byte(text))
+			if err != nil {
+				return newDecodeError("Int8", err)
+			}
+
+			*o = SomeInt8(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestInt8_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyInt8 := option.NoneInt8()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyInt8.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestInt8_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestInt8_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInt8(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInt8().All()))
+	})
+}
+
+func TestInt8_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInt8 := option.SomeInt8(12)
+	otherInt8 := option.SomeInt8(13)
+	emptyInt8 := option.NoneInt8()
+
+	assert.True(t, someInt8.Equal(option.SomeInt8(12)))
+	assert.True(t, emptyInt8.Equal(option.NoneInt8()))
+	assert.False(t, someInt8.Equal(otherInt8))
+	assert.False(t, someInt8.Equal(emptyInt8))
+	assert.False(t, emptyInt8.Equal(someInt8))
+
+	assert.Zero(t, someInt8.Compare(option.SomeInt8(12)))
+	assert.Zero(t, emptyInt8.Compare(option.NoneInt8()))
+	assert.Equal(t, -otherInt8.Compare(someInt8), someInt8.Compare(otherInt8))
+	assert.NotZero(t, someInt8.Compare(otherInt8))
+	assert.Equal(t, 1, someInt8.Compare(emptyInt8))
+	assert.Equal(t, -1, emptyInt8.Compare(someInt8))
+}
+
+func TestInt8_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		assert.True(t, someInt8.Filter(func(int8) bool { return true }).IsSome())
+		assert.False(t, someInt8.Filter(func(int8) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		assert.False(t, emptyInt8.Filter(func(int8) bool { return true }).IsSome())
+	})
+}
+
+func TestInt8_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		other := option.SomeInt8(13)
+		assert.EqualValues(t, 12, someInt8.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		other := option.SomeInt8(13)
+		assert.EqualValues(t, 13, emptyInt8.Or(other).Unwrap())
+	})
+}
+
+func TestInt8_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		assert.EqualValues(t, 12, someInt8.OrElse(func() option.Int8 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		assert.EqualValues(t, 13, emptyInt8.OrElse(func() option.Int8 {
+			return option.SomeInt8(13)
+		}).Unwrap())
+	})
+}
+
 func TestInt8_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestInt8_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestInt8_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInt8 := option.SomeInt8(12)
+		require.NoError(t, someInt8.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInt8.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInt8().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInt8_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		val, err := someInt8.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Int8
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		val, err := emptyInt8.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInt8(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInt8_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		data, err := json.Marshal(someInt8)
+		require.NoError(t, err)
+
+		var unmarshaled option.Int8
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		data, err := json.Marshal(emptyInt8)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInt8(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Int8
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Int8", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt8_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt8 := option.SomeInt8(12)
+		data, err := someInt8.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Int8
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt8 := option.NoneInt8()
+		data, err := emptyInt8.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInt8(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt8_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInt8(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Int8
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Int8
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeInt8() {
 	opt := option.SomeInt8(12)
 	if opt.IsSome() {
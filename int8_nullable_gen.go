@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInt8 represents a nullable value of type int8 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInt8 struct {
+	value int8
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInt8]()
+}
+
+// SomeNullableInt8 creates a NullableInt8 with the given int8 value.
+func SomeNullableInt8(value int8) NullableInt8 {
+	return NullableInt8{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInt8 creates a NullableInt8, that is explicitly set to null.
+func NullNullableInt8() NullableInt8 {
+	return NullableInt8{
+		value: zero[int8](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInt8 creates a NullableInt8, that is not set.
+// It is equal to the zero value of NullableInt8.
+func UnsetNullableInt8() NullableInt8 {
+	return NullableInt8{
+		value: zero[int8](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInt8 contains a value.
+func (o NullableInt8) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInt8 is explicitly set to null.
+func (o NullableInt8) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInt8 is either null or contains a value.
+func (o NullableInt8) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInt8 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInt8) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of int8, false).
+func (o NullableInt8) Get() (int8, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInt8) MustGet() int8 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for int8.
+func (o NullableInt8) Unwrap() int8 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInt8) UnwrapOr(defaultValue int8) int8 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInt8) UnwrapOrElse(defaultValue func() int8) int8 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInt8 to Int8. Both Null and Unset are converted to NoneInt8().
+func (o NullableInt8) Option() Int8 {
+	if o.state == nullableSome {
+		return SomeInt8(o.value)
+	}
+
+	return NoneInt8()
+}
+
+// Equal returns true if both NullableInt8s have the same state and, if present, equal values.
+func (o NullableInt8) Equal(other NullableInt8) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInt8) Compare(other NullableInt8) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInt8 value using MessagePack format.
+// - If the value is present, it is encoded as int8.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInt8) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInt8", encodeInt8(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInt8", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInt8 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int8.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInt8) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt8(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInt8", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInt8 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInt8)
+//   - int8: interpreted as a present value (SomeNullableInt8)
+//
+// In DecodeModeLenient alternative encodings of int8 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int8 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt8) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInt8", "int8", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[int8]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInt8", "int8", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int8
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeInt8Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableInt8", "int8", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt8(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableInt8", "int8", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeInt8Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInt8", "int8", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInt8", "int8", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInt8 value using JSON format.
+// - If the value is present, it is encoded as int8.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInt8) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInt8 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInt8)
+//   - int8: interpreted as a present value (SomeNullableInt8)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt8) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int8]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInt8", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int8.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInt8) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt8(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInt8)
+//   - text representation of int8: interpreted as a present value (SomeNullableInt8)
+func (o *NullableInt8) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int8]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt8(text)
+	if err != nil {
+		return newDecodeError("NullableInt8", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
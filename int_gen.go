@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Int) Get() (int, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Int) MustGet() int {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Int) UnwrapOrElse(defaultValue func() int) int {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Int) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Ints are None, or both contain equal values.
+func (o Int) Equal(other Int) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneInt() is less than any value.
+func (o Int) Compare(other Int) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Int itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneInt().
+func (o Int) Filter(predicate func(int) bool) Int {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneInt()
+}
+
+// Or returns the Int itself if it contains a value.
+// Otherwise, returns other.
+func (o Int) Or(other Int) Int {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Int itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Int) OrElse(fn func() Int) Int {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Int value using MessagePack format.
 // - If the value is present, it is encoded as int.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Int) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Int", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Int value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Int) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Int", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Int value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneInt)
 //   - int: interpreted as a present value (SomeInt)
 //
+// In DecodeModeLenient alternative encodings of int are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on int: exists = true, value = decoded value
 func (o *Int) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Int", err)
+		return newDecodeMsgpackError("Int", "int", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Int", decoder.Skip())
+		return newDecodeMsgpackError("Int", "int", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeInt(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeIntStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Int", "int", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Int", "int", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeIntLenient(decoder)
 		if err != nil {
-			return newDecodeError("Int", err)
+			return newDecodeMsgpackError("Int", "int", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Int", code)
+		return newDecodeWithCodeError("Int", "int", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as int.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Int) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueInt(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneInt)
+//   - int: interpreted as a present value (SomeInt)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into int.
+func (o *Int) Scan(src any) error {
+	if src == nil {
+		o.value = zero[int]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanInt(src)
+	if err != nil {
+		return newDecodeError("Int", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Int value using JSON format.
+// - If the value is present, it is encoded as int.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Int) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Int value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneInt)
+//   - int: interpreted as a present value (SomeInt)
+//
+// Returns an error if the input can't be decoded as int.
+func (o *Int) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Int", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Int) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt(o.value)
+	if err != nil {
+		return nil, newEncodeError("Int", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneInt)
+//   - text representation of int: interpreted as a present value (SomeInt)
+//
+// Returns an error if the text can't be parsed as int.
+func (o *Int) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt(text)
+	if err != nil {
+		return newDecodeError("Int", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Int.
+// The Int stays None until the flag is passed, the value is parsed
+// as text representation of int. Get of the returned flag.Getter returns the Int.
+//
+// Example:
+//
+//	var opt option.Int
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Int) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextInt([]byte(text))
+			if err != nil {
+				return newDecodeError("Int", err)
+			}
+
+			*o = SomeInt(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestInt_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyInt := option.NoneInt()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyInt.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestInt_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestInt_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInt(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInt().All()))
+	})
+}
+
+func TestInt_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInt := option.SomeInt(12)
+	otherInt := option.SomeInt(13)
+	emptyInt := option.NoneInt()
+
+	assert.True(t, someInt.Equal(option.SomeInt(12)))
+	assert.True(t, emptyInt.Equal(option.NoneInt()))
+	assert.False(t, someInt.Equal(otherInt))
+	assert.False(t, someInt.Equal(emptyInt))
+	assert.False(t, emptyInt.Equal(someInt))
+
+	assert.Zero(t, someInt.Compare(option.SomeInt(12)))
+	assert.Zero(t, emptyInt.Compare(option.NoneInt()))
+	assert.Equal(t, -otherInt.Compare(someInt), someInt.Compare(otherInt))
+	assert.NotZero(t, someInt.Compare(otherInt))
+	assert.Equal(t, 1, someInt.Compare(emptyInt))
+	assert.Equal(t, -1, emptyInt.Compare(someInt))
+}
+
+func TestInt_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		assert.True(t, someInt.Filter(func(int) bool { return true }).IsSome())
+		assert.False(t, someInt.Filter(func(int) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		assert.False(t, emptyInt.Filter(func(int) bool { return true }).IsSome())
+	})
+}
+
+func TestInt_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		other := option.SomeInt(13)
+		assert.EqualValues(t, 12, someInt.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		other := option.SomeInt(13)
+		assert.EqualValues(t, 13, emptyInt.Or(other).Unwrap())
+	})
+}
+
+func TestInt_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		assert.EqualValues(t, 12, someInt.OrElse(func() option.Int {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		assert.EqualValues(t, 13, emptyInt.OrElse(func() option.Int {
+			return option.SomeInt(13)
+		}).Unwrap())
+	})
+}
+
 func TestInt_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestInt_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestInt_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInt := option.SomeInt(12)
+		require.NoError(t, someInt.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInt.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInt().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInt_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		val, err := someInt.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Int
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		val, err := emptyInt.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInt(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInt_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		data, err := json.Marshal(someInt)
+		require.NoError(t, err)
+
+		var unmarshaled option.Int
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		data, err := json.Marshal(emptyInt)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInt(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Int
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Int", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInt := option.SomeInt(12)
+		data, err := someInt.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Int
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInt := option.NoneInt()
+		data, err := emptyInt.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInt(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInt_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInt(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Int
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Int
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeInt() {
 	opt := option.SomeInt(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInt represents a nullable value of type int with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInt struct {
+	value int
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInt]()
+}
+
+// SomeNullableInt creates a NullableInt with the given int value.
+func SomeNullableInt(value int) NullableInt {
+	return NullableInt{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInt creates a NullableInt, that is explicitly set to null.
+func NullNullableInt() NullableInt {
+	return NullableInt{
+		value: zero[int](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInt creates a NullableInt, that is not set.
+// It is equal to the zero value of NullableInt.
+func UnsetNullableInt() NullableInt {
+	return NullableInt{
+		value: zero[int](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInt contains a value.
+func (o NullableInt) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInt is explicitly set to null.
+func (o NullableInt) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInt is either null or contains a value.
+func (o NullableInt) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInt is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInt) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of int, false).
+func (o NullableInt) Get() (int, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInt) MustGet() int {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for int.
+func (o NullableInt) Unwrap() int {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInt) UnwrapOr(defaultValue int) int {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInt) UnwrapOrElse(defaultValue func() int) int {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInt to Int. Both Null and Unset are converted to NoneInt().
+func (o NullableInt) Option() Int {
+	if o.state == nullableSome {
+		return SomeInt(o.value)
+	}
+
+	return NoneInt()
+}
+
+// Equal returns true if both NullableInts have the same state and, if present, equal values.
+func (o NullableInt) Equal(other NullableInt) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInt) Compare(other NullableInt) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInt value using MessagePack format.
+// - If the value is present, it is encoded as int.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInt) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInt", encodeInt(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInt", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInt value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as int.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInt) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInt(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInt", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInt value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInt)
+//   - int: interpreted as a present value (SomeNullableInt)
+//
+// In DecodeModeLenient alternative encodings of int are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as int exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInt", "int", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[int]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInt", "int", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val int
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeIntStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableInt", "int", offset, code, err)
+			}
+		} else {
+			val, err = decodeInt(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableInt", "int", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeIntLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInt", "int", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInt", "int", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInt value using JSON format.
+// - If the value is present, it is encoded as int.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInt) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInt value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInt)
+//   - int: interpreted as a present value (SomeNullableInt)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInt) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[int]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInt", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of int.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInt) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInt(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInt", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInt)
+//   - text representation of int: interpreted as a present value (SomeNullableInt)
+func (o *NullableInt) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[int]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInt(text)
+	if err != nil {
+		return newDecodeError("NullableInt", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package option
 
 import (
+	"database/sql/driver"
+
 	"github.com/vmihailenco/msgpack/v5"
 )
 
@@ -16,5 +18,12 @@ type commonInterface[T any] interface {
 	UnwrapOrElse(defCb func() T) T
 
 	EncodeMsgpack(enc *msgpack.Encoder) error
+	AppendMsgpack(dst []byte) ([]byte, error)
 	DecodeMsgpack(dec *msgpack.Decoder) error
+
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(data []byte) error
+
+	Value() (driver.Value, error)
+	Scan(src any) error
 }
@@ -0,0 +1,308 @@
+package option
+
+// This file implements IntervalValue, a representation of Tarantool datetime intervals,
+// and conversion to/from the MessagePack extension format used by Tarantool (MP_EXT type 6):
+//
+//	+-------------+------------+-------------+-----+------------+-------------+
+//	| field count | field id 1 | field val 1 | ... | field id N | field val N |
+//	| MP_UINT     | MP_UINT    | MP_INT      |     | MP_UINT    | MP_INT      |
+//	+-------------+------------+-------------+-----+------------+-------------+
+//
+// Only non-zero fields are encoded, adjust is omitted if it equals to "excess".
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// IntervalExtCode is the MessagePack extension code of Tarantool interval type.
+	IntervalExtCode = 6
+)
+
+// IntervalAdjust defines how Tarantool adjusts a date when an interval with months or years
+// is added to a date at the end of a month.
+type IntervalAdjust int64
+
+const (
+	// IntervalAdjustNone keeps the day of month, but limits it to the last day of month
+	// (adjust = "none" in Tarantool, the default one).
+	IntervalAdjustNone IntervalAdjust = 0
+	// IntervalAdjustExcess allows overflowing into the next month (adjust = "excess" in Tarantool).
+	IntervalAdjustExcess IntervalAdjust = 1
+	// IntervalAdjustLast snaps the date to the last day of month (adjust = "last" in Tarantool).
+	IntervalAdjustLast IntervalAdjust = 2
+)
+
+// Tarantool internal values of adjust.
+const (
+	dtExcess = 0
+	dtLimit  = 1
+	dtSnap   = 2
+)
+
+// Tarantool field ids of interval.
+const (
+	intervalFieldYear = iota
+	intervalFieldMonth
+	intervalFieldWeek
+	intervalFieldDay
+	intervalFieldHour
+	intervalFieldMin
+	intervalFieldSec
+	intervalFieldNsec
+	intervalFieldAdjust
+)
+
+var (
+	errIntervalField  = errors.New("unknown interval field")
+	errIntervalAdjust = errors.New("unknown interval adjust")
+	errIntervalExtra  = errors.New("unexpected trailing bytes in interval payload")
+	errIntervalSyntax = errors.New("invalid interval syntax")
+)
+
+// IntervalValue is a datetime interval, compatible with Tarantool interval type.
+//
+// The zero value is an empty interval with the default adjust.
+type IntervalValue struct {
+	Year   int64
+	Month  int64
+	Week   int64
+	Day    int64
+	Hour   int64
+	Min    int64
+	Sec    int64
+	Nsec   int64
+	Adjust IntervalAdjust
+}
+
+// intervalUnit is a field of IntervalValue with the unit of its text representation.
+type intervalUnit struct {
+	value *int64
+	unit  string
+}
+
+// units returns fields of the interval in the order of their text representation.
+func (i *IntervalValue) units() []intervalUnit {
+	return []intervalUnit{
+		{&i.Year, "years"},
+		{&i.Month, "months"},
+		{&i.Week, "weeks"},
+		{&i.Day, "days"},
+		{&i.Hour, "hours"},
+		{&i.Min, "minutes"},
+		{&i.Sec, "seconds"},
+		{&i.Nsec, "nanoseconds"},
+	}
+}
+
+// String returns the text representation of the interval, similar to the Tarantool one,
+// e.g. "+1 years, -2 months, +3 hours".
+func (i IntervalValue) String() string {
+	parts := make([]string, 0, intervalFieldAdjust)
+
+	for _, field := range i.units() {
+		if *field.value != 0 {
+			parts = append(parts, fmt.Sprintf("%+d %s", *field.value, field.unit))
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0 seconds"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// The text is the same as String() returns, a non-default adjust is appended
+// as ", adjust excess" or ", adjust last".
+func (i IntervalValue) MarshalText() ([]byte, error) {
+	text := i.String()
+
+	switch i.Adjust {
+	case IntervalAdjustNone:
+	case IntervalAdjustExcess:
+		text += ", adjust excess"
+	case IntervalAdjustLast:
+		text += ", adjust last"
+	default:
+		return nil, fmt.Errorf("%w: %d", errIntervalAdjust, i.Adjust)
+	}
+
+	return []byte(text), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It accepts the text, produced by MarshalText.
+func (i *IntervalValue) UnmarshalText(text []byte) error {
+	var val IntervalValue
+
+	units := val.units()
+
+	for part := range strings.SplitSeq(string(text), ", ") {
+		num, unit, ok := strings.Cut(part, " ")
+		if !ok {
+			return fmt.Errorf("%w: %q", errIntervalSyntax, part)
+		}
+
+		if num == "adjust" {
+			switch unit {
+			case "none":
+				val.Adjust = IntervalAdjustNone
+			case "excess":
+				val.Adjust = IntervalAdjustExcess
+			case "last":
+				val.Adjust = IntervalAdjustLast
+			default:
+				return fmt.Errorf("%w: %s", errIntervalAdjust, unit)
+			}
+
+			continue
+		}
+
+		idx := slices.IndexFunc(units, func(field intervalUnit) bool { return field.unit == unit })
+		if idx < 0 {
+			return fmt.Errorf("%w: unknown unit %q", errIntervalSyntax, unit)
+		}
+
+		parsed, err := strconv.ParseInt(num, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errIntervalSyntax, err)
+		}
+
+		*units[idx].value = parsed
+	}
+
+	*i = val
+
+	return nil
+}
+
+func (a IntervalAdjust) toTarantool() (int64, error) {
+	switch a {
+	case IntervalAdjustNone:
+		return dtLimit, nil
+	case IntervalAdjustExcess:
+		return dtExcess, nil
+	case IntervalAdjustLast:
+		return dtSnap, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", errIntervalAdjust, a)
+	}
+}
+
+func intervalAdjustFromTarantool(val int64) (IntervalAdjust, error) {
+	switch val {
+	case dtLimit:
+		return IntervalAdjustNone, nil
+	case dtExcess:
+		return IntervalAdjustExcess, nil
+	case dtSnap:
+		return IntervalAdjustLast, nil
+	default:
+		return 0, fmt.Errorf("%w: %d", errIntervalAdjust, val)
+	}
+}
+
+// appendIntervalPayload appends the Tarantool MP_EXT payload of the interval to dst.
+func appendIntervalPayload(dst []byte, val IntervalValue) ([]byte, error) {
+	adjust, err := val.Adjust.toTarantool()
+	if err != nil {
+		return nil, err
+	}
+
+	fields := [...]int64{
+		intervalFieldYear:   val.Year,
+		intervalFieldMonth:  val.Month,
+		intervalFieldWeek:   val.Week,
+		intervalFieldDay:    val.Day,
+		intervalFieldHour:   val.Hour,
+		intervalFieldMin:    val.Min,
+		intervalFieldSec:    val.Sec,
+		intervalFieldNsec:   val.Nsec,
+		intervalFieldAdjust: adjust,
+	}
+
+	count := int64(0)
+
+	for _, field := range fields {
+		if field != 0 {
+			count++
+		}
+	}
+
+	dst = appendMsgpackInt(dst, count)
+
+	for id, field := range fields {
+		if field != 0 {
+			dst = appendMsgpackInt(dst, int64(id))
+			dst = appendMsgpackInt(dst, field)
+		}
+	}
+
+	return dst, nil
+}
+
+// parseIntervalPayload parses the Tarantool MP_EXT payload of the interval.
+func parseIntervalPayload(payload []byte) (IntervalValue, error) { //nolint:cyclop
+	count, rest, err := readMsgpackInt(payload)
+	if err != nil {
+		return IntervalValue{}, fmt.Errorf("failed to read field count: %w", err)
+	}
+
+	val := IntervalValue{} //nolint:exhaustruct
+	adjust := int64(dtExcess)
+
+	for range count {
+		var id, field int64
+
+		id, rest, err = readMsgpackInt(rest)
+		if err != nil {
+			return IntervalValue{}, fmt.Errorf("failed to read field id: %w", err)
+		}
+
+		field, rest, err = readMsgpackInt(rest)
+		if err != nil {
+			return IntervalValue{}, fmt.Errorf("failed to read field value: %w", err)
+		}
+
+		switch id {
+		case intervalFieldYear:
+			val.Year = field
+		case intervalFieldMonth:
+			val.Month = field
+		case intervalFieldWeek:
+			val.Week = field
+		case intervalFieldDay:
+			val.Day = field
+		case intervalFieldHour:
+			val.Hour = field
+		case intervalFieldMin:
+			val.Min = field
+		case intervalFieldSec:
+			val.Sec = field
+		case intervalFieldNsec:
+			val.Nsec = field
+		case intervalFieldAdjust:
+			adjust = field
+		default:
+			return IntervalValue{}, fmt.Errorf("%w: %d", errIntervalField, id)
+		}
+	}
+
+	if len(rest) != 0 {
+		return IntervalValue{}, errIntervalExtra
+	}
+
+	val.Adjust, err = intervalAdjustFromTarantool(adjust)
+	if err != nil {
+		return IntervalValue{}, err
+	}
+
+	return val, nil
+}
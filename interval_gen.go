@@ -0,0 +1,411 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Interval represents an optional value of type IntervalValue.
+// It can either hold a valid IntervalValue (IsSome == true) or be empty (IsZero == true).
+type Interval struct {
+	value  IntervalValue
+	exists bool
+}
+
+var _ commonInterface[IntervalValue] = (*Interval)(nil)
+
+// SomeInterval creates an optional Interval with the given IntervalValue value.
+// The returned Interval will have IsSome() == true and IsZero() == false.
+func SomeInterval(value IntervalValue) Interval {
+	return Interval{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneInterval creates an empty optional Interval value.
+// The returned Interval will have IsSome() == false and IsZero() == true.
+func NoneInterval() Interval {
+	return Interval{
+		exists: false,
+		value:  zero[IntervalValue](),
+	}
+}
+
+// IsSome returns true if the Interval contains a value.
+// This indicates the value is explicitly set (not None).
+func (o Interval) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the Interval does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o Interval) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o Interval) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of IntervalValue, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o Interval) Get() (IntervalValue, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o Interval) MustGet() IntervalValue {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for IntervalValue.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o Interval) Unwrap() IntervalValue {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Interval) UnwrapOr(defaultValue IntervalValue) IntervalValue {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o Interval) UnwrapOrElse(defaultValue func() IntervalValue) IntervalValue {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Interval) All() iter.Seq[IntervalValue] {
+	return func(yield func(IntervalValue) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Intervals are None, or both contain equal values.
+func (o Interval) Equal(other Interval) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalInterval(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneInterval() is less than any value.
+func (o Interval) Compare(other Interval) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareInterval(o.value, other.value)
+	})
+}
+
+// Filter returns the Interval itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneInterval().
+func (o Interval) Filter(predicate func(IntervalValue) bool) Interval {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneInterval()
+}
+
+// Or returns the Interval itself if it contains a value.
+// Otherwise, returns other.
+func (o Interval) Or(other Interval) Interval {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Interval itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Interval) OrElse(fn func() Interval) Interval {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the Interval value using MessagePack format.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o Interval) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("Interval", encodeInterval(encoder, o.value))
+	}
+
+	return newEncodeError("Interval", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Interval value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Interval) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInterval(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Interval", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Interval value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneInterval)
+//   - IntervalValue: interpreted as a present value (SomeInterval)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on IntervalValue: exists = true, value = decoded value
+func (o *Interval) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Interval", "IntervalValue", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("Interval", "IntervalValue", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val IntervalValue
+
+		val, err = decodeInterval(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Interval", "IntervalValue", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("Interval", "IntervalValue", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as IntervalValue.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Interval) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueInterval(o.value)
+	if err != nil {
+		return nil, newEncodeError("Interval", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneInterval)
+//   - IntervalValue: interpreted as a present value (SomeInterval)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into IntervalValue.
+func (o *Interval) Scan(src any) error {
+	if src == nil {
+		o.value = zero[IntervalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanInterval(src)
+	if err != nil {
+		return newDecodeError("Interval", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Interval value using JSON format.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Interval) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Interval", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Interval value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneInterval)
+//   - IntervalValue: interpreted as a present value (SomeInterval)
+//
+// Returns an error if the input can't be decoded as IntervalValue.
+func (o *Interval) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[IntervalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Interval", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of IntervalValue.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Interval) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInterval(o.value)
+	if err != nil {
+		return nil, newEncodeError("Interval", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneInterval)
+//   - text representation of IntervalValue: interpreted as a present value (SomeInterval)
+//
+// Returns an error if the text can't be parsed as IntervalValue.
+func (o *Interval) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[IntervalValue]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextInterval(text)
+	if err != nil {
+		return newDecodeError("Interval", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Interval.
+// The Interval stays None until the flag is passed, the value is parsed
+// as text representation of IntervalValue. Get of the returned flag.Getter returns the Interval.
+//
+// Example:
+//
+//	var opt option.Interval
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Interval) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextInterval([]byte(text))
+			if err != nil {
+				return newDecodeError("Interval", err)
+			}
+
+			*o = SomeInterval(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
@@ -0,0 +1,661 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestInterval_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.True(t, someInterval.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.False(t, emptyInterval.IsSome())
+	})
+}
+
+func TestInterval_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.False(t, someInterval.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.True(t, emptyInterval.IsZero())
+	})
+}
+
+func TestInterval_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.False(t, someInterval.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.True(t, emptyInterval.IsNil())
+	})
+}
+
+func TestInterval_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		val, ok := someInterval.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		_, ok := emptyInterval.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestInterval_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyInterval.MustGet()
+		})
+	})
+}
+
+func TestInterval_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.NotPanics(t, func() {
+			emptyInterval.Unwrap()
+		})
+	})
+}
+
+func TestInterval_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.UnwrapOr(option.IntervalValue{Day: 7}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.EqualValues(t, option.IntervalValue{Day: 7}, emptyInterval.UnwrapOr(option.IntervalValue{Day: 7}))
+	})
+}
+
+func TestInterval_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.UnwrapOrElse(func() option.IntervalValue {
+			return option.IntervalValue{Day: 7}
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.EqualValues(t, option.IntervalValue{Day: 7}, emptyInterval.UnwrapOrElse(func() option.IntervalValue {
+			return option.IntervalValue{Day: 7}
+		}))
+	})
+}
+
+func TestInterval_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3}).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneInterval().All()))
+	})
+}
+
+func TestInterval_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	otherInterval := option.SomeInterval(option.IntervalValue{Day: 7})
+	emptyInterval := option.NoneInterval()
+
+	assert.True(t, someInterval.Equal(option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})))
+	assert.True(t, emptyInterval.Equal(option.NoneInterval()))
+	assert.False(t, someInterval.Equal(otherInterval))
+	assert.False(t, someInterval.Equal(emptyInterval))
+	assert.False(t, emptyInterval.Equal(someInterval))
+
+	assert.Zero(t, someInterval.Compare(option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})))
+	assert.Zero(t, emptyInterval.Compare(option.NoneInterval()))
+	assert.Equal(t, -otherInterval.Compare(someInterval), someInterval.Compare(otherInterval))
+	assert.NotZero(t, someInterval.Compare(otherInterval))
+	assert.Equal(t, 1, someInterval.Compare(emptyInterval))
+	assert.Equal(t, -1, emptyInterval.Compare(someInterval))
+}
+
+func TestInterval_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.True(t, someInterval.Filter(func(option.IntervalValue) bool { return true }).IsSome())
+		assert.False(t, someInterval.Filter(func(option.IntervalValue) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.False(t, emptyInterval.Filter(func(option.IntervalValue) bool { return true }).IsSome())
+	})
+}
+
+func TestInterval_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		other := option.SomeInterval(option.IntervalValue{Day: 7})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		other := option.SomeInterval(option.IntervalValue{Day: 7})
+		assert.EqualValues(t, option.IntervalValue{Day: 7}, emptyInterval.Or(other).Unwrap())
+	})
+}
+
+func TestInterval_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, someInterval.OrElse(func() option.Interval {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		assert.EqualValues(t, option.IntervalValue{Day: 7}, emptyInterval.OrElse(func() option.Interval {
+			return option.SomeInterval(option.IntervalValue{Day: 7})
+		}).Unwrap())
+	})
+}
+
+func TestInterval_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		err := someInterval.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Interval
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, unmarshaled.Unwrap())
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someInterval := option.SomeInterval(option.IntervalValue{Nsec: 1000, Adjust: option.IntervalAdjustLast})
+		err := someInterval.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Interval
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Nsec: 1000, Adjust: option.IntervalAdjustLast}, unmarshaled.Unwrap())
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someInterval := option.SomeInterval(option.IntervalValue{})
+		err := someInterval.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Interval
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.IntervalValue{}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyInterval := option.NoneInterval()
+		err := emptyInterval.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Interval
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInterval_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		require.NoError(t, someInterval.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInterval.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInterval := option.SomeInterval(option.IntervalValue{Nsec: 1000, Adjust: option.IntervalAdjustLast})
+		require.NoError(t, someInterval.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInterval.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someInterval := option.SomeInterval(option.IntervalValue{})
+		require.NoError(t, someInterval.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someInterval.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneInterval().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestInterval_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		val, err := someInterval.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Interval
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		val, err := emptyInterval.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestInterval_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		data, err := json.Marshal(someInterval)
+		require.NoError(t, err)
+
+		var unmarshaled option.Interval
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		data, err := json.Marshal(emptyInterval)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Interval
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Interval", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInterval_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someInterval := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		data, err := someInterval.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Interval
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyInterval := option.NoneInterval()
+		data, err := emptyInterval.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestInterval_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3}).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Interval
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, option.IntervalValue{Year: 1, Month: -2, Hour: 3}, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Interval
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeInterval() {
+	opt := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: +1 years, -2 months, +3 hours
+}
+
+func ExampleNoneInterval() {
+	opt := option.NoneInterval()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleInterval_IsSome() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleInterval_IsZero() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleInterval_IsNil() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleInterval_Get() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// +1 years, -2 months, +3 hours true
+	// 0 seconds false
+}
+
+func ExampleInterval_MustGet() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	fmt.Println(some.MustGet())
+	// Output: +1 years, -2 months, +3 hours
+}
+
+func ExampleInterval_MustGet_panic() {
+	none := option.NoneInterval()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleInterval_Unwrap() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// +1 years, -2 months, +3 hours
+	// 0 seconds
+}
+
+func ExampleInterval_UnwrapOr() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.UnwrapOr(option.IntervalValue{Day: 7}))
+	fmt.Println(none.UnwrapOr(option.IntervalValue{Day: 7}))
+	// Output:
+	// +1 years, -2 months, +3 hours
+	// +7 days
+}
+
+func ExampleInterval_UnwrapOrElse() {
+	some := option.SomeInterval(option.IntervalValue{Year: 1, Month: -2, Hour: 3})
+	none := option.NoneInterval()
+	fmt.Println(some.UnwrapOrElse(func() option.IntervalValue {
+		return option.IntervalValue{Day: 7}
+	}))
+	fmt.Println(none.UnwrapOrElse(func() option.IntervalValue {
+		return option.IntervalValue{Day: 7}
+	}))
+	// Output:
+	// +1 years, -2 months, +3 hours
+	// +7 days
+}
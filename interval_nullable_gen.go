@@ -0,0 +1,298 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableInterval represents a nullable value of type IntervalValue with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableInterval struct {
+	value IntervalValue
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableInterval]()
+}
+
+// SomeNullableInterval creates a NullableInterval with the given IntervalValue value.
+func SomeNullableInterval(value IntervalValue) NullableInterval {
+	return NullableInterval{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableInterval creates a NullableInterval, that is explicitly set to null.
+func NullNullableInterval() NullableInterval {
+	return NullableInterval{
+		value: zero[IntervalValue](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableInterval creates a NullableInterval, that is not set.
+// It is equal to the zero value of NullableInterval.
+func UnsetNullableInterval() NullableInterval {
+	return NullableInterval{
+		value: zero[IntervalValue](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableInterval contains a value.
+func (o NullableInterval) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableInterval is explicitly set to null.
+func (o NullableInterval) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableInterval is either null or contains a value.
+func (o NullableInterval) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableInterval is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableInterval) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of IntervalValue, false).
+func (o NullableInterval) Get() (IntervalValue, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableInterval) MustGet() IntervalValue {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for IntervalValue.
+func (o NullableInterval) Unwrap() IntervalValue {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableInterval) UnwrapOr(defaultValue IntervalValue) IntervalValue {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableInterval) UnwrapOrElse(defaultValue func() IntervalValue) IntervalValue {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableInterval to Interval. Both Null and Unset are converted to NoneInterval().
+func (o NullableInterval) Option() Interval {
+	if o.state == nullableSome {
+		return SomeInterval(o.value)
+	}
+
+	return NoneInterval()
+}
+
+// Equal returns true if both NullableIntervals have the same state and, if present, equal values.
+func (o NullableInterval) Equal(other NullableInterval) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalInterval(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableInterval) Compare(other NullableInterval) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareInterval(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableInterval value using MessagePack format.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableInterval) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableInterval", encodeInterval(encoder, o.value))
+	}
+
+	return newEncodeError("NullableInterval", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableInterval value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableInterval) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendInterval(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableInterval", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableInterval value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableInterval)
+//   - IntervalValue: interpreted as a present value (SomeNullableInterval)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInterval) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableInterval", "IntervalValue", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[IntervalValue]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableInterval", "IntervalValue", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val IntervalValue
+
+		val, err = decodeInterval(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableInterval", "IntervalValue", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableInterval", "IntervalValue", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableInterval value using JSON format.
+// - If the value is present, it is encoded as IntervalValue.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableInterval) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInterval", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableInterval value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableInterval)
+//   - IntervalValue: interpreted as a present value (SomeNullableInterval)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableInterval) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[IntervalValue]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableInterval", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of IntervalValue.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableInterval) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextInterval(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableInterval", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableInterval)
+//   - text representation of IntervalValue: interpreted as a present value (SomeNullableInterval)
+func (o *NullableInterval) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[IntervalValue]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextInterval(text)
+	if err != nil {
+		return newDecodeError("NullableInterval", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+// intervalFixtures contains intervals encoded by Tarantool.
+var intervalFixtures = []struct {
+	name     string
+	interval option.IntervalValue
+	data     []byte
+}{
+	{
+		name:     "empty",
+		interval: option.IntervalValue{},
+		data:     []byte{0xc7, 0x03, 0x06, 0x01, 0x08, 0x01},
+	},
+	{
+		name:     "year",
+		interval: option.IntervalValue{Year: 1},
+		data:     []byte{0xc7, 0x05, 0x06, 0x02, 0x00, 0x01, 0x08, 0x01},
+	},
+	{
+		name:     "negative month",
+		interval: option.IntervalValue{Month: -2},
+		data:     []byte{0xc7, 0x05, 0x06, 0x02, 0x01, 0xfe, 0x08, 0x01},
+	},
+	{
+		name:     "excess adjust",
+		interval: option.IntervalValue{Hour: 3, Adjust: option.IntervalAdjustExcess},
+		data:     []byte{0xc7, 0x03, 0x06, 0x01, 0x04, 0x03},
+	},
+	{
+		name:     "last adjust",
+		interval: option.IntervalValue{Week: 2, Adjust: option.IntervalAdjustLast},
+		data:     []byte{0xc7, 0x05, 0x06, 0x02, 0x02, 0x02, 0x08, 0x02},
+	},
+	{
+		name: "all fields",
+		interval: option.IntervalValue{
+			Year: 1, Month: 2, Week: 3, Day: 4, Hour: 5, Min: 6, Sec: 7, Nsec: 1000,
+			Adjust: option.IntervalAdjustNone,
+		},
+		data: []byte{
+			0xc7, 0x15, 0x06, 0x09,
+			0x00, 0x01, 0x01, 0x02, 0x02, 0x03, 0x03, 0x04, 0x04, 0x05, 0x05, 0x06, 0x06, 0x07,
+			0x07, 0xcd, 0x03, 0xe8,
+			0x08, 0x01,
+		},
+	},
+}
+
+func TestInterval_EncodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range intervalFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := msgpack.Marshal(option.SomeInterval(fixture.interval))
+			require.NoError(t, err)
+			assert.Equal(t, fixture.data, data)
+		})
+	}
+}
+
+func TestInterval_DecodeFixtures(t *testing.T) {
+	t.Parallel()
+
+	for _, fixture := range intervalFixtures {
+		t.Run(fixture.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Interval
+
+			err := msgpack.Unmarshal(fixture.data, &opt)
+			require.NoError(t, err)
+			assert.Equal(t, option.SomeInterval(fixture.interval), opt)
+		})
+	}
+}
+
+func TestInterval_EncodeInvalidAdjust(t *testing.T) {
+	t.Parallel()
+
+	_, err := msgpack.Marshal(option.SomeInterval(option.IntervalValue{Adjust: 42}))
+
+	var encodeErr option.EncodeError
+	require.ErrorAs(t, err, &encodeErr)
+	assert.Equal(t, "Interval", encodeErr.Type)
+}
+
+func TestInterval_DecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"wrong ext code", []byte{0xc7, 0x03, 0x07, 0x01, 0x08, 0x01}},
+		{"unknown field", []byte{0xc7, 0x03, 0x06, 0x01, 0x09, 0x01}},
+		{"unknown adjust", []byte{0xc7, 0x03, 0x06, 0x01, 0x08, 0x05}},
+		{"truncated", []byte{0xc7, 0x02, 0x06, 0x01, 0x08}},
+		{"trailing bytes", []byte{0xc7, 0x04, 0x06, 0x01, 0x08, 0x01, 0x00}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Interval
+
+			err := msgpack.Unmarshal(tc.data, &opt)
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, "Interval", decodeErr.Type)
+		})
+	}
+}
+
+func TestIntervalValue_String(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0 seconds", option.IntervalValue{}.String())
+	assert.Equal(t, "+1 years, -2 months, +3 hours", option.IntervalValue{Year: 1, Month: -2, Hour: 3}.String())
+}
@@ -0,0 +1,51 @@
+package option
+
+import (
+	"iter"
+)
+
+// Somes returns an iterator over the values of optionals in seq, skipping None ones.
+//
+// Example:
+//
+//	for value := range option.Somes(slices.Values(opts)) {
+//	    fmt.Println(value)
+//	}
+func Somes[T any](seq iter.Seq[Generic[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for opt := range seq {
+			if opt.exists && !yield(opt.value) {
+				return
+			}
+		}
+	}
+}
+
+// CollectAll collects values of optionals in seq into a slice.
+// If any of the optionals is None, the iteration stops and None is returned.
+// An empty seq results in Some of an empty slice.
+func CollectAll[T any](seq iter.Seq[Generic[T]]) Generic[[]T] {
+	values := []T{}
+
+	for opt := range seq {
+		if !opt.exists {
+			return None[[]T]()
+		}
+
+		values = append(values, opt.value)
+	}
+
+	return Some(values)
+}
+
+// FirstSome returns the first optional in seq, that contains a value.
+// The iteration stops at that optional. If there is no such optional, None is returned.
+func FirstSome[T any](seq iter.Seq[Generic[T]]) Generic[T] {
+	for opt := range seq {
+		if opt.exists {
+			return opt
+		}
+	}
+
+	return None[T]()
+}
@@ -0,0 +1,87 @@
+package option_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tarantool/go-option"
+)
+
+// countingSeq returns a sequence of opts and a counter of yielded items.
+func countingSeq[T any](opts ...option.Generic[T]) (iter.Seq[option.Generic[T]], *int) {
+	count := 0
+
+	return func(yield func(option.Generic[T]) bool) {
+		for _, opt := range opts {
+			count++
+
+			if !yield(opt) {
+				return
+			}
+		}
+	}, &count
+}
+
+func TestGeneric_All(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []int{1}, slices.Collect(option.Some(1).All()))
+	assert.Empty(t, slices.Collect(option.None[int]().All()))
+
+	// Break in the loop body is handled.
+	for range option.Some(1).All() {
+		break
+	}
+}
+
+func TestSomes(t *testing.T) {
+	t.Parallel()
+
+	opts := []option.Generic[string]{option.Some("a"), option.None[string](), option.Some(""), option.Some("b")}
+	assert.Equal(t, []string{"a", "", "b"}, slices.Collect(option.Somes(slices.Values(opts))))
+
+	seq, count := countingSeq(opts...)
+	for value := range option.Somes(seq) {
+		assert.Equal(t, "a", value)
+
+		break
+	}
+
+	assert.Equal(t, 1, *count)
+}
+
+func TestCollectAll(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some([]int{1, 2, 3}),
+		option.CollectAll(slices.Values([]option.Generic[int]{option.Some(1), option.Some(2), option.Some(3)})))
+	assert.Equal(t, option.Some([]int{}), option.CollectAll(slices.Values([]option.Generic[int]{})))
+
+	seq, count := countingSeq(option.Some(1), option.None[int](), option.Some(3))
+	assert.Equal(t, option.None[[]int](), option.CollectAll(seq))
+	assert.Equal(t, 2, *count)
+}
+
+func TestFirstSome(t *testing.T) {
+	t.Parallel()
+
+	seq, count := countingSeq(option.None[int](), option.Some(2), option.Some(3))
+	assert.Equal(t, option.Some(2), option.FirstSome(seq))
+	assert.Equal(t, 2, *count)
+
+	assert.Equal(t, option.None[int](), option.FirstSome(slices.Values([]option.Generic[int]{option.None[int]()})))
+}
+
+func ExampleSomes() {
+	row := []option.Generic[int]{option.Some(1), option.None[int](), option.Some(3)}
+	for value := range option.Somes(slices.Values(row)) {
+		fmt.Println(value)
+	}
+	// Output:
+	// 1
+	// 3
+}
@@ -0,0 +1,27 @@
+package option
+
+// This file provides helpers shared by the JSON (un)marshaling methods of generated and generic optional types.
+// None is always represented as JSON null, Some is delegated to encoding/json.
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// jsonNull returns the JSON representation of an empty optional.
+func jsonNull() []byte {
+	return []byte("null")
+}
+
+// isJSONNull checks whether the given JSON document is a null literal.
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+func marshalJSON(val any) ([]byte, error) {
+	return json.Marshal(val) //nolint:wrapcheck
+}
+
+func unmarshalJSON(data []byte, val any) error {
+	return json.Unmarshal(data, val) //nolint:wrapcheck
+}
@@ -0,0 +1,156 @@
+package option
+
+// This file provides decoders of cross-type encodings for DecodeModeLenient: numbers encoded as strings,
+// booleans encoded as 0/1, integral floats and so on. A value of an alternative encoding is decoded with
+// msgpack.Decoder.DecodeInterfaceLoose and converted with the same helpers as values from database/sql
+// drivers, so conversions are checked for range and precision loss in the same way.
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+func isBoolCode(code byte) bool {
+	return code == msgpcode.True || code == msgpcode.False
+}
+
+// decodeLenientSource decodes a value of an alternative encoding. Booleans are converted to 0 and 1,
+// so they could be converted to numbers.
+func decodeLenientSource(decoder *msgpack.Decoder) (any, error) {
+	src, err := decoder.DecodeInterfaceLoose()
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	if val, ok := src.(bool); ok {
+		if val {
+			return int64(1), nil
+		}
+
+		return int64(0), nil
+	}
+
+	return src, nil
+}
+
+// checkNumberLenient reports whether the code starts an alternative encoding of an integer:
+// a string, a boolean or a float.
+func checkNumberLenient(code byte) bool {
+	return msgpcode.IsString(code) || isBoolCode(code) || code == msgpcode.Float || code == msgpcode.Double
+}
+
+func decodeSignedLenient[T signed](decoder *msgpack.Decoder) (T, error) {
+	src, err := decodeLenientSource(decoder)
+	if err != nil {
+		return 0, err
+	}
+
+	return scanSigned[T](src)
+}
+
+func decodeUnsignedLenient[T unsigned](decoder *msgpack.Decoder) (T, error) {
+	src, err := decodeLenientSource(decoder)
+	if err != nil {
+		return 0, err
+	}
+
+	return scanUnsigned[T](src)
+}
+
+func decodeIntLenient(decoder *msgpack.Decoder) (int, error) {
+	return decodeSignedLenient[int](decoder)
+}
+
+func decodeInt8Lenient(decoder *msgpack.Decoder) (int8, error) {
+	return decodeSignedLenient[int8](decoder)
+}
+
+func decodeInt16Lenient(decoder *msgpack.Decoder) (int16, error) {
+	return decodeSignedLenient[int16](decoder)
+}
+
+func decodeInt32Lenient(decoder *msgpack.Decoder) (int32, error) {
+	return decodeSignedLenient[int32](decoder)
+}
+
+func decodeInt64Lenient(decoder *msgpack.Decoder) (int64, error) {
+	return decodeSignedLenient[int64](decoder)
+}
+
+func decodeUintLenient(decoder *msgpack.Decoder) (uint, error) {
+	return decodeUnsignedLenient[uint](decoder)
+}
+
+func decodeUint8Lenient(decoder *msgpack.Decoder) (uint8, error) {
+	return decodeUnsignedLenient[uint8](decoder)
+}
+
+func decodeUint16Lenient(decoder *msgpack.Decoder) (uint16, error) {
+	return decodeUnsignedLenient[uint16](decoder)
+}
+
+func decodeUint32Lenient(decoder *msgpack.Decoder) (uint32, error) {
+	return decodeUnsignedLenient[uint32](decoder)
+}
+
+func decodeUint64Lenient(decoder *msgpack.Decoder) (uint64, error) {
+	return decodeUnsignedLenient[uint64](decoder)
+}
+
+func decodeByteLenient(decoder *msgpack.Decoder) (byte, error) {
+	return decodeUnsignedLenient[byte](decoder)
+}
+
+// checkFloatLenient reports whether the code starts an alternative encoding of a float: a string.
+// Integers are accepted by float types in any mode.
+func checkFloatLenient(code byte) bool {
+	return msgpcode.IsString(code)
+}
+
+func decodeFloatLenient[T float](decoder *msgpack.Decoder) (T, error) {
+	src, err := decodeLenientSource(decoder)
+	if err != nil {
+		return 0, err
+	}
+
+	return scanFloat[T](src)
+}
+
+func decodeFloat32Lenient(decoder *msgpack.Decoder) (float32, error) {
+	return decodeFloatLenient[float32](decoder)
+}
+
+func decodeFloat64Lenient(decoder *msgpack.Decoder) (float64, error) {
+	return decodeFloatLenient[float64](decoder)
+}
+
+// checkStringLenient reports whether the code starts an alternative encoding of a string: a number.
+// Binary strings are accepted by the string type in any mode.
+func checkStringLenient(code byte) bool {
+	return checkFloat(code)
+}
+
+func decodeStringLenient(decoder *msgpack.Decoder) (string, error) {
+	src, err := decodeLenientSource(decoder)
+	if err != nil {
+		return "", err
+	}
+
+	return scanString(src)
+}
+
+// checkBoolLenient reports whether the code starts an alternative encoding of a boolean:
+// an integer or a string.
+func checkBoolLenient(code byte) bool {
+	return checkNumber(code) || msgpcode.IsString(code)
+}
+
+// decodeBoolLenient decodes 0 and 1 as false and true, strings are parsed with strconv.ParseBool.
+func decodeBoolLenient(decoder *msgpack.Decoder) (bool, error) {
+	src, err := decodeLenientSource(decoder)
+	if err != nil {
+		return false, err
+	}
+
+	return scanBool(src)
+}
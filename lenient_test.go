@@ -0,0 +1,114 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func ptrTo[T any](value T) *T {
+	return &value
+}
+
+func TestDecodeModeLenient_CrossType(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    any
+		opt      msgpack.CustomDecoder
+		expected any
+	}{
+		"int from string":       {"42", &option.Int{}, ptrTo(option.SomeInt(42))},
+		"int8 from true":        {true, &option.Int8{}, ptrTo(option.SomeInt8(1))},
+		"uint16 from false":     {false, &option.Uint16{}, ptrTo(option.SomeUint16(0))},
+		"int64 from float":      {float64(-3), &option.Int64{}, ptrTo(option.SomeInt64(-3))},
+		"uint from float32":     {float32(7), &option.Uint{}, ptrTo(option.SomeUint(7))},
+		"byte from string":      {"255", &option.Byte{}, ptrTo(option.SomeByte(255))},
+		"float64 from string":   {"1.5", &option.Float64{}, ptrTo(option.SomeFloat64(1.5))},
+		"float32 from string":   {"-0.25", &option.Float32{}, ptrTo(option.SomeFloat32(-0.25))},
+		"bool from 1":           {1, &option.Bool{}, ptrTo(option.SomeBool(true))},
+		"bool from 0":           {uint64(0), &option.Bool{}, ptrTo(option.SomeBool(false))},
+		"bool from string":      {"true", &option.Bool{}, ptrTo(option.SomeBool(true))},
+		"string from int":       {-12, &option.String{}, ptrTo(option.SomeString("-12"))},
+		"string from uint":      {uint64(1) << 63, &option.String{}, ptrTo(option.SomeString("9223372036854775808"))},
+		"string from float":     {2.5, &option.String{}, ptrTo(option.SomeString("2.5"))},
+		"string from bin":       {[]byte("abc"), &option.String{}, ptrTo(option.SomeString("abc"))},
+		"bytes from string":     {"abc", &option.Bytes{}, ptrTo(option.SomeBytes([]byte("abc")))},
+		"nullable from string":  {"-7", &option.NullableInt32{}, ptrTo(option.SomeNullableInt32(-7))},
+		"native value accepted": {12, &option.Int{}, ptrTo(option.SomeInt(12))},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, decodeLenient(encodeRaw(t, test.value), test.opt))
+			assert.Equal(t, test.expected, test.opt)
+		})
+	}
+}
+
+func TestDecodeModeLenient_CrossTypeErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value any
+		opt   msgpack.CustomDecoder
+	}{
+		"int8 overflow":         {"300", &option.Int8{}},
+		"negative uint":         {"-1", &option.Uint{}},
+		"fractional float":      {3.5, &option.Int{}},
+		"not a number":          {"abc", &option.Int{}},
+		"float from invalid":    {"1,5", &option.Float64{}},
+		"bool from 2":           {2, &option.Bool{}},
+		"bool from invalid":     {"yes", &option.Bool{}},
+		"float from bool":       {true, &option.Float64{}},
+		"string from bool":      {true, &option.String{}},
+		"uint64 from negative":  {-1.0, &option.Uint64{}},
+		"int from array":        {[]int{1}, &option.Int{}},
+		"float32 from overflow": {"1e39", &option.Float32{}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, decodeLenient(encodeRaw(t, test.value), test.opt), &decodeErr)
+		})
+	}
+}
+
+func TestDecodeModeLenient_DefaultRejectsCrossType(t *testing.T) {
+	t.Parallel()
+
+	var opt option.Int
+
+	var decodeErr option.DecodeError
+	require.ErrorAs(t, msgpack.Unmarshal(encodeRaw(t, "42"), &opt), &decodeErr)
+	assert.Equal(t, option.SomeByte(0xa2), decodeErr.Code)
+}
+
+func TestUnmarshalWithMode(t *testing.T) {
+	t.Parallel()
+
+	type row struct {
+		ID     option.Int  `msgpack:"id"`
+		Active option.Bool `msgpack:"active"`
+	}
+
+	data := encodeRaw(t, map[string]any{"id": "17", "active": 1})
+
+	var lenient row
+	require.NoError(t, option.UnmarshalWithMode(data, &lenient, option.DecodeModeLenient))
+	assert.Equal(t, row{ID: option.SomeInt(17), Active: option.SomeBool(true)}, lenient)
+
+	// The mode is used only for the call.
+	var strict row
+	require.Error(t, msgpack.Unmarshal(data, &strict))
+	require.Error(t, option.UnmarshalWithMode(data, &strict, option.DecodeModeDefault))
+}
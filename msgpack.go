@@ -7,6 +7,11 @@ package option
 // as the underlying library requires it for correct encoding.
 
 import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -178,3 +183,190 @@ func encodeAny(encoder *msgpack.Encoder, val any) error {
 func decodeAny(decoder *msgpack.Decoder) (any, error) {
 	return decoder.DecodeInterfaceLoose() //nolint:wrapcheck
 }
+
+func checkExt(code byte) bool {
+	return msgpcode.IsExt(code)
+}
+
+func decodeDecimal(decoder *msgpack.Decoder) (DecimalValue, error) {
+	payload, err := decodeExtPayload(decoder, DecimalExtCode)
+	if err != nil {
+		return DecimalValue{}, err
+	}
+
+	return parseDecimalPayload(payload)
+}
+
+func encodeDecimal(encoder *msgpack.Encoder, val DecimalValue) error {
+	return encodeExtPayload(encoder, DecimalExtCode, appendDecimalPayload(nil, val))
+}
+
+func decodeUUID(decoder *msgpack.Decoder) (uuid.UUID, error) {
+	payload, err := decodeExtPayload(decoder, UUIDExtCode)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return parseUUIDPayload(payload)
+}
+
+func encodeUUID(encoder *msgpack.Encoder, val uuid.UUID) error {
+	return encodeExtPayload(encoder, UUIDExtCode, appendUUIDPayload(nil, val))
+}
+
+func decodeDatetime(decoder *msgpack.Decoder) (time.Time, error) {
+	payload, err := decodeExtPayload(decoder, DatetimeExtCode)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return parseDatetimePayload(payload)
+}
+
+func encodeDatetime(encoder *msgpack.Encoder, val time.Time) error {
+	return encodeExtPayload(encoder, DatetimeExtCode, appendDatetimePayload(nil, val))
+}
+
+func decodeInterval(decoder *msgpack.Decoder) (IntervalValue, error) {
+	payload, err := decodeExtPayload(decoder, IntervalExtCode)
+	if err != nil {
+		return IntervalValue{}, err
+	}
+
+	return parseIntervalPayload(payload)
+}
+
+func encodeInterval(encoder *msgpack.Encoder, val IntervalValue) error {
+	payload, err := appendIntervalPayload(nil, val)
+	if err != nil {
+		return err
+	}
+
+	return encodeExtPayload(encoder, IntervalExtCode, payload)
+}
+
+func decodeTime(decoder *msgpack.Decoder) (time.Time, error) {
+	val, err := decoder.DecodeTime()
+	if err != nil {
+		return time.Time{}, err //nolint:wrapcheck
+	}
+
+	return val.UTC(), nil
+}
+
+func encodeTime(encoder *msgpack.Encoder, val time.Time) error {
+	return encoder.EncodeTime(val) //nolint:wrapcheck
+}
+
+// checkTimeLenient reports whether the code starts an alternative encoding of time:
+// an integer or float Unix epoch, or an RFC3339 string.
+func checkTimeLenient(code byte) bool {
+	return checkFloat(code) || msgpcode.IsString(code)
+}
+
+func decodeTimeLenient(decoder *msgpack.Decoder) (time.Time, error) {
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return time.Time{}, err //nolint:wrapcheck
+	}
+
+	switch {
+	case msgpcode.IsString(code):
+		str, err := decoder.DecodeString()
+		if err != nil {
+			return time.Time{}, err //nolint:wrapcheck
+		}
+
+		return time.Parse(time.RFC3339Nano, str) //nolint:wrapcheck
+	case code == msgpcode.Float || code == msgpcode.Double:
+		epoch, err := decoder.DecodeFloat64()
+		if err != nil {
+			return time.Time{}, err //nolint:wrapcheck
+		}
+
+		sec, frac := math.Modf(epoch)
+
+		return time.Unix(int64(sec), int64(frac*float64(time.Second))).UTC(), nil
+	default:
+		epoch, err := decoder.DecodeInt64()
+		if err != nil {
+			return time.Time{}, err //nolint:wrapcheck
+		}
+
+		return time.Unix(epoch, 0).UTC(), nil
+	}
+}
+
+func decodeDuration(decoder *msgpack.Decoder) (time.Duration, error) {
+	return decoder.DecodeDuration() //nolint:wrapcheck
+}
+
+func encodeDuration(encoder *msgpack.Encoder, val time.Duration) error {
+	return encoder.EncodeInt(int64(val)) //nolint:wrapcheck
+}
+
+// checkDurationLenient reports whether the code starts an alternative encoding of duration:
+// a float number of nanoseconds or a string in time.ParseDuration format.
+func checkDurationLenient(code byte) bool {
+	return code == msgpcode.Float || code == msgpcode.Double || msgpcode.IsString(code)
+}
+
+func decodeDurationLenient(decoder *msgpack.Decoder) (time.Duration, error) {
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	if msgpcode.IsString(code) {
+		str, err := decoder.DecodeString()
+		if err != nil {
+			return 0, err //nolint:wrapcheck
+		}
+
+		return time.ParseDuration(str) //nolint:wrapcheck
+	}
+
+	nsec, err := decoder.DecodeFloat64()
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	if nsec != math.Trunc(nsec) || nsec < math.MinInt64 || nsec >= math.MaxInt64 {
+		return 0, fmt.Errorf("%v is not a valid number of nanoseconds", nsec)
+	}
+
+	return time.Duration(nsec), nil
+}
+
+// decodeExtPayload reads a MessagePack extension header and returns the payload,
+// if extension code matches the expected one.
+func decodeExtPayload(decoder *msgpack.Decoder, expectedCode int8) ([]byte, error) {
+	extCode, length, err := decoder.DecodeExtHeader()
+	switch {
+	case err != nil:
+		return nil, err //nolint:wrapcheck
+	case extCode != expectedCode:
+		return nil, fmt.Errorf("%w: %d, expected: %d", ErrInvalidExtCode, extCode, expectedCode)
+	}
+
+	payload := make([]byte, length)
+
+	err = decoder.ReadFull(payload)
+	if err != nil {
+		return nil, err //nolint:wrapcheck
+	}
+
+	return payload, nil
+}
+
+// encodeExtPayload writes a MessagePack extension header with the given code followed by the payload.
+func encodeExtPayload(encoder *msgpack.Encoder, extCode int8, payload []byte) error {
+	err := encoder.EncodeExtHeader(extCode, len(payload))
+	if err != nil {
+		return err //nolint:wrapcheck
+	}
+
+	_, err = encoder.Writer().Write(payload)
+
+	return err //nolint:wrapcheck
+}
@@ -0,0 +1,328 @@
+package option
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// nullableState is a state of a nullable value.
+type nullableState uint8
+
+const (
+	// nullableUnset is the state of a value, that was not set at all (e.g. a missing field).
+	nullableUnset nullableState = iota
+	// nullableNull is the state of a value, that was explicitly set to null.
+	nullableNull
+	// nullableSome is the state of a value, that contains a value.
+	nullableSome
+)
+
+// compareNullableState compares states of nullable values, Unset < Null < Some.
+func compareNullableState(a, b nullableState) int {
+	return cmp.Compare(a, b)
+}
+
+// Nullable represents a value with three states:
+//   - Unset: the value was not set at all, e.g. the field is missing;
+//   - Null: the value was explicitly set to null (nil);
+//   - Some: the value is present.
+//
+// It is useful for partial updates (PATCH, upsert), where "set to null" and
+// "leave untouched" are different operations. The zero value is Unset.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag, while
+// Null values are encoded as nil (null).
+//
+// Example:
+//
+//	type Patch struct {
+//	    Name option.Nullable[string] `msgpack:"name,omitempty" json:"name,omitzero"`
+//	}
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+// SomeNullable creates a Nullable[T] containing the given value.
+func SomeNullable[T any](value T) Nullable[T] {
+	RegisterNullable[T]()
+
+	return Nullable[T]{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullable creates a Nullable[T], that is explicitly set to null.
+func NullNullable[T any]() Nullable[T] {
+	RegisterNullable[T]()
+
+	return Nullable[T]{state: nullableNull} //nolint:exhaustruct
+}
+
+// UnsetNullable creates a Nullable[T], that is not set. It is equal to the zero value.
+func UnsetNullable[T any]() Nullable[T] {
+	RegisterNullable[T]()
+
+	return Nullable[T]{state: nullableUnset} //nolint:exhaustruct
+}
+
+var errNotAddressable = errors.New("value is not addressable")
+
+// registeredNullables contains types of Nullable[T], registered with RegisterNullable.
+var registeredNullables sync.Map //nolint:gochecknoglobals
+
+// RegisterNullable registers the msgpack decoder for Nullable[T].
+//
+// msgpack does not call DecodeMsgpack of non-pointer values for nil, but resets them
+// to the zero value, which is Unset for Nullable. After the registration nil is decoded
+// as Null.
+//
+// Nullable[T] is registered automatically on first use: by SomeNullable, NullNullable and
+// UnsetNullable and by its msgpack methods. msgpack caches decoders of a structure on its first
+// encoding or decoding, so a structure, that is decoded before any Nullable[T] is used, requires
+// the explicit registration, e.g. in init(). Repeated calls are no-op. Typed variants like
+// NullableInt are registered at startup.
+func RegisterNullable[T any]() {
+	if _, loaded := registeredNullables.LoadOrStore(reflect.TypeFor[T](), struct{}{}); !loaded {
+		registerNullable[Nullable[T]]()
+	}
+}
+
+// registerNullable registers the msgpack decoder, that passes nil to DecodeMsgpack of N.
+func registerNullable[N any, PN interface {
+	*N
+	msgpack.CustomDecoder
+}]() {
+	msgpack.Register(zero[N](), nil, func(decoder *msgpack.Decoder, value reflect.Value) error {
+		if !value.CanAddr() {
+			return newDecodeError(value.Type().Name(), errNotAddressable)
+		}
+
+		ptr, _ := value.Addr().Interface().(*N)
+
+		return PN(ptr).DecodeMsgpack(decoder)
+	})
+}
+
+func getNullableTypeName[T any]() string {
+	return fmt.Sprintf("Nullable[%T]", zero[T]())
+}
+
+// IsSome returns true if the Nullable contains a value.
+func (o Nullable[T]) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the Nullable is explicitly set to null.
+func (o Nullable[T]) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the Nullable is either null or contains a value.
+func (o Nullable[T]) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the Nullable is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o Nullable[T]) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the contained value and a boolean indicating whether the value exists.
+// The first return value is the zero value of T for Null and Unset values.
+func (o Nullable[T]) Get() (T, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the contained value if present.
+//
+// Panics with ErrNone if the Nullable is Null or Unset.
+func (o Nullable[T]) MustGet() T {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for T.
+func (o Nullable[T]) Unwrap() T {
+	return o.value
+}
+
+// UnwrapOr returns the contained value if present, otherwise returns the provided default value.
+func (o Nullable[T]) UnwrapOr(defaultValue T) T {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the contained value if present, otherwise calls the provided function
+// to compute a default value.
+func (o Nullable[T]) UnwrapOrElse(defaultValueFunc func() T) T {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValueFunc()
+}
+
+// Option converts the Nullable to Generic[T]. Both Null and Unset are converted to None.
+func (o Nullable[T]) Option() Generic[T] {
+	if o.state == nullableSome {
+		return Some(o.value)
+	}
+
+	return None[T]()
+}
+
+// EncodeMsgpack implements the msgpack.CustomEncoder interface.
+//
+// Both Null and Unset values are encoded as MessagePack nil. Use the `omitempty`
+// tag to skip Unset values. The contained value is encoded the same way as by Generic[T].
+func (o Nullable[T]) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	RegisterNullable[T]()
+
+	if o.state != nullableSome {
+		return newEncodeError(getNullableTypeName[T](), encoder.EncodeNil())
+	}
+
+	if codec := getGenericCodec[T](); codec != nil {
+		return newEncodeError(getNullableTypeName[T](), codec.encode(encoder, o.value))
+	}
+
+	return newEncodeError(getNullableTypeName[T](), encodeGenericSlow(encoder, o.value))
+}
+
+// AppendMsgpack implements the AppendMarshaler interface.
+//
+// It appends the same bytes, as EncodeMsgpack writes with the default msgpack.Encoder options,
+// to dst and returns the extended buffer. See Generic[T].AppendMsgpack for details.
+func (o Nullable[T]) AppendMsgpack(dst []byte) ([]byte, error) {
+	RegisterNullable[T]()
+
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendGeneric(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError(getNullableTypeName[T](), err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack implements the msgpack.CustomDecoder interface.
+//
+//   - If the encoded value is nil, the Nullable is set to Null.
+//   - Otherwise, it decodes into the internal value and the Nullable contains a value.
+//
+// A missing field is not decoded at all, so it stays Unset.
+// In DecodeModeStrict numbers are decoded in the same way as by Generic[T].
+//
+// nil is decoded into fields of Nullable[T] as Null, if Nullable[T] is registered, see RegisterNullable.
+func (o *Nullable[T]) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	RegisterNullable[T]()
+
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	switch {
+	case err != nil:
+		return newDecodeMsgpackError(getNullableTypeName[T](), getTypeName[T](), offset, err)
+	case code == msgpcode.Nil:
+		o.value = zero[T]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError(getNullableTypeName[T](), getTypeName[T](), offset, decoder.Skip())
+	}
+
+	if getDecodeMode(decoder) == DecodeModeStrict {
+		// The previous value is restored, so the optional is not changed on errors.
+		value := o.value
+
+		ok, err := decodeGenericStrict(decoder, code, &o.value)
+		if err != nil {
+			o.value = value
+
+			return newDecodeStrictError(getNullableTypeName[T](), getTypeName[T](), offset, code, err)
+		}
+
+		if ok {
+			o.state = nullableSome
+
+			return nil
+		}
+	}
+
+	var value T
+
+	if codec := getGenericCodec[T](); codec != nil {
+		value, err = codec.decode(decoder)
+	} else {
+		value, err = decodeGenericSlow(decoder, o.value)
+	}
+
+	if err != nil {
+		return newDecodeMsgpackError(getNullableTypeName[T](), getTypeName[T](), offset, err)
+	}
+
+	o.value = value
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// Both Null and Unset values are encoded as JSON null. Use the `omitzero` tag
+// to skip Unset values.
+func (o Nullable[T]) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError(getNullableTypeName[T](), err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// JSON null sets the Nullable to Null, any other value is decoded into T.
+// A missing field is not decoded at all, so it stays Unset.
+func (o *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[T]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError(getNullableTypeName[T](), err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package option_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+type nullableName struct {
+	First string
+	Last  string
+}
+
+//nolint:gochecknoinits // Registration must precede the first decoding.
+func init() {
+	option.RegisterNullable[nullableName]()
+}
+
+type nullablePatch struct {
+	Name  option.Nullable[nullableName] `json:"name,omitzero"  msgpack:"name,omitempty"`
+	Email option.NullableString         `json:"email,omitzero" msgpack:"email,omitempty"`
+}
+
+func TestNullable_State(t *testing.T) {
+	t.Parallel()
+
+	some := option.SomeNullable(nullableName{First: "John", Last: "Doe"})
+	assert.True(t, some.IsSome())
+	assert.False(t, some.IsNull())
+	assert.True(t, some.IsSet())
+	assert.False(t, some.IsZero())
+	assert.Equal(t, "John", some.MustGet().First)
+	assert.True(t, some.Option().IsSome())
+
+	null := option.NullNullable[nullableName]()
+	assert.False(t, null.IsSome())
+	assert.True(t, null.IsNull())
+	assert.True(t, null.IsSet())
+	assert.False(t, null.IsZero())
+	assert.Panics(t, func() { null.MustGet() })
+	assert.False(t, null.Option().IsSome())
+
+	unset := option.UnsetNullable[nullableName]()
+	assert.Equal(t, option.Nullable[nullableName]{}, unset)
+	assert.False(t, unset.IsSome())
+	assert.False(t, unset.IsNull())
+	assert.False(t, unset.IsSet())
+	assert.True(t, unset.IsZero())
+	assert.Equal(t, "Jane", unset.UnwrapOr(nullableName{First: "Jane", Last: ""}).First)
+}
+
+func TestNullable_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		patch nullablePatch
+		size  int
+	}{
+		{"unset", nullablePatch{}, 0},
+		{"null", nullablePatch{Name: option.NullNullable[nullableName](), Email: option.NullNullableString()}, 2},
+		{"some", nullablePatch{
+			Name:  option.SomeNullable(nullableName{First: "John", Last: "Doe"}),
+			Email: option.SomeNullableString("john@example.com"),
+		}, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := msgpack.Marshal(tc.patch)
+			require.NoError(t, err)
+
+			var fields map[string]any
+			require.NoError(t, msgpack.Unmarshal(data, &fields))
+			assert.Len(t, fields, tc.size)
+
+			var unmarshaled nullablePatch
+			require.NoError(t, msgpack.Unmarshal(data, &unmarshaled))
+			assert.Equal(t, tc.patch, unmarshaled)
+		})
+	}
+}
+
+func TestNullable_DecodeMsgpackNil(t *testing.T) {
+	t.Parallel()
+
+	data := encodeRaw(t, nil)
+
+	// SomeNullable registers Nullable[int] on first use, so nil is decoded as Null.
+	unregistered := option.SomeNullable(1)
+	require.NoError(t, msgpack.Unmarshal(data, &unregistered))
+	assert.True(t, unregistered.IsNull())
+
+	// Direct DecodeMsgpack call is not affected.
+	direct := option.SomeNullable(1)
+	require.NoError(t, direct.DecodeMsgpack(msgpack.NewDecoder(bytes.NewReader(data))))
+	assert.True(t, direct.IsNull())
+}
+
+func TestNullable_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		patch    nullablePatch
+		expected string
+	}{
+		{"unset", nullablePatch{}, `{}`},
+		{"null", nullablePatch{
+			Name:  option.NullNullable[nullableName](),
+			Email: option.NullNullableString(),
+		}, `{"name":null,"email":null}`},
+		{"some", nullablePatch{
+			Name:  option.SomeNullable(nullableName{First: "John", Last: "Doe"}),
+			Email: option.SomeNullableString("john@example.com"),
+		}, `{"name":{"First":"John","Last":"Doe"},"email":"john@example.com"}`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(tc.patch)
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(data))
+
+			var unmarshaled nullablePatch
+			require.NoError(t, json.Unmarshal(data, &unmarshaled))
+			assert.Equal(t, tc.patch, unmarshaled)
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Nullable[nullableName]
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, unmarshaled.UnmarshalJSON([]byte(`"name"`)), &decodeErr)
+		assert.Equal(t, "Nullable[option_test.nullableName]", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSet())
+	})
+}
+
+// nullableUnregistered is not registered with RegisterNullable.
+type nullableUnregistered struct {
+	Value int
+}
+
+type nullableUnregisteredPatch struct {
+	Field option.Nullable[nullableUnregistered] `msgpack:"field,omitempty"`
+}
+
+func TestNullable_RegisterOnFirstUse(t *testing.T) {
+	t.Parallel()
+
+	// The first use registers the decoder before the structure is decoded.
+	expected := nullableUnregisteredPatch{Field: option.NullNullable[nullableUnregistered]()}
+
+	data, err := msgpack.Marshal(map[string]any{"field": nil})
+	require.NoError(t, err)
+
+	var patch nullableUnregisteredPatch
+	require.NoError(t, msgpack.Unmarshal(data, &patch))
+	assert.Equal(t, expected, patch)
+	assert.True(t, patch.Field.IsNull())
+
+	// A missing field stays Unset.
+	data, err = msgpack.Marshal(map[string]any{})
+	require.NoError(t, err)
+
+	patch = nullableUnregisteredPatch{}
+	require.NoError(t, msgpack.Unmarshal(data, &patch))
+	assert.False(t, patch.Field.IsSet())
+}
@@ -0,0 +1,550 @@
+package option
+
+// This file provides utility functions for scanning and valuing basic types used in database/sql integration.
+// Scan helpers accept every representation a driver may hand back (all integer widths, floats, strings and
+// byte slices) and verify that the value fits into the destination type. Value helpers convert the contained
+// value into one of the types allowed by driver.Value.
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errSQLUnsupportedType = errors.New("unsupported source type")
+)
+
+type signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+type unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type float interface {
+	~float32 | ~float64
+}
+
+func newSQLUnsupportedTypeError(src any) error {
+	return fmt.Errorf("%w: %T", errSQLUnsupportedType, src)
+}
+
+// convertToInt64 converts a value returned by a driver to int64.
+func convertToInt64(src any) (int64, error) { //nolint:cyclop
+	switch val := src.(type) {
+	case int64:
+		return val, nil
+	case int:
+		return int64(val), nil
+	case int8:
+		return int64(val), nil
+	case int16:
+		return int64(val), nil
+	case int32:
+		return int64(val), nil
+	case uint:
+		return convertUint64ToInt64(uint64(val))
+	case uint8:
+		return int64(val), nil
+	case uint16:
+		return int64(val), nil
+	case uint32:
+		return int64(val), nil
+	case uint64:
+		return convertUint64ToInt64(val)
+	case float32:
+		return convertFloat64ToInt64(float64(val))
+	case float64:
+		return convertFloat64ToInt64(val)
+	case []byte:
+		return strconv.ParseInt(string(val), 10, 64) //nolint:wrapcheck
+	case string:
+		return strconv.ParseInt(val, 10, 64) //nolint:wrapcheck
+	default:
+		return 0, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func convertUint64ToInt64(val uint64) (int64, error) {
+	if val > math.MaxInt64 {
+		return 0, newOverflowError[int64](val)
+	}
+
+	return int64(val), nil
+}
+
+func convertFloat64ToInt64(val float64) (int64, error) {
+	// 2^63 is exactly representable as float64, while math.MaxInt64 is not.
+	if val != math.Trunc(val) || val < math.MinInt64 || val >= -math.MinInt64 {
+		return 0, newOverflowError[int64](val)
+	}
+
+	return int64(val), nil
+}
+
+// convertToUint64 converts a value returned by a driver to uint64.
+func convertToUint64(src any) (uint64, error) { //nolint:cyclop
+	switch val := src.(type) {
+	case uint64:
+		return val, nil
+	case uint:
+		return uint64(val), nil
+	case uint8:
+		return uint64(val), nil
+	case uint16:
+		return uint64(val), nil
+	case uint32:
+		return uint64(val), nil
+	case int, int8, int16, int32, int64, float32, float64:
+		signedVal, err := convertToInt64(val)
+		switch {
+		case err != nil:
+			return 0, err
+		case signedVal < 0:
+			return 0, newOverflowError[uint64](src)
+		}
+
+		return uint64(signedVal), nil
+	case []byte:
+		return strconv.ParseUint(string(val), 10, 64) //nolint:wrapcheck
+	case string:
+		return strconv.ParseUint(val, 10, 64) //nolint:wrapcheck
+	default:
+		return 0, newSQLUnsupportedTypeError(src)
+	}
+}
+
+// convertToFloat64 converts a value returned by a driver to float64.
+func convertToFloat64(src any) (float64, error) {
+	switch val := src.(type) {
+	case float64:
+		return val, nil
+	case float32:
+		return float64(val), nil
+	case int, int8, int16, int32, int64:
+		signedVal, err := convertToInt64(val)
+
+		return float64(signedVal), err
+	case uint, uint8, uint16, uint32, uint64:
+		unsignedVal, err := convertToUint64(val)
+
+		return float64(unsignedVal), err
+	case []byte:
+		return strconv.ParseFloat(string(val), 64) //nolint:wrapcheck
+	case string:
+		return strconv.ParseFloat(val, 64) //nolint:wrapcheck
+	default:
+		return 0, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func scanSigned[T signed](src any) (T, error) {
+	val, err := convertToInt64(src)
+	if err != nil {
+		return 0, err
+	}
+
+	if int64(T(val)) != val {
+		return 0, newOverflowError[T](src)
+	}
+
+	return T(val), nil
+}
+
+func scanUnsigned[T unsigned](src any) (T, error) {
+	val, err := convertToUint64(src)
+	if err != nil {
+		return 0, err
+	}
+
+	if uint64(T(val)) != val {
+		return 0, newOverflowError[T](src)
+	}
+
+	return T(val), nil
+}
+
+func scanFloat[T float](src any) (T, error) {
+	val, err := convertToFloat64(src)
+	if err != nil {
+		return 0, err
+	}
+
+	if math.IsInf(float64(T(val)), 0) && !math.IsInf(val, 0) {
+		return 0, newOverflowError[T](src)
+	}
+
+	return T(val), nil
+}
+
+func scanInt(src any) (int, error) {
+	return scanSigned[int](src)
+}
+
+func scanInt8(src any) (int8, error) {
+	return scanSigned[int8](src)
+}
+
+func scanInt16(src any) (int16, error) {
+	return scanSigned[int16](src)
+}
+
+func scanInt32(src any) (int32, error) {
+	return scanSigned[int32](src)
+}
+
+func scanInt64(src any) (int64, error) {
+	return scanSigned[int64](src)
+}
+
+func scanUint(src any) (uint, error) {
+	return scanUnsigned[uint](src)
+}
+
+func scanUint8(src any) (uint8, error) {
+	return scanUnsigned[uint8](src)
+}
+
+func scanUint16(src any) (uint16, error) {
+	return scanUnsigned[uint16](src)
+}
+
+func scanUint32(src any) (uint32, error) {
+	return scanUnsigned[uint32](src)
+}
+
+func scanUint64(src any) (uint64, error) {
+	return scanUnsigned[uint64](src)
+}
+
+func scanByte(src any) (byte, error) {
+	return scanUnsigned[byte](src)
+}
+
+func scanFloat32(src any) (float32, error) {
+	return scanFloat[float32](src)
+}
+
+func scanFloat64(src any) (float64, error) {
+	return scanFloat[float64](src)
+}
+
+func scanString(src any) (string, error) {
+	switch val := src.(type) {
+	case string:
+		return val, nil
+	case []byte:
+		return string(val), nil
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case uint64:
+		return strconv.FormatUint(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	case time.Time:
+		return val.Format(time.RFC3339Nano), nil
+	default:
+		return "", newSQLUnsupportedTypeError(src)
+	}
+}
+
+func scanBytes(src any) ([]byte, error) {
+	switch val := src.(type) {
+	case []byte:
+		// The driver may reuse the buffer on the next call to Rows.Next, so make a copy.
+		return append([]byte{}, val...), nil
+	case string:
+		return []byte(val), nil
+	default:
+		return nil, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func scanBool(src any) (bool, error) {
+	switch val := src.(type) {
+	case bool:
+		return val, nil
+	case []byte:
+		return strconv.ParseBool(string(val)) //nolint:wrapcheck
+	case string:
+		return strconv.ParseBool(val) //nolint:wrapcheck
+	default:
+		num, err := convertToInt64(src)
+		switch {
+		case err != nil:
+			return false, err
+		case num != 0 && num != 1:
+			return false, newOverflowError[bool](src)
+		}
+
+		return num == 1, nil
+	}
+}
+
+func scanAny(src any) (any, error) {
+	if val, ok := src.([]byte); ok {
+		return append([]byte{}, val...), nil
+	}
+
+	return src, nil
+}
+
+func valueSigned[T signed](val T) (driver.Value, error) {
+	return int64(val), nil
+}
+
+func valueUnsigned[T unsigned](val T) (driver.Value, error) {
+	if uint64(val) > math.MaxInt64 {
+		return nil, newOverflowError[int64](val)
+	}
+
+	return int64(val), nil
+}
+
+func valueInt(val int) (driver.Value, error) {
+	return valueSigned(val)
+}
+
+func valueInt8(val int8) (driver.Value, error) {
+	return valueSigned(val)
+}
+
+func valueInt16(val int16) (driver.Value, error) {
+	return valueSigned(val)
+}
+
+func valueInt32(val int32) (driver.Value, error) {
+	return valueSigned(val)
+}
+
+func valueInt64(val int64) (driver.Value, error) {
+	return valueSigned(val)
+}
+
+func valueUint(val uint) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueUint8(val uint8) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueUint16(val uint16) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueUint32(val uint32) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueUint64(val uint64) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueByte(val byte) (driver.Value, error) {
+	return valueUnsigned(val)
+}
+
+func valueFloat32(val float32) (driver.Value, error) {
+	return float64(val), nil
+}
+
+func valueFloat64(val float64) (driver.Value, error) {
+	return val, nil
+}
+
+func valueString(val string) (driver.Value, error) {
+	return val, nil
+}
+
+func valueBytes(val []byte) (driver.Value, error) {
+	return val, nil
+}
+
+func valueBool(val bool) (driver.Value, error) {
+	return val, nil
+}
+
+func valueAny(val any) (driver.Value, error) {
+	return driver.DefaultParameterConverter.ConvertValue(val) //nolint:wrapcheck
+}
+
+// scanGeneric scans src into dst, trying (in order) sql.Scanner implementation of dst,
+// the typed scan helpers for basic types, direct assignment and reflection-based conversion.
+func scanGeneric[T any](dst *T, src any) error { //nolint:cyclop
+	var err error
+
+	switch typedDst := any(dst).(type) {
+	case sql.Scanner:
+		return typedDst.Scan(src) //nolint:wrapcheck
+	case *int:
+		*typedDst, err = scanInt(src)
+	case *int8:
+		*typedDst, err = scanInt8(src)
+	case *int16:
+		*typedDst, err = scanInt16(src)
+	case *int32:
+		*typedDst, err = scanInt32(src)
+	case *int64:
+		*typedDst, err = scanInt64(src)
+	case *uint:
+		*typedDst, err = scanUint(src)
+	case *uint8:
+		*typedDst, err = scanUint8(src)
+	case *uint16:
+		*typedDst, err = scanUint16(src)
+	case *uint32:
+		*typedDst, err = scanUint32(src)
+	case *uint64:
+		*typedDst, err = scanUint64(src)
+	case *float32:
+		*typedDst, err = scanFloat32(src)
+	case *float64:
+		*typedDst, err = scanFloat64(src)
+	case *string:
+		*typedDst, err = scanString(src)
+	case *[]byte:
+		*typedDst, err = scanBytes(src)
+	case *bool:
+		*typedDst, err = scanBool(src)
+	case *any:
+		*typedDst, err = scanAny(src)
+	default:
+		if val, ok := src.(T); ok {
+			*dst = val
+
+			return nil
+		}
+
+		srcValue := reflect.ValueOf(src)
+		dstValue := reflect.ValueOf(dst).Elem()
+
+		if srcValue.Kind() != dstValue.Kind() || !srcValue.CanConvert(dstValue.Type()) {
+			return newSQLUnsupportedTypeError(src)
+		}
+
+		dstValue.Set(srcValue.Convert(dstValue.Type()))
+	}
+
+	return err
+}
+
+func scanDecimal(src any) (DecimalValue, error) {
+	switch val := src.(type) {
+	case string:
+		return ParseDecimalValue(val)
+	case []byte:
+		return ParseDecimalValue(string(val))
+	case float32:
+		return ParseDecimalValue(strconv.FormatFloat(float64(val), 'f', -1, 32))
+	case float64:
+		return ParseDecimalValue(strconv.FormatFloat(val, 'f', -1, 64))
+	case int, int8, int16, int32, int64:
+		num, err := convertToInt64(val)
+		if err != nil {
+			return DecimalValue{}, err
+		}
+
+		return ParseDecimalValue(strconv.FormatInt(num, decimalStringBase))
+	case uint, uint8, uint16, uint32, uint64:
+		num, err := convertToUint64(val)
+		if err != nil {
+			return DecimalValue{}, err
+		}
+
+		return ParseDecimalValue(strconv.FormatUint(num, decimalStringBase))
+	default:
+		return DecimalValue{}, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func valueDecimal(val DecimalValue) (driver.Value, error) {
+	return val.String(), nil
+}
+
+func scanUUID(src any) (uuid.UUID, error) {
+	switch val := src.(type) {
+	case string:
+		return uuid.Parse(val) //nolint:wrapcheck
+	case []byte:
+		if len(val) == len(uuid.Nil) {
+			return uuid.FromBytes(val) //nolint:wrapcheck
+		}
+
+		return uuid.ParseBytes(val) //nolint:wrapcheck
+	default:
+		return uuid.Nil, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func valueUUID(val uuid.UUID) (driver.Value, error) {
+	return val.String(), nil
+}
+
+func scanDatetime(src any) (time.Time, error) {
+	switch val := src.(type) {
+	case time.Time:
+		return val, nil
+	case string:
+		return time.Parse(time.RFC3339Nano, val) //nolint:wrapcheck
+	case []byte:
+		return time.Parse(time.RFC3339Nano, string(val)) //nolint:wrapcheck
+	default:
+		return time.Time{}, newSQLUnsupportedTypeError(src)
+	}
+}
+
+func valueDatetime(val time.Time) (driver.Value, error) {
+	return val, nil
+}
+
+// scanInterval scans the interval from its JSON representation, that is produced by valueInterval.
+func scanInterval(src any) (IntervalValue, error) {
+	var val IntervalValue
+
+	switch typedSrc := src.(type) {
+	case string:
+		return val, unmarshalJSON([]byte(typedSrc), &val)
+	case []byte:
+		return val, unmarshalJSON(typedSrc, &val)
+	default:
+		return val, newSQLUnsupportedTypeError(src)
+	}
+}
+
+// valueInterval represents the interval as JSON text, since there's no common SQL interval type.
+func valueInterval(val IntervalValue) (driver.Value, error) {
+	data, err := marshalJSON(val)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+// scanDuration scans the duration from a number of nanoseconds or from a string in time.ParseDuration format.
+func scanDuration(src any) (time.Duration, error) {
+	switch val := src.(type) {
+	case string:
+		return time.ParseDuration(val) //nolint:wrapcheck
+	case []byte:
+		return time.ParseDuration(string(val)) //nolint:wrapcheck
+	default:
+		return scanSigned[time.Duration](src)
+	}
+}
+
+func valueDuration(val time.Duration) (driver.Value, error) {
+	return int64(val), nil
+}
@@ -0,0 +1,261 @@
+package option_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tarantool/go-option"
+)
+
+// echoDriver is an in-process database/sql driver, that returns query arguments back as a single row.
+// Query text is ignored. It allows to check both driver.Valuer and sql.Scanner implementations
+// without a real database.
+type echoDriver struct{}
+
+func (echoDriver) Open(string) (driver.Conn, error) {
+	return echoConn{}, nil
+}
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) {
+	return echoStmt{}, nil
+}
+
+func (echoConn) Close() error {
+	return nil
+}
+
+func (echoConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error {
+	return nil
+}
+
+func (echoStmt) NumInput() int {
+	return -1
+}
+
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) {
+	return driver.ResultNoRows, nil
+}
+
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args, done: false}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string {
+	return make([]string, len(r.values))
+}
+
+func (r *echoRows) Close() error {
+	return nil
+}
+
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	copy(dest, r.values)
+	r.done = true
+
+	return nil
+}
+
+func init() { //nolint:gochecknoinits
+	sql.Register("option-echo", echoDriver{})
+}
+
+func openEchoDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("option-echo", "")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return db
+}
+
+func TestSQL_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	db := openEchoDB(t)
+
+	var (
+		someInt     option.Int64
+		noneInt     = option.SomeInt64(1)
+		someString  option.String
+		noneString  = option.SomeString("overwritten")
+		someGeneric option.Generic[uint16]
+		noneGeneric = option.Some(1.5)
+	)
+
+	err := db.QueryRowContext(context.Background(), "echo",
+		option.SomeInt64(42), option.NoneInt64(),
+		option.SomeString("hello"), option.NoneString(),
+		option.Some[uint16](7), option.None[float64](),
+	).Scan(&someInt, &noneInt, &someString, &noneString, &someGeneric, &noneGeneric)
+	require.NoError(t, err)
+
+	assert.Equal(t, option.SomeInt64(42), someInt)
+	assert.True(t, noneInt.IsZero())
+	assert.Equal(t, option.SomeString("hello"), someString)
+	assert.True(t, noneString.IsZero())
+	assert.Equal(t, option.Some[uint16](7), someGeneric)
+	assert.True(t, noneGeneric.IsZero())
+}
+
+func TestSQL_ScanWidths(t *testing.T) {
+	t.Parallel()
+
+	for _, src := range []any{
+		int(12), int8(12), int16(12), int32(12), int64(12),
+		uint(12), uint8(12), uint16(12), uint32(12), uint64(12),
+		float32(12), float64(12), []byte("12"), "12",
+	} {
+		var (
+			i8  option.Int8
+			u64 option.Uint64
+			f32 option.Float32
+			gen option.Generic[int]
+		)
+
+		require.NoError(t, i8.Scan(src))
+		assert.Equal(t, option.SomeInt8(12), i8)
+
+		require.NoError(t, u64.Scan(src))
+		assert.Equal(t, option.SomeUint64(12), u64)
+
+		require.NoError(t, f32.Scan(src))
+		assert.Equal(t, option.SomeFloat32(12), f32)
+
+		require.NoError(t, gen.Scan(src))
+		assert.Equal(t, option.Some(12), gen)
+	}
+}
+
+func TestSQL_ScanOverflow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		typ     string
+		scanner sql.Scanner
+		src     any
+	}{
+		{"int8 from int64", "Int8", new(option.Int8), int64(300)},
+		{"int8 from negative", "Int8", new(option.Int8), int64(-129)},
+		{"uint8 from negative", "Uint8", new(option.Uint8), int64(-1)},
+		{"uint32 from uint64", "Uint32", new(option.Uint32), uint64(math.MaxUint32 + 1)},
+		{"int64 from uint64", "Int64", new(option.Int64), uint64(math.MaxUint64)},
+		{"int from fraction", "Int", new(option.Int), 1.5},
+		{"float32 from float64", "Float32", new(option.Float32), math.MaxFloat64},
+		{"bool from int", "Bool", new(option.Bool), int64(2)},
+		{"generic int8", "Generic[int8]", new(option.Generic[int8]), int64(128)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.scanner.Scan(tc.src)
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, tc.typ, decodeErr.Type)
+		})
+	}
+}
+
+func TestSQL_ScanUnsupported(t *testing.T) {
+	t.Parallel()
+
+	var opt option.Int
+
+	err := opt.Scan(true)
+
+	var decodeErr option.DecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, "Int", decodeErr.Type)
+	assert.True(t, opt.IsZero())
+}
+
+func TestSQL_ScanErrorKeepsValue(t *testing.T) {
+	t.Parallel()
+
+	opt := option.Some(5)
+	require.Error(t, opt.Scan("abc"))
+	assert.Equal(t, option.Some(5), opt)
+
+	i8 := option.SomeInt8(5)
+	require.Error(t, i8.Scan(int64(300)))
+	assert.Equal(t, option.SomeInt8(5), i8)
+}
+
+func TestSQL_ValueOverflow(t *testing.T) {
+	t.Parallel()
+
+	_, err := option.SomeUint64(math.MaxUint64).Value()
+
+	var encodeErr option.EncodeError
+	require.ErrorAs(t, err, &encodeErr)
+	assert.Equal(t, "Uint64", encodeErr.Type)
+
+	_, err = option.Some[uint64](math.MaxUint64).Value()
+	require.ErrorAs(t, err, &encodeErr)
+	assert.Equal(t, "Generic[uint64]", encodeErr.Type)
+}
+
+type sqlCustomType struct {
+	Data string
+}
+
+func (c *sqlCustomType) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("unexpected type")
+	}
+
+	c.Data = s[7:] // Strip "custom:".
+
+	return nil
+}
+
+func (c sqlCustomType) Value() (driver.Value, error) { //nolint:unparam
+	return "custom:" + c.Data, nil
+}
+
+func TestSQL_GenericCustomScannerValuer(t *testing.T) {
+	t.Parallel()
+
+	db := openEchoDB(t)
+
+	var out option.Generic[sqlCustomType]
+
+	err := db.QueryRowContext(context.Background(), "echo", option.Some(sqlCustomType{Data: "test"})).Scan(&out)
+	require.NoError(t, err)
+
+	assert.True(t, out.IsSome())
+	assert.Equal(t, "test", out.Unwrap().Data)
+}
@@ -0,0 +1,219 @@
+package option
+
+// This file provides decoders of numeric types for DecodeModeStrict. Unlike the default decoders,
+// that rely on narrowing conversions of the msgpack library, they read the number as it is encoded
+// and reject values, that can't be represented by the destination type exactly.
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// inexactValueError is returned by strict decoders, when the decoded value doesn't fit into
+// the destination type. It wraps ErrOverflow.
+type inexactValueError struct {
+	value  any
+	target string
+}
+
+func newInexactValueError[T any](value any) error {
+	return inexactValueError{value: value, target: fmt.Sprintf("%T", zero[T]())}
+}
+
+// Error returns the text representation of error.
+func (e inexactValueError) Error() string {
+	return fmt.Sprintf("value %v can't be represented as %s exactly", e.value, e.target)
+}
+
+// Unwrap returns ErrOverflow.
+func (e inexactValueError) Unwrap() error {
+	return ErrOverflow
+}
+
+// isUnsignedCode checks whether the code starts a non-negative MessagePack integer.
+func isUnsignedCode(code byte) bool {
+	switch code {
+	case msgpcode.Uint8, msgpcode.Uint16, msgpcode.Uint32, msgpcode.Uint64:
+		return true
+	default:
+		return code <= msgpcode.PosFixedNumHigh
+	}
+}
+
+func decodeSignedStrict[T signed](decoder *msgpack.Decoder, code byte) (T, error) {
+	if isUnsignedCode(code) {
+		val, err := decoder.DecodeUint64()
+		switch {
+		case err != nil:
+			return 0, err //nolint:wrapcheck
+		case T(val) < 0 || uint64(T(val)) != val: //nolint:gosec
+			return 0, newInexactValueError[T](val)
+		}
+
+		return T(val), nil //nolint:gosec
+	}
+
+	val, err := decoder.DecodeInt64()
+	switch {
+	case err != nil:
+		return 0, err //nolint:wrapcheck
+	case int64(T(val)) != val:
+		return 0, newInexactValueError[T](val)
+	}
+
+	return T(val), nil
+}
+
+func decodeUnsignedStrict[T unsigned](decoder *msgpack.Decoder, code byte) (T, error) {
+	if !isUnsignedCode(code) {
+		val, err := decoder.DecodeInt64()
+		switch {
+		case err != nil:
+			return 0, err //nolint:wrapcheck
+		case val < 0 || int64(T(val)) != val: //nolint:gosec
+			return 0, newInexactValueError[T](val)
+		}
+
+		return T(val), nil //nolint:gosec
+	}
+
+	val, err := decoder.DecodeUint64()
+	switch {
+	case err != nil:
+		return 0, err //nolint:wrapcheck
+	case uint64(T(val)) != val:
+		return 0, newInexactValueError[T](val)
+	}
+
+	return T(val), nil
+}
+
+// decodeFloatStrict decodes a float or an integer, that could be represented as T exactly.
+func decodeFloatStrict[T float](decoder *msgpack.Decoder, code byte) (T, error) {
+	switch {
+	case code == msgpcode.Float || code == msgpcode.Double:
+		val, err := decoder.DecodeFloat64()
+		switch {
+		case err != nil:
+			return 0, err //nolint:wrapcheck
+		case float64(T(val)) != val && !math.IsNaN(val):
+			return 0, newInexactValueError[T](val)
+		}
+
+		return T(val), nil
+	case isUnsignedCode(code):
+		val, err := decoder.DecodeUint64()
+		switch {
+		case err != nil:
+			return 0, err //nolint:wrapcheck
+		// 2^64 is exactly representable as float, while math.MaxUint64 is not.
+		case float64(T(val)) >= math.MaxUint64 || uint64(float64(T(val))) != val:
+			return 0, newInexactValueError[T](val)
+		}
+
+		return T(val), nil
+	default:
+		val, err := decoder.DecodeInt64()
+		switch {
+		case err != nil:
+			return 0, err //nolint:wrapcheck
+		// 2^63 is exactly representable as float, while math.MaxInt64 is not.
+		case float64(T(val)) >= -math.MinInt64 || int64(float64(T(val))) != val:
+			return 0, newInexactValueError[T](val)
+		}
+
+		return T(val), nil
+	}
+}
+
+func decodeIntStrict(decoder *msgpack.Decoder, code byte) (int, error) {
+	return decodeSignedStrict[int](decoder, code)
+}
+
+func decodeInt8Strict(decoder *msgpack.Decoder, code byte) (int8, error) {
+	return decodeSignedStrict[int8](decoder, code)
+}
+
+func decodeInt16Strict(decoder *msgpack.Decoder, code byte) (int16, error) {
+	return decodeSignedStrict[int16](decoder, code)
+}
+
+func decodeInt32Strict(decoder *msgpack.Decoder, code byte) (int32, error) {
+	return decodeSignedStrict[int32](decoder, code)
+}
+
+func decodeInt64Strict(decoder *msgpack.Decoder, code byte) (int64, error) {
+	return decodeSignedStrict[int64](decoder, code)
+}
+
+func decodeUintStrict(decoder *msgpack.Decoder, code byte) (uint, error) {
+	return decodeUnsignedStrict[uint](decoder, code)
+}
+
+func decodeUint8Strict(decoder *msgpack.Decoder, code byte) (uint8, error) {
+	return decodeUnsignedStrict[uint8](decoder, code)
+}
+
+func decodeUint16Strict(decoder *msgpack.Decoder, code byte) (uint16, error) {
+	return decodeUnsignedStrict[uint16](decoder, code)
+}
+
+func decodeUint32Strict(decoder *msgpack.Decoder, code byte) (uint32, error) {
+	return decodeUnsignedStrict[uint32](decoder, code)
+}
+
+func decodeUint64Strict(decoder *msgpack.Decoder, code byte) (uint64, error) {
+	return decodeUnsignedStrict[uint64](decoder, code)
+}
+
+func decodeByteStrict(decoder *msgpack.Decoder, code byte) (byte, error) {
+	return decodeUnsignedStrict[byte](decoder, code)
+}
+
+func decodeFloat32Strict(decoder *msgpack.Decoder, code byte) (float32, error) {
+	return decodeFloatStrict[float32](decoder, code)
+}
+
+func decodeFloat64Strict(decoder *msgpack.Decoder, code byte) (float64, error) {
+	return decodeFloatStrict[float64](decoder, code)
+}
+
+// decodeGenericStrict decodes the value with a strict decoder, if T is a numeric type.
+// It returns false, if there is no strict decoder for T.
+func decodeGenericStrict[T any](decoder *msgpack.Decoder, code byte, val *T) (bool, error) {
+	var err error
+
+	switch v := any(val).(type) {
+	case *int:
+		*v, err = decodeIntStrict(decoder, code)
+	case *int8:
+		*v, err = decodeInt8Strict(decoder, code)
+	case *int16:
+		*v, err = decodeInt16Strict(decoder, code)
+	case *int32:
+		*v, err = decodeInt32Strict(decoder, code)
+	case *int64:
+		*v, err = decodeInt64Strict(decoder, code)
+	case *uint:
+		*v, err = decodeUintStrict(decoder, code)
+	case *uint8:
+		*v, err = decodeUint8Strict(decoder, code)
+	case *uint16:
+		*v, err = decodeUint16Strict(decoder, code)
+	case *uint32:
+		*v, err = decodeUint32Strict(decoder, code)
+	case *uint64:
+		*v, err = decodeUint64Strict(decoder, code)
+	case *float32:
+		*v, err = decodeFloat32Strict(decoder, code)
+	case *float64:
+		*v, err = decodeFloat64Strict(decoder, code)
+	default:
+		return false, nil
+	}
+
+	return true, err
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o String) Get() (string, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o String) MustGet() string {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o String) UnwrapOrElse(defaultValue func() string) string {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o String) All() iter.Seq[string] {
+	return func(yield func(string) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Strings are None, or both contain equal values.
+func (o String) Equal(other String) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneString() is less than any value.
+func (o String) Compare(other String) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the String itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneString().
+func (o String) Filter(predicate func(string) bool) String {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneString()
+}
+
+// Or returns the String itself if it contains a value.
+// Otherwise, returns other.
+func (o String) Or(other String) String {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the String itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o String) OrElse(fn func() String) String {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the String value using MessagePack format.
 // - If the value is present, it is encoded as string.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,234 @@ func (o String) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("String", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the String value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as string.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o String) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendString(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("String", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a String value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneString)
 //   - string: interpreted as a present value (SomeString)
 //
+// In DecodeModeLenient alternative encodings of string are accepted as well.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on string: exists = true, value = decoded value
 func (o *String) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("String", err)
+		return newDecodeMsgpackError("String", "string", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("String", decoder.Skip())
+		return newDecodeMsgpackError("String", "string", offset, decoder.Skip())
 	case checkString(code):
-		o.value, err = decodeString(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val string
+
+		val, err = decodeString(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("String", "string", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkStringLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeStringLenient(decoder)
 		if err != nil {
-			return newDecodeError("String", err)
+			return newDecodeMsgpackError("String", "string", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("String", code)
+		return newDecodeWithCodeError("String", "string", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as string.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o String) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueString(o.value)
+	if err != nil {
+		return nil, newEncodeError("String", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneString)
+//   - string: interpreted as a present value (SomeString)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into string.
+func (o *String) Scan(src any) error {
+	if src == nil {
+		o.value = zero[string]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanString(src)
+	if err != nil {
+		return newDecodeError("String", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the String value using JSON format.
+// - If the value is present, it is encoded as string.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o String) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("String", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a String value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneString)
+//   - string: interpreted as a present value (SomeString)
+//
+// Returns an error if the input can't be decoded as string.
+func (o *String) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[string]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("String", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of string.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o String) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextString(o.value)
+	if err != nil {
+		return nil, newEncodeError("String", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneString)
+//   - text representation of string: interpreted as a present value (SomeString)
+//
+// Returns an error if the text can't be parsed as string.
+func (o *String) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[string]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextString(text)
+	if err != nil {
+		return newDecodeError("String", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the String.
+// The String stays None until the flag is passed, the value is parsed
+// as text representation of string. Get of the returned flag.Getter returns the String.
+//
+// Example:
+//
+//	var opt option.String
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *String) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextString([]byte(text))
+			if err != nil {
+				return newDecodeError("String", err)
+			}
+
+			*o = SomeString(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestString_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyString := option.NoneString()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyString.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestString_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestString_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeString("hello").All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, "hello", values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneString().All()))
+	})
+}
+
+func TestString_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someString := option.SomeString("hello")
+	otherString := option.SomeString("bye")
+	emptyString := option.NoneString()
+
+	assert.True(t, someString.Equal(option.SomeString("hello")))
+	assert.True(t, emptyString.Equal(option.NoneString()))
+	assert.False(t, someString.Equal(otherString))
+	assert.False(t, someString.Equal(emptyString))
+	assert.False(t, emptyString.Equal(someString))
+
+	assert.Zero(t, someString.Compare(option.SomeString("hello")))
+	assert.Zero(t, emptyString.Compare(option.NoneString()))
+	assert.Equal(t, -otherString.Compare(someString), someString.Compare(otherString))
+	assert.NotZero(t, someString.Compare(otherString))
+	assert.Equal(t, 1, someString.Compare(emptyString))
+	assert.Equal(t, -1, emptyString.Compare(someString))
+}
+
+func TestString_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		assert.True(t, someString.Filter(func(string) bool { return true }).IsSome())
+		assert.False(t, someString.Filter(func(string) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		assert.False(t, emptyString.Filter(func(string) bool { return true }).IsSome())
+	})
+}
+
+func TestString_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		other := option.SomeString("bye")
+		assert.EqualValues(t, "hello", someString.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		other := option.SomeString("bye")
+		assert.EqualValues(t, "bye", emptyString.Or(other).Unwrap())
+	})
+}
+
+func TestString_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		assert.EqualValues(t, "hello", someString.OrElse(func() option.String {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		assert.EqualValues(t, "bye", emptyString.OrElse(func() option.String {
+			return option.SomeString("bye")
+		}).Unwrap())
+	})
+}
+
 func TestString_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestString_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestString_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someString := option.SomeString("hello")
+		require.NoError(t, someString.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someString.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneString().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestString_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		val, err := someString.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.String
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, "hello", scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		val, err := emptyString.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeString("hello")
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestString_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		data, err := json.Marshal(someString)
+		require.NoError(t, err)
+
+		var unmarshaled option.String
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, "hello", unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		data, err := json.Marshal(emptyString)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeString("hello")
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.String
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "String", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestString_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someString := option.SomeString("hello")
+		data, err := someString.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.String
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, "hello", unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyString := option.NoneString()
+		data, err := emptyString.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeString("hello")
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestString_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeString("hello").MarshalText()
+		require.NoError(t, err)
+
+		var opt option.String
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, "hello", opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.String
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeString() {
 	opt := option.SomeString("hello")
 	if opt.IsSome() {
@@ -0,0 +1,310 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableString represents a nullable value of type string with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableString struct {
+	value string
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableString]()
+}
+
+// SomeNullableString creates a NullableString with the given string value.
+func SomeNullableString(value string) NullableString {
+	return NullableString{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableString creates a NullableString, that is explicitly set to null.
+func NullNullableString() NullableString {
+	return NullableString{
+		value: zero[string](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableString creates a NullableString, that is not set.
+// It is equal to the zero value of NullableString.
+func UnsetNullableString() NullableString {
+	return NullableString{
+		value: zero[string](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableString contains a value.
+func (o NullableString) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableString is explicitly set to null.
+func (o NullableString) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableString is either null or contains a value.
+func (o NullableString) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableString is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableString) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of string, false).
+func (o NullableString) Get() (string, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableString) MustGet() string {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for string.
+func (o NullableString) Unwrap() string {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableString) UnwrapOr(defaultValue string) string {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableString) UnwrapOrElse(defaultValue func() string) string {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableString to String. Both Null and Unset are converted to NoneString().
+func (o NullableString) Option() String {
+	if o.state == nullableSome {
+		return SomeString(o.value)
+	}
+
+	return NoneString()
+}
+
+// Equal returns true if both NullableStrings have the same state and, if present, equal values.
+func (o NullableString) Equal(other NullableString) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableString) Compare(other NullableString) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableString value using MessagePack format.
+// - If the value is present, it is encoded as string.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableString) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableString", encodeString(encoder, o.value))
+	}
+
+	return newEncodeError("NullableString", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableString value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as string.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableString) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendString(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableString", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableString value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableString)
+//   - string: interpreted as a present value (SomeNullableString)
+//
+// In DecodeModeLenient alternative encodings of string are accepted as well.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableString) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableString", "string", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[string]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableString", "string", offset, decoder.Skip())
+	case checkString(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val string
+
+		val, err = decodeString(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableString", "string", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkStringLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeStringLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableString", "string", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableString", "string", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableString value using JSON format.
+// - If the value is present, it is encoded as string.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableString) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableString", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableString value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableString)
+//   - string: interpreted as a present value (SomeNullableString)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableString) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[string]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableString", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of string.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableString) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextString(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableString", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableString)
+//   - text representation of string: interpreted as a present value (SomeNullableString)
+func (o *NullableString) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[string]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextString(text)
+	if err != nil {
+		return newDecodeError("NullableString", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,362 @@
+package option
+
+// This file provides helpers shared by the text (un)marshaling methods of generated and generic optional types.
+// None is always represented as empty text. Numeric and boolean values are formatted and parsed with strconv,
+// strings and byte slices are used as is, other types are delegated to their own text representation.
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	errTextUnsupportedType = errors.New("type does not implement text marshaling")
+)
+
+func newTextUnsupportedTypeError[T any]() error {
+	return fmt.Errorf("%w: %T", errTextUnsupportedType, zero[T]())
+}
+
+// emptyText returns the text representation of an empty optional.
+func emptyText() []byte {
+	return []byte{}
+}
+
+// isEmptyText checks whether the given text represents an empty optional.
+func isEmptyText(text []byte) bool {
+	return len(text) == 0
+}
+
+func marshalTextSigned[T signed](val T) ([]byte, error) {
+	return strconv.AppendInt(nil, int64(val), 10), nil
+}
+
+func unmarshalTextSigned[T signed](text []byte) (T, error) {
+	val, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	if int64(T(val)) != val {
+		return 0, newOverflowError[T](string(text))
+	}
+
+	return T(val), nil
+}
+
+func marshalTextUnsigned[T unsigned](val T) ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(val), 10), nil
+}
+
+func unmarshalTextUnsigned[T unsigned](text []byte) (T, error) {
+	val, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	if uint64(T(val)) != val {
+		return 0, newOverflowError[T](string(text))
+	}
+
+	return T(val), nil
+}
+
+func marshalTextInt(val int) ([]byte, error) {
+	return marshalTextSigned(val)
+}
+
+func unmarshalTextInt(text []byte) (int, error) {
+	return unmarshalTextSigned[int](text)
+}
+
+func marshalTextInt8(val int8) ([]byte, error) {
+	return marshalTextSigned(val)
+}
+
+func unmarshalTextInt8(text []byte) (int8, error) {
+	return unmarshalTextSigned[int8](text)
+}
+
+func marshalTextInt16(val int16) ([]byte, error) {
+	return marshalTextSigned(val)
+}
+
+func unmarshalTextInt16(text []byte) (int16, error) {
+	return unmarshalTextSigned[int16](text)
+}
+
+func marshalTextInt32(val int32) ([]byte, error) {
+	return marshalTextSigned(val)
+}
+
+func unmarshalTextInt32(text []byte) (int32, error) {
+	return unmarshalTextSigned[int32](text)
+}
+
+func marshalTextInt64(val int64) ([]byte, error) {
+	return marshalTextSigned(val)
+}
+
+func unmarshalTextInt64(text []byte) (int64, error) {
+	return unmarshalTextSigned[int64](text)
+}
+
+func marshalTextUint(val uint) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextUint(text []byte) (uint, error) {
+	return unmarshalTextUnsigned[uint](text)
+}
+
+func marshalTextUint8(val uint8) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextUint8(text []byte) (uint8, error) {
+	return unmarshalTextUnsigned[uint8](text)
+}
+
+func marshalTextUint16(val uint16) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextUint16(text []byte) (uint16, error) {
+	return unmarshalTextUnsigned[uint16](text)
+}
+
+func marshalTextUint32(val uint32) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextUint32(text []byte) (uint32, error) {
+	return unmarshalTextUnsigned[uint32](text)
+}
+
+func marshalTextUint64(val uint64) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextUint64(text []byte) (uint64, error) {
+	return unmarshalTextUnsigned[uint64](text)
+}
+
+func marshalTextByte(val byte) ([]byte, error) {
+	return marshalTextUnsigned(val)
+}
+
+func unmarshalTextByte(text []byte) (byte, error) {
+	return unmarshalTextUnsigned[byte](text)
+}
+
+func marshalTextFloat32(val float32) ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(val), 'g', -1, 32), nil
+}
+
+func unmarshalTextFloat32(text []byte) (float32, error) {
+	val, err := strconv.ParseFloat(string(text), 32)
+	if err != nil {
+		return 0, err //nolint:wrapcheck
+	}
+
+	return float32(val), nil
+}
+
+func marshalTextFloat64(val float64) ([]byte, error) {
+	return strconv.AppendFloat(nil, val, 'g', -1, 64), nil
+}
+
+func unmarshalTextFloat64(text []byte) (float64, error) {
+	return strconv.ParseFloat(string(text), 64) //nolint:wrapcheck
+}
+
+func marshalTextString(val string) ([]byte, error) {
+	return []byte(val), nil
+}
+
+func unmarshalTextString(text []byte) (string, error) {
+	return string(text), nil
+}
+
+func marshalTextBytes(val []byte) ([]byte, error) {
+	return append([]byte{}, val...), nil
+}
+
+func unmarshalTextBytes(text []byte) ([]byte, error) {
+	// The caller may reuse the buffer, so make a copy.
+	return append([]byte{}, text...), nil
+}
+
+func marshalTextBool(val bool) ([]byte, error) {
+	return strconv.AppendBool(nil, val), nil
+}
+
+func unmarshalTextBool(text []byte) (bool, error) {
+	return strconv.ParseBool(string(text)) //nolint:wrapcheck
+}
+
+func marshalTextDecimal(val DecimalValue) ([]byte, error) {
+	return val.MarshalText()
+}
+
+func unmarshalTextDecimal(text []byte) (DecimalValue, error) {
+	return ParseDecimalValue(string(text))
+}
+
+func marshalTextUUID(val uuid.UUID) ([]byte, error) {
+	return val.MarshalText() //nolint:wrapcheck
+}
+
+func unmarshalTextUUID(text []byte) (uuid.UUID, error) {
+	return uuid.ParseBytes(text) //nolint:wrapcheck
+}
+
+func marshalTextTime(val time.Time) ([]byte, error) {
+	return val.MarshalText() //nolint:wrapcheck
+}
+
+func unmarshalTextTime(text []byte) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, string(text)) //nolint:wrapcheck
+}
+
+func marshalTextInterval(val IntervalValue) ([]byte, error) {
+	return val.MarshalText()
+}
+
+func unmarshalTextInterval(text []byte) (IntervalValue, error) {
+	var val IntervalValue
+
+	err := val.UnmarshalText(text)
+
+	return val, err
+}
+
+func marshalTextDuration(val time.Duration) ([]byte, error) {
+	return []byte(val.String()), nil
+}
+
+func unmarshalTextDuration(text []byte) (time.Duration, error) {
+	return time.ParseDuration(string(text)) //nolint:wrapcheck
+}
+
+// marshalTextAny marshals values implementing encoding.TextMarshaler with their own method,
+// strings and byte slices as is and other values in the default fmt format.
+func marshalTextAny(val any) ([]byte, error) {
+	switch typed := val.(type) {
+	case encoding.TextMarshaler:
+		return typed.MarshalText() //nolint:wrapcheck
+	case string:
+		return []byte(typed), nil
+	case []byte:
+		return append([]byte{}, typed...), nil
+	default:
+		return fmt.Append(nil, typed), nil
+	}
+}
+
+// unmarshalTextAny always unmarshals text as a string, since the original type is unknown.
+func unmarshalTextAny(text []byte) (any, error) {
+	return string(text), nil
+}
+
+// marshalTextGeneric marshals built-in types with the helpers above, as the typed optionals do,
+// and other types with their encoding.TextMarshaler implementation.
+func marshalTextGeneric[T any](val *T) ([]byte, error) { //nolint:cyclop
+	switch typed := any(*val).(type) {
+	case int:
+		return marshalTextInt(typed)
+	case int8:
+		return marshalTextInt8(typed)
+	case int16:
+		return marshalTextInt16(typed)
+	case int32:
+		return marshalTextInt32(typed)
+	case int64:
+		return marshalTextInt64(typed)
+	case uint:
+		return marshalTextUint(typed)
+	case uint8:
+		return marshalTextUint8(typed)
+	case uint16:
+		return marshalTextUint16(typed)
+	case uint32:
+		return marshalTextUint32(typed)
+	case uint64:
+		return marshalTextUint64(typed)
+	case float32:
+		return marshalTextFloat32(typed)
+	case float64:
+		return marshalTextFloat64(typed)
+	case string:
+		return marshalTextString(typed)
+	case []byte:
+		return marshalTextBytes(typed)
+	case bool:
+		return marshalTextBool(typed)
+	case time.Time:
+		return marshalTextTime(typed)
+	case time.Duration:
+		return marshalTextDuration(typed)
+	}
+
+	marshaler, ok := any(val).(encoding.TextMarshaler)
+	if !ok {
+		return nil, newTextUnsupportedTypeError[T]()
+	}
+
+	return marshaler.MarshalText() //nolint:wrapcheck
+}
+
+// unmarshalTextGeneric unmarshals text into dst of built-in types with the helpers above
+// and into dst of other types with their encoding.TextUnmarshaler implementation.
+func unmarshalTextGeneric[T any](dst *T, text []byte) error { //nolint:cyclop,funlen
+	var err error
+
+	switch typed := any(dst).(type) {
+	case *int:
+		*typed, err = unmarshalTextInt(text)
+	case *int8:
+		*typed, err = unmarshalTextInt8(text)
+	case *int16:
+		*typed, err = unmarshalTextInt16(text)
+	case *int32:
+		*typed, err = unmarshalTextInt32(text)
+	case *int64:
+		*typed, err = unmarshalTextInt64(text)
+	case *uint:
+		*typed, err = unmarshalTextUint(text)
+	case *uint8:
+		*typed, err = unmarshalTextUint8(text)
+	case *uint16:
+		*typed, err = unmarshalTextUint16(text)
+	case *uint32:
+		*typed, err = unmarshalTextUint32(text)
+	case *uint64:
+		*typed, err = unmarshalTextUint64(text)
+	case *float32:
+		*typed, err = unmarshalTextFloat32(text)
+	case *float64:
+		*typed, err = unmarshalTextFloat64(text)
+	case *string:
+		*typed, err = unmarshalTextString(text)
+	case *[]byte:
+		*typed, err = unmarshalTextBytes(text)
+	case *bool:
+		*typed, err = unmarshalTextBool(text)
+	case *time.Time:
+		*typed, err = unmarshalTextTime(text)
+	case *time.Duration:
+		*typed, err = unmarshalTextDuration(text)
+	case encoding.TextUnmarshaler:
+		err = typed.UnmarshalText(text)
+	default:
+		err = newTextUnsupportedTypeError[T]()
+	}
+
+	return err //nolint:wrapcheck
+}
@@ -0,0 +1,191 @@
+package option_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestMarshalText_Format(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    encoding.TextMarshaler
+		expected string
+	}{
+		{"int", option.SomeInt(-42), "-42"},
+		{"uint64", option.SomeUint64(1<<64 - 1), "18446744073709551615"},
+		{"float32", option.SomeFloat32(0.1), "0.1"},
+		{"float64", option.SomeFloat64(1e21), "1e+21"},
+		{"bool", option.SomeBool(false), "false"},
+		{"string", option.SomeString("hello world"), "hello world"},
+		{"bytes", option.SomeBytes([]byte("raw")), "raw"},
+		{"decimal", option.SomeDecimal(option.MustParseDecimalValue("-12.340")), "-12.340"},
+		{
+			"time", option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 5, time.UTC)),
+			"2025-12-02T10:30:00.000000005Z",
+		},
+		{"duration", option.SomeDuration(90 * time.Second), "1m30s"},
+		{"interval", option.SomeInterval(option.IntervalValue{Day: 2, Adjust: option.IntervalAdjustLast}),
+			"+2 days, adjust last"},
+		{"any", option.SomeAny(12), "12"},
+		{"nullable", option.SomeNullableInt(7), "7"},
+		{"null", option.NullNullableInt(), ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := tc.value.MarshalText()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(data))
+		})
+	}
+}
+
+func TestUnmarshalText_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target encoding.TextUnmarshaler
+		text   string
+	}{
+		{"int8 overflow", &option.Int8{}, "300"},
+		{"uint negative", &option.Uint{}, "-1"},
+		{"int float", &option.Int{}, "1.5"},
+		{"float", &option.Float64{}, "pi"},
+		{"bool", &option.Bool{}, "yes"},
+		{"uuid", &option.UUID{}, "not-a-uuid"},
+		{"datetime", &option.Datetime{}, "yesterday"},
+		{"duration", &option.Duration{}, "forever"},
+		{"interval unit", &option.Interval{}, "+1 fortnights"},
+		{"interval adjust", &option.Interval{}, "+1 days, adjust first"},
+		{"nullable", &option.NullableInt{}, "one"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, tc.target.UnmarshalText([]byte(tc.text)), &decodeErr)
+		})
+	}
+}
+
+func TestIntervalValue_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	for _, interval := range []option.IntervalValue{
+		{},
+		{Year: 1, Month: -2, Week: 3, Day: -4, Hour: 5, Min: -6, Sec: 7, Nsec: -8},
+		{Hour: 1, Adjust: option.IntervalAdjustExcess},
+		{Adjust: option.IntervalAdjustLast},
+	} {
+		data, err := interval.MarshalText()
+		require.NoError(t, err)
+
+		var unmarshaled option.IntervalValue
+		require.NoError(t, unmarshaled.UnmarshalText(data))
+		assert.Equal(t, interval, unmarshaled, string(data))
+	}
+}
+
+func TestGeneric_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		some := option.Some(option.MustParseDecimalValue("1.5"))
+		data, err := some.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "1.5", string(data))
+
+		var unmarshaled option.Generic[option.DecimalValue]
+		require.NoError(t, unmarshaled.UnmarshalText(data))
+		assert.True(t, unmarshaled.IsSome())
+		assert.Equal(t, "1.5", unmarshaled.Unwrap().String())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.None[option.DecimalValue]().MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.Some(option.MustParseDecimalValue("1.5"))
+		require.NoError(t, unmarshaled.UnmarshalText(data))
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.Some(-12).MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "-12", string(data))
+
+		var unmarshaled option.Generic[int]
+		require.NoError(t, unmarshaled.UnmarshalText(data))
+		assert.Equal(t, option.Some(-12), unmarshaled)
+
+		// The value is kept on errors.
+		require.Error(t, unmarshaled.UnmarshalText([]byte("abc")))
+		assert.Equal(t, option.Some(-12), unmarshaled)
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.Some("hello").MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+
+		var unmarshaled option.Generic[string]
+		require.NoError(t, unmarshaled.UnmarshalText(data))
+		assert.Equal(t, option.Some("hello"), unmarshaled)
+	})
+
+	t.Run("json map key", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(map[option.Generic[int]]string{option.Some(1): "one"})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"1":"one"}`, string(data))
+
+		var unmarshaled map[option.Generic[int]]string
+		require.NoError(t, json.Unmarshal(data, &unmarshaled))
+		assert.Equal(t, map[option.Generic[int]]string{option.Some(1): "one"}, unmarshaled)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		type point struct{ X, Y int }
+
+		var encodeErr option.EncodeError
+
+		_, err := option.Some(point{X: 1, Y: 2}).MarshalText()
+		require.ErrorAs(t, err, &encodeErr)
+		assert.Equal(t, "Generic[option_test.point]", encodeErr.Type)
+
+		var (
+			unmarshaled option.Generic[point]
+			decodeErr   option.DecodeError
+		)
+
+		require.ErrorAs(t, unmarshaled.UnmarshalText([]byte("1,2")), &decodeErr)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
@@ -0,0 +1,425 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// Time represents an optional value of type time.Time.
+// It can either hold a valid time.Time (IsSome == true) or be empty (IsZero == true).
+type Time struct {
+	value  time.Time
+	exists bool
+}
+
+var _ commonInterface[time.Time] = (*Time)(nil)
+
+// SomeTime creates an optional Time with the given time.Time value.
+// The returned Time will have IsSome() == true and IsZero() == false.
+func SomeTime(value time.Time) Time {
+	return Time{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneTime creates an empty optional Time value.
+// The returned Time will have IsSome() == false and IsZero() == true.
+func NoneTime() Time {
+	return Time{
+		exists: false,
+		value:  zero[time.Time](),
+	}
+}
+
+// IsSome returns true if the Time contains a value.
+// This indicates the value is explicitly set (not None).
+func (o Time) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the Time does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o Time) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o Time) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of time.Time, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o Time) Get() (time.Time, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o Time) MustGet() time.Time {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Time.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o Time) Unwrap() time.Time {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o Time) UnwrapOr(defaultValue time.Time) time.Time {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o Time) UnwrapOrElse(defaultValue func() time.Time) time.Time {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Time) All() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Times are None, or both contain equal values.
+func (o Time) Equal(other Time) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalTime(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneTime() is less than any value.
+func (o Time) Compare(other Time) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareTime(o.value, other.value)
+	})
+}
+
+// Filter returns the Time itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneTime().
+func (o Time) Filter(predicate func(time.Time) bool) Time {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneTime()
+}
+
+// Or returns the Time itself if it contains a value.
+// Otherwise, returns other.
+func (o Time) Or(other Time) Time {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Time itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Time) OrElse(fn func() Time) Time {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the Time value using MessagePack format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o Time) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("Time", encodeTime(encoder, o.value))
+	}
+
+	return newEncodeError("Time", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the Time value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Time) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendTime(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Time", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a Time value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneTime)
+//   - time.Time: interpreted as a present value (SomeTime)
+//
+// In DecodeModeLenient alternative encodings of time.Time are accepted as well.
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on time.Time: exists = true, value = decoded value
+func (o *Time) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("Time", "time.Time", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("Time", "time.Time", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Time
+
+		val, err = decodeTime(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Time", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkTimeLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeTimeLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("Time", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("Time", "time.Time", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as time.Time.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Time) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueDatetime(o.value)
+	if err != nil {
+		return nil, newEncodeError("Time", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneTime)
+//   - time.Time: interpreted as a present value (SomeTime)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into time.Time.
+func (o *Time) Scan(src any) error {
+	if src == nil {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanDatetime(src)
+	if err != nil {
+		return newDecodeError("Time", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Time value using JSON format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Time) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Time", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Time value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneTime)
+//   - time.Time: interpreted as a present value (SomeTime)
+//
+// Returns an error if the input can't be decoded as time.Time.
+func (o *Time) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Time", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Time.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Time) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextTime(o.value)
+	if err != nil {
+		return nil, newEncodeError("Time", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneTime)
+//   - text representation of time.Time: interpreted as a present value (SomeTime)
+//
+// Returns an error if the text can't be parsed as time.Time.
+func (o *Time) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Time]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextTime(text)
+	if err != nil {
+		return newDecodeError("Time", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Time.
+// The Time stays None until the flag is passed, the value is parsed
+// as text representation of time.Time. Get of the returned flag.Getter returns the Time.
+//
+// Example:
+//
+//	var opt option.Time
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Time) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextTime([]byte(text))
+			if err != nil {
+				return newDecodeError("Time", err)
+			}
+
+			*o = SomeTime(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
@@ -0,0 +1,695 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"time"
+
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestTime_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.True(t, someTime.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.False(t, emptyTime.IsSome())
+	})
+}
+
+func TestTime_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.False(t, someTime.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.True(t, emptyTime.IsZero())
+	})
+}
+
+func TestTime_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.False(t, someTime.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.True(t, emptyTime.IsNil())
+	})
+}
+
+func TestTime_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		val, ok := someTime.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		_, ok := emptyTime.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestTime_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyTime.MustGet()
+		})
+	})
+}
+
+func TestTime_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.NotPanics(t, func() {
+			emptyTime.Unwrap()
+		})
+	})
+}
+
+func TestTime_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyTime.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestTime_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.UnwrapOrElse(func() time.Time {
+			return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyTime.UnwrapOrElse(func() time.Time {
+			return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+		}))
+	})
+}
+
+func TestTime_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneTime().All()))
+	})
+}
+
+func TestTime_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	otherTime := option.SomeTime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+	emptyTime := option.NoneTime()
+
+	assert.True(t, someTime.Equal(option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))))
+	assert.True(t, emptyTime.Equal(option.NoneTime()))
+	assert.False(t, someTime.Equal(otherTime))
+	assert.False(t, someTime.Equal(emptyTime))
+	assert.False(t, emptyTime.Equal(someTime))
+
+	assert.Zero(t, someTime.Compare(option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))))
+	assert.Zero(t, emptyTime.Compare(option.NoneTime()))
+	assert.Equal(t, -otherTime.Compare(someTime), someTime.Compare(otherTime))
+	assert.NotZero(t, someTime.Compare(otherTime))
+	assert.Equal(t, 1, someTime.Compare(emptyTime))
+	assert.Equal(t, -1, emptyTime.Compare(someTime))
+}
+
+func TestTime_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.True(t, someTime.Filter(func(time.Time) bool { return true }).IsSome())
+		assert.False(t, someTime.Filter(func(time.Time) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.False(t, emptyTime.Filter(func(time.Time) bool { return true }).IsSome())
+	})
+}
+
+func TestTime_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		other := option.SomeTime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		other := option.SomeTime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyTime.Or(other).Unwrap())
+	})
+}
+
+func TestTime_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), someTime.OrElse(func() option.Time {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		assert.EqualValues(t, time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC), emptyTime.OrElse(func() option.Time {
+			return option.SomeTime(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+		}).Unwrap())
+	})
+}
+
+func TestTime_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err := someTime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC))
+		err := someTime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someTime := option.SomeTime(time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC))
+		err := someTime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("some_3", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someTime := option.SomeTime(time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC))
+		err := someTime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyTime := option.NoneTime()
+		err := emptyTime.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestTime_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		require.NoError(t, someTime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someTime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_1", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC))
+		require.NoError(t, someTime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someTime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_2", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someTime := option.SomeTime(time.Date(1969, time.July, 20, 20, 17, 40, 0, time.UTC))
+		require.NoError(t, someTime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someTime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("some_3", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someTime := option.SomeTime(time.Date(2600, time.January, 1, 0, 0, 0, 1, time.UTC))
+		require.NoError(t, someTime.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someTime.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneTime().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestTime_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		val, err := someTime.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Time
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		val, err := emptyTime.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestTime_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		data, err := json.Marshal(someTime)
+		require.NoError(t, err)
+
+		var unmarshaled option.Time
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		data, err := json.Marshal(emptyTime)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Time
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Time", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestTime_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someTime := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		data, err := someTime.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Time
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyTime := option.NoneTime()
+		data, err := emptyTime.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestTime_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Time
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC), opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Time
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeTime() {
+	opt := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: 2025-12-02 10:30:00 +0000 UTC
+}
+
+func ExampleNoneTime() {
+	opt := option.NoneTime()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleTime_IsSome() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleTime_IsZero() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleTime_IsNil() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleTime_Get() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC true
+	// 0001-01-01 00:00:00 +0000 UTC false
+}
+
+func ExampleTime_MustGet() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	fmt.Println(some.MustGet())
+	// Output: 2025-12-02 10:30:00 +0000 UTC
+}
+
+func ExampleTime_MustGet_panic() {
+	none := option.NoneTime()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleTime_Unwrap() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 0001-01-01 00:00:00 +0000 UTC
+}
+
+func ExampleTime_UnwrapOr() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	fmt.Println(none.UnwrapOr(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 2000-01-01 00:00:00 +0000 UTC
+}
+
+func ExampleTime_UnwrapOrElse() {
+	some := option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC))
+	none := option.NoneTime()
+	fmt.Println(some.UnwrapOrElse(func() time.Time {
+		return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}))
+	fmt.Println(none.UnwrapOrElse(func() time.Time {
+		return time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	}))
+	// Output:
+	// 2025-12-02 10:30:00 +0000 UTC
+	// 2000-01-01 00:00:00 +0000 UTC
+}
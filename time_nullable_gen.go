@@ -0,0 +1,312 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableTime represents a nullable value of type time.Time with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableTime struct {
+	value time.Time
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableTime]()
+}
+
+// SomeNullableTime creates a NullableTime with the given time.Time value.
+func SomeNullableTime(value time.Time) NullableTime {
+	return NullableTime{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableTime creates a NullableTime, that is explicitly set to null.
+func NullNullableTime() NullableTime {
+	return NullableTime{
+		value: zero[time.Time](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableTime creates a NullableTime, that is not set.
+// It is equal to the zero value of NullableTime.
+func UnsetNullableTime() NullableTime {
+	return NullableTime{
+		value: zero[time.Time](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableTime contains a value.
+func (o NullableTime) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableTime is explicitly set to null.
+func (o NullableTime) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableTime is either null or contains a value.
+func (o NullableTime) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableTime is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableTime) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of time.Time, false).
+func (o NullableTime) Get() (time.Time, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableTime) MustGet() time.Time {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for time.Time.
+func (o NullableTime) Unwrap() time.Time {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableTime) UnwrapOr(defaultValue time.Time) time.Time {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableTime) UnwrapOrElse(defaultValue func() time.Time) time.Time {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableTime to Time. Both Null and Unset are converted to NoneTime().
+func (o NullableTime) Option() Time {
+	if o.state == nullableSome {
+		return SomeTime(o.value)
+	}
+
+	return NoneTime()
+}
+
+// Equal returns true if both NullableTimes have the same state and, if present, equal values.
+func (o NullableTime) Equal(other NullableTime) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalTime(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableTime) Compare(other NullableTime) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareTime(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableTime value using MessagePack format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableTime) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableTime", encodeTime(encoder, o.value))
+	}
+
+	return newEncodeError("NullableTime", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableTime value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableTime) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendTime(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableTime", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableTime value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableTime)
+//   - time.Time: interpreted as a present value (SomeNullableTime)
+//
+// In DecodeModeLenient alternative encodings of time.Time are accepted as well.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableTime) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableTime", "time.Time", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableTime", "time.Time", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val time.Time
+
+		val, err = decodeTime(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableTime", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkTimeLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeTimeLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableTime", "time.Time", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableTime", "time.Time", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableTime value using JSON format.
+// - If the value is present, it is encoded as time.Time.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableTime) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableTime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableTime value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableTime)
+//   - time.Time: interpreted as a present value (SomeNullableTime)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableTime) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableTime", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of time.Time.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableTime) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextTime(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableTime", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableTime)
+//   - text representation of time.Time: interpreted as a present value (SomeNullableTime)
+func (o *NullableTime) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[time.Time]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextTime(text)
+	if err != nil {
+		return newDecodeError("NullableTime", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
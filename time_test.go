@@ -0,0 +1,140 @@
+package option_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+func encodeRaw(t *testing.T, val any) []byte {
+	t.Helper()
+
+	data, err := msgpack.Marshal(val)
+	require.NoError(t, err)
+
+	return data
+}
+
+func decodeLenient(data []byte, opt msgpack.CustomDecoder) error {
+	dec := msgpack.NewDecoder(bytes.NewReader(data))
+	option.SetDecodeMode(dec, option.DecodeModeLenient)
+
+	return opt.DecodeMsgpack(dec)
+}
+
+func TestTime_EncodeTimestampExt(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(option.SomeTime(time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xd6, 0xff, 0x69, 0x2e, 0xbf, 0xa8}, data)
+}
+
+func TestDuration_EncodeNanoseconds(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(option.SomeDuration(90 * time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0xcf, 0x00, 0x00, 0x00, 0x14, 0xf4, 0x6b, 0x04, 0x00}, data)
+
+	var opt option.Duration
+	require.NoError(t, msgpack.Unmarshal(encodeRaw(t, -90*time.Second), &opt))
+	assert.Equal(t, -90*time.Second, opt.Unwrap())
+}
+
+func TestTime_DecodeLenient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    any
+		expected time.Time
+	}{
+		{"int epoch", int64(1764671400), time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)},
+		{"negative int epoch", int64(-1), time.Date(1969, time.December, 31, 23, 59, 59, 0, time.UTC)},
+		{"float epoch", 1764671400.5, time.Date(2025, time.December, 2, 10, 30, 0, 500000000, time.UTC)},
+		{"rfc3339", "2025-12-02T13:30:00+03:00", time.Date(2025, time.December, 2, 10, 30, 0, 0, time.UTC)},
+		{"rfc3339 nano", "2025-12-02T10:30:00.123456789Z", time.Date(2025, time.December, 2, 10, 30, 0, 123456789, time.UTC)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Time
+
+			require.NoError(t, decodeLenient(encodeRaw(t, tc.input), &opt))
+			require.True(t, opt.IsSome())
+			assert.True(t, tc.expected.Equal(opt.Unwrap()), "expected %s, got %s", tc.expected, opt.Unwrap())
+		})
+	}
+
+	t.Run("invalid string", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Time
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, decodeLenient(encodeRaw(t, "yesterday"), &opt), &decodeErr)
+		assert.Equal(t, "Time", decodeErr.Type)
+		assert.False(t, opt.IsSome())
+	})
+
+	t.Run("unsupported code", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Time
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, decodeLenient(encodeRaw(t, true), &opt), &decodeErr)
+		assert.True(t, decodeErr.Code.IsSome())
+	})
+}
+
+func TestDuration_DecodeLenient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    any
+		expected time.Duration
+	}{
+		{"float", float64(1500), 1500 * time.Nanosecond},
+		{"string", "1h30m", 90 * time.Minute},
+		{"negative string", "-1.5s", -1500 * time.Millisecond},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Duration
+
+			require.NoError(t, decodeLenient(encodeRaw(t, tc.input), &opt))
+			require.True(t, opt.IsSome())
+			assert.Equal(t, tc.expected, opt.Unwrap())
+		})
+	}
+
+	for name, input := range map[string]any{
+		"fractional float": 1.5,
+		"invalid string":   "forever",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.Duration
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, decodeLenient(encodeRaw(t, input), &opt), &decodeErr)
+			assert.Equal(t, "Duration", decodeErr.Type)
+			assert.False(t, opt.IsSome())
+		})
+	}
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Uint16) Get() (uint16, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Uint16) MustGet() uint16 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Uint16) UnwrapOrElse(defaultValue func() uint16) uint16 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Uint16) All() iter.Seq[uint16] {
+	return func(yield func(uint16) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Uint16s are None, or both contain equal values.
+func (o Uint16) Equal(other Uint16) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneUint16() is less than any value.
+func (o Uint16) Compare(other Uint16) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Uint16 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneUint16().
+func (o Uint16) Filter(predicate func(uint16) bool) Uint16 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneUint16()
+}
+
+// Or returns the Uint16 itself if it contains a value.
+// Otherwise, returns other.
+func (o Uint16) Or(other Uint16) Uint16 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Uint16 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Uint16) OrElse(fn func() Uint16) Uint16 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Uint16 value using MessagePack format.
 // - If the value is present, it is encoded as uint16.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Uint16) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Uint16", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Uint16 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint16.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Uint16) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint16(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Uint16", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Uint16 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneUint16)
 //   - uint16: interpreted as a present value (SomeUint16)
 //
+// In DecodeModeLenient alternative encodings of uint16 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint16 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on uint16: exists = true, value = decoded value
 func (o *Uint16) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Uint16", err)
+		return newDecodeMsgpackError("Uint16", "uint16", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Uint16", decoder.Skip())
+		return newDecodeMsgpackError("Uint16", "uint16", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeUint16(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint16
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint16Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Uint16", "uint16", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint16(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Uint16", "uint16", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint16Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Uint16", err)
+			return newDecodeMsgpackError("Uint16", "uint16", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Uint16", code)
+		return newDecodeWithCodeError("Uint16", "uint16", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as uint16.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Uint16) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueUint16(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint16", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneUint16)
+//   - uint16: interpreted as a present value (SomeUint16)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into uint16.
+func (o *Uint16) Scan(src any) error {
+	if src == nil {
+		o.value = zero[uint16]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanUint16(src)
+	if err != nil {
+		return newDecodeError("Uint16", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Uint16 value using JSON format.
+// - If the value is present, it is encoded as uint16.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Uint16) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Uint16 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneUint16)
+//   - uint16: interpreted as a present value (SomeUint16)
+//
+// Returns an error if the input can't be decoded as uint16.
+func (o *Uint16) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint16]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Uint16", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint16.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Uint16) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint16(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneUint16)
+//   - text representation of uint16: interpreted as a present value (SomeUint16)
+//
+// Returns an error if the text can't be parsed as uint16.
+func (o *Uint16) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint16]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint16(text)
+	if err != nil {
+		return newDecodeError("Uint16", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Uint16.
+// The Uint16 stays None until the flag is passed, the value is parsed
+// as text representation of uint16. Get of the returned flag.Getter returns the Uint16.
+//
+// Example:
+//
+//	var opt option.Uint16
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Uint16) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextUint16([]byte(text))
+			if err != nil {
+				return newDecodeError("Uint16", err)
+			}
+
+			*o = SomeUint16(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
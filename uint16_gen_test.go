@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestUint16_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyUint16 := option.NoneUint16()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyUint16.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestUint16_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestUint16_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUint16(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUint16().All()))
+	})
+}
+
+func TestUint16_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUint16 := option.SomeUint16(12)
+	otherUint16 := option.SomeUint16(13)
+	emptyUint16 := option.NoneUint16()
+
+	assert.True(t, someUint16.Equal(option.SomeUint16(12)))
+	assert.True(t, emptyUint16.Equal(option.NoneUint16()))
+	assert.False(t, someUint16.Equal(otherUint16))
+	assert.False(t, someUint16.Equal(emptyUint16))
+	assert.False(t, emptyUint16.Equal(someUint16))
+
+	assert.Zero(t, someUint16.Compare(option.SomeUint16(12)))
+	assert.Zero(t, emptyUint16.Compare(option.NoneUint16()))
+	assert.Equal(t, -otherUint16.Compare(someUint16), someUint16.Compare(otherUint16))
+	assert.NotZero(t, someUint16.Compare(otherUint16))
+	assert.Equal(t, 1, someUint16.Compare(emptyUint16))
+	assert.Equal(t, -1, emptyUint16.Compare(someUint16))
+}
+
+func TestUint16_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		assert.True(t, someUint16.Filter(func(uint16) bool { return true }).IsSome())
+		assert.False(t, someUint16.Filter(func(uint16) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		assert.False(t, emptyUint16.Filter(func(uint16) bool { return true }).IsSome())
+	})
+}
+
+func TestUint16_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		other := option.SomeUint16(13)
+		assert.EqualValues(t, 12, someUint16.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		other := option.SomeUint16(13)
+		assert.EqualValues(t, 13, emptyUint16.Or(other).Unwrap())
+	})
+}
+
+func TestUint16_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		assert.EqualValues(t, 12, someUint16.OrElse(func() option.Uint16 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		assert.EqualValues(t, 13, emptyUint16.OrElse(func() option.Uint16 {
+			return option.SomeUint16(13)
+		}).Unwrap())
+	})
+}
+
 func TestUint16_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestUint16_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestUint16_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUint16 := option.SomeUint16(12)
+		require.NoError(t, someUint16.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUint16.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUint16().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUint16_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		val, err := someUint16.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Uint16
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		val, err := emptyUint16.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUint16(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUint16_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		data, err := json.Marshal(someUint16)
+		require.NoError(t, err)
+
+		var unmarshaled option.Uint16
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		data, err := json.Marshal(emptyUint16)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUint16(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Uint16
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Uint16", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint16_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint16 := option.SomeUint16(12)
+		data, err := someUint16.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Uint16
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint16 := option.NoneUint16()
+		data, err := emptyUint16.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUint16(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint16_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUint16(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Uint16
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Uint16
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeUint16() {
 	opt := option.SomeUint16(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUint16 represents a nullable value of type uint16 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUint16 struct {
+	value uint16
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUint16]()
+}
+
+// SomeNullableUint16 creates a NullableUint16 with the given uint16 value.
+func SomeNullableUint16(value uint16) NullableUint16 {
+	return NullableUint16{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUint16 creates a NullableUint16, that is explicitly set to null.
+func NullNullableUint16() NullableUint16 {
+	return NullableUint16{
+		value: zero[uint16](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUint16 creates a NullableUint16, that is not set.
+// It is equal to the zero value of NullableUint16.
+func UnsetNullableUint16() NullableUint16 {
+	return NullableUint16{
+		value: zero[uint16](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUint16 contains a value.
+func (o NullableUint16) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUint16 is explicitly set to null.
+func (o NullableUint16) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUint16 is either null or contains a value.
+func (o NullableUint16) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUint16 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUint16) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uint16, false).
+func (o NullableUint16) Get() (uint16, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUint16) MustGet() uint16 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uint16.
+func (o NullableUint16) Unwrap() uint16 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUint16) UnwrapOr(defaultValue uint16) uint16 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUint16) UnwrapOrElse(defaultValue func() uint16) uint16 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUint16 to Uint16. Both Null and Unset are converted to NoneUint16().
+func (o NullableUint16) Option() Uint16 {
+	if o.state == nullableSome {
+		return SomeUint16(o.value)
+	}
+
+	return NoneUint16()
+}
+
+// Equal returns true if both NullableUint16s have the same state and, if present, equal values.
+func (o NullableUint16) Equal(other NullableUint16) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUint16) Compare(other NullableUint16) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUint16 value using MessagePack format.
+// - If the value is present, it is encoded as uint16.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUint16) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUint16", encodeUint16(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUint16", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUint16 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint16.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUint16) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint16(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUint16", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUint16 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUint16)
+//   - uint16: interpreted as a present value (SomeNullableUint16)
+//
+// In DecodeModeLenient alternative encodings of uint16 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint16 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint16) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUint16", "uint16", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uint16]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUint16", "uint16", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint16
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint16Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableUint16", "uint16", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint16(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableUint16", "uint16", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint16Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUint16", "uint16", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUint16", "uint16", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUint16 value using JSON format.
+// - If the value is present, it is encoded as uint16.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUint16) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUint16 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUint16)
+//   - uint16: interpreted as a present value (SomeNullableUint16)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint16) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint16]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUint16", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint16.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUint16) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint16(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint16", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUint16)
+//   - text representation of uint16: interpreted as a present value (SomeNullableUint16)
+func (o *NullableUint16) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint16]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint16(text)
+	if err != nil {
+		return newDecodeError("NullableUint16", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
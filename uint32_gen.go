@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Uint32) Get() (uint32, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Uint32) MustGet() uint32 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Uint32) UnwrapOrElse(defaultValue func() uint32) uint32 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Uint32) All() iter.Seq[uint32] {
+	return func(yield func(uint32) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Uint32s are None, or both contain equal values.
+func (o Uint32) Equal(other Uint32) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneUint32() is less than any value.
+func (o Uint32) Compare(other Uint32) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Uint32 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneUint32().
+func (o Uint32) Filter(predicate func(uint32) bool) Uint32 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneUint32()
+}
+
+// Or returns the Uint32 itself if it contains a value.
+// Otherwise, returns other.
+func (o Uint32) Or(other Uint32) Uint32 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Uint32 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Uint32) OrElse(fn func() Uint32) Uint32 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Uint32 value using MessagePack format.
 // - If the value is present, it is encoded as uint32.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Uint32) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Uint32", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Uint32 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint32.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Uint32) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint32(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Uint32", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Uint32 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneUint32)
 //   - uint32: interpreted as a present value (SomeUint32)
 //
+// In DecodeModeLenient alternative encodings of uint32 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint32 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on uint32: exists = true, value = decoded value
 func (o *Uint32) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Uint32", err)
+		return newDecodeMsgpackError("Uint32", "uint32", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Uint32", decoder.Skip())
+		return newDecodeMsgpackError("Uint32", "uint32", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeUint32(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint32
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint32Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Uint32", "uint32", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint32(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Uint32", "uint32", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint32Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Uint32", err)
+			return newDecodeMsgpackError("Uint32", "uint32", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Uint32", code)
+		return newDecodeWithCodeError("Uint32", "uint32", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as uint32.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Uint32) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueUint32(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint32", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneUint32)
+//   - uint32: interpreted as a present value (SomeUint32)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into uint32.
+func (o *Uint32) Scan(src any) error {
+	if src == nil {
+		o.value = zero[uint32]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanUint32(src)
+	if err != nil {
+		return newDecodeError("Uint32", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Uint32 value using JSON format.
+// - If the value is present, it is encoded as uint32.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Uint32) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Uint32 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneUint32)
+//   - uint32: interpreted as a present value (SomeUint32)
+//
+// Returns an error if the input can't be decoded as uint32.
+func (o *Uint32) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint32]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Uint32", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint32.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Uint32) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint32(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneUint32)
+//   - text representation of uint32: interpreted as a present value (SomeUint32)
+//
+// Returns an error if the text can't be parsed as uint32.
+func (o *Uint32) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint32]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint32(text)
+	if err != nil {
+		return newDecodeError("Uint32", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Uint32.
+// The Uint32 stays None until the flag is passed, the value is parsed
+// as text representation of uint32. Get of the returned flag.Getter returns the Uint32.
+//
+// Example:
+//
+//	var opt option.Uint32
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Uint32) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextUint32([]byte(text))
+			if err != nil {
+				return newDecodeError("Uint32", err)
+			}
+
+			*o = SomeUint32(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestUint32_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyUint32 := option.NoneUint32()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyUint32.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestUint32_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestUint32_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUint32(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUint32().All()))
+	})
+}
+
+func TestUint32_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUint32 := option.SomeUint32(12)
+	otherUint32 := option.SomeUint32(13)
+	emptyUint32 := option.NoneUint32()
+
+	assert.True(t, someUint32.Equal(option.SomeUint32(12)))
+	assert.True(t, emptyUint32.Equal(option.NoneUint32()))
+	assert.False(t, someUint32.Equal(otherUint32))
+	assert.False(t, someUint32.Equal(emptyUint32))
+	assert.False(t, emptyUint32.Equal(someUint32))
+
+	assert.Zero(t, someUint32.Compare(option.SomeUint32(12)))
+	assert.Zero(t, emptyUint32.Compare(option.NoneUint32()))
+	assert.Equal(t, -otherUint32.Compare(someUint32), someUint32.Compare(otherUint32))
+	assert.NotZero(t, someUint32.Compare(otherUint32))
+	assert.Equal(t, 1, someUint32.Compare(emptyUint32))
+	assert.Equal(t, -1, emptyUint32.Compare(someUint32))
+}
+
+func TestUint32_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		assert.True(t, someUint32.Filter(func(uint32) bool { return true }).IsSome())
+		assert.False(t, someUint32.Filter(func(uint32) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		assert.False(t, emptyUint32.Filter(func(uint32) bool { return true }).IsSome())
+	})
+}
+
+func TestUint32_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		other := option.SomeUint32(13)
+		assert.EqualValues(t, 12, someUint32.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		other := option.SomeUint32(13)
+		assert.EqualValues(t, 13, emptyUint32.Or(other).Unwrap())
+	})
+}
+
+func TestUint32_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		assert.EqualValues(t, 12, someUint32.OrElse(func() option.Uint32 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		assert.EqualValues(t, 13, emptyUint32.OrElse(func() option.Uint32 {
+			return option.SomeUint32(13)
+		}).Unwrap())
+	})
+}
+
 func TestUint32_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestUint32_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestUint32_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUint32 := option.SomeUint32(12)
+		require.NoError(t, someUint32.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUint32.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUint32().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUint32_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		val, err := someUint32.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Uint32
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		val, err := emptyUint32.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUint32(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUint32_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		data, err := json.Marshal(someUint32)
+		require.NoError(t, err)
+
+		var unmarshaled option.Uint32
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		data, err := json.Marshal(emptyUint32)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUint32(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Uint32
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Uint32", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint32_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint32 := option.SomeUint32(12)
+		data, err := someUint32.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Uint32
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint32 := option.NoneUint32()
+		data, err := emptyUint32.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUint32(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint32_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUint32(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Uint32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Uint32
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeUint32() {
 	opt := option.SomeUint32(12)
 	if opt.IsSome() {
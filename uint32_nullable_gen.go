@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUint32 represents a nullable value of type uint32 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUint32 struct {
+	value uint32
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUint32]()
+}
+
+// SomeNullableUint32 creates a NullableUint32 with the given uint32 value.
+func SomeNullableUint32(value uint32) NullableUint32 {
+	return NullableUint32{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUint32 creates a NullableUint32, that is explicitly set to null.
+func NullNullableUint32() NullableUint32 {
+	return NullableUint32{
+		value: zero[uint32](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUint32 creates a NullableUint32, that is not set.
+// It is equal to the zero value of NullableUint32.
+func UnsetNullableUint32() NullableUint32 {
+	return NullableUint32{
+		value: zero[uint32](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUint32 contains a value.
+func (o NullableUint32) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUint32 is explicitly set to null.
+func (o NullableUint32) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUint32 is either null or contains a value.
+func (o NullableUint32) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUint32 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUint32) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uint32, false).
+func (o NullableUint32) Get() (uint32, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUint32) MustGet() uint32 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uint32.
+func (o NullableUint32) Unwrap() uint32 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUint32) UnwrapOr(defaultValue uint32) uint32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUint32) UnwrapOrElse(defaultValue func() uint32) uint32 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUint32 to Uint32. Both Null and Unset are converted to NoneUint32().
+func (o NullableUint32) Option() Uint32 {
+	if o.state == nullableSome {
+		return SomeUint32(o.value)
+	}
+
+	return NoneUint32()
+}
+
+// Equal returns true if both NullableUint32s have the same state and, if present, equal values.
+func (o NullableUint32) Equal(other NullableUint32) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUint32) Compare(other NullableUint32) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUint32 value using MessagePack format.
+// - If the value is present, it is encoded as uint32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUint32) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUint32", encodeUint32(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUint32", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUint32 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint32.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUint32) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint32(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUint32", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUint32 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUint32)
+//   - uint32: interpreted as a present value (SomeNullableUint32)
+//
+// In DecodeModeLenient alternative encodings of uint32 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint32 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint32) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUint32", "uint32", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uint32]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUint32", "uint32", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint32
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint32Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableUint32", "uint32", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint32(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableUint32", "uint32", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint32Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUint32", "uint32", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUint32", "uint32", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUint32 value using JSON format.
+// - If the value is present, it is encoded as uint32.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUint32) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUint32 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUint32)
+//   - uint32: interpreted as a present value (SomeNullableUint32)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint32) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUint32", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint32.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUint32) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint32(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint32", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUint32)
+//   - text representation of uint32: interpreted as a present value (SomeNullableUint32)
+func (o *NullableUint32) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint32]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint32(text)
+	if err != nil {
+		return newDecodeError("NullableUint32", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
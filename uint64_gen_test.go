@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestUint64_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyUint64 := option.NoneUint64()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyUint64.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestUint64_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestUint64_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUint64(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUint64().All()))
+	})
+}
+
+func TestUint64_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUint64 := option.SomeUint64(12)
+	otherUint64 := option.SomeUint64(13)
+	emptyUint64 := option.NoneUint64()
+
+	assert.True(t, someUint64.Equal(option.SomeUint64(12)))
+	assert.True(t, emptyUint64.Equal(option.NoneUint64()))
+	assert.False(t, someUint64.Equal(otherUint64))
+	assert.False(t, someUint64.Equal(emptyUint64))
+	assert.False(t, emptyUint64.Equal(someUint64))
+
+	assert.Zero(t, someUint64.Compare(option.SomeUint64(12)))
+	assert.Zero(t, emptyUint64.Compare(option.NoneUint64()))
+	assert.Equal(t, -otherUint64.Compare(someUint64), someUint64.Compare(otherUint64))
+	assert.NotZero(t, someUint64.Compare(otherUint64))
+	assert.Equal(t, 1, someUint64.Compare(emptyUint64))
+	assert.Equal(t, -1, emptyUint64.Compare(someUint64))
+}
+
+func TestUint64_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		assert.True(t, someUint64.Filter(func(uint64) bool { return true }).IsSome())
+		assert.False(t, someUint64.Filter(func(uint64) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		assert.False(t, emptyUint64.Filter(func(uint64) bool { return true }).IsSome())
+	})
+}
+
+func TestUint64_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		other := option.SomeUint64(13)
+		assert.EqualValues(t, 12, someUint64.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		other := option.SomeUint64(13)
+		assert.EqualValues(t, 13, emptyUint64.Or(other).Unwrap())
+	})
+}
+
+func TestUint64_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		assert.EqualValues(t, 12, someUint64.OrElse(func() option.Uint64 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		assert.EqualValues(t, 13, emptyUint64.OrElse(func() option.Uint64 {
+			return option.SomeUint64(13)
+		}).Unwrap())
+	})
+}
+
 func TestUint64_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestUint64_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestUint64_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUint64 := option.SomeUint64(12)
+		require.NoError(t, someUint64.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUint64.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUint64().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUint64_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		val, err := someUint64.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Uint64
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		val, err := emptyUint64.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUint64(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUint64_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		data, err := json.Marshal(someUint64)
+		require.NoError(t, err)
+
+		var unmarshaled option.Uint64
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		data, err := json.Marshal(emptyUint64)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUint64(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Uint64
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Uint64", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint64_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint64 := option.SomeUint64(12)
+		data, err := someUint64.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Uint64
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint64 := option.NoneUint64()
+		data, err := emptyUint64.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUint64(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint64_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUint64(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Uint64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Uint64
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeUint64() {
 	opt := option.SomeUint64(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUint64 represents a nullable value of type uint64 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUint64 struct {
+	value uint64
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUint64]()
+}
+
+// SomeNullableUint64 creates a NullableUint64 with the given uint64 value.
+func SomeNullableUint64(value uint64) NullableUint64 {
+	return NullableUint64{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUint64 creates a NullableUint64, that is explicitly set to null.
+func NullNullableUint64() NullableUint64 {
+	return NullableUint64{
+		value: zero[uint64](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUint64 creates a NullableUint64, that is not set.
+// It is equal to the zero value of NullableUint64.
+func UnsetNullableUint64() NullableUint64 {
+	return NullableUint64{
+		value: zero[uint64](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUint64 contains a value.
+func (o NullableUint64) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUint64 is explicitly set to null.
+func (o NullableUint64) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUint64 is either null or contains a value.
+func (o NullableUint64) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUint64 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUint64) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uint64, false).
+func (o NullableUint64) Get() (uint64, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUint64) MustGet() uint64 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uint64.
+func (o NullableUint64) Unwrap() uint64 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUint64) UnwrapOr(defaultValue uint64) uint64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUint64) UnwrapOrElse(defaultValue func() uint64) uint64 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUint64 to Uint64. Both Null and Unset are converted to NoneUint64().
+func (o NullableUint64) Option() Uint64 {
+	if o.state == nullableSome {
+		return SomeUint64(o.value)
+	}
+
+	return NoneUint64()
+}
+
+// Equal returns true if both NullableUint64s have the same state and, if present, equal values.
+func (o NullableUint64) Equal(other NullableUint64) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUint64) Compare(other NullableUint64) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUint64 value using MessagePack format.
+// - If the value is present, it is encoded as uint64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUint64) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUint64", encodeUint64(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUint64", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUint64 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint64.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUint64) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint64(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUint64", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUint64 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUint64)
+//   - uint64: interpreted as a present value (SomeNullableUint64)
+//
+// In DecodeModeLenient alternative encodings of uint64 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint64 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint64) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUint64", "uint64", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uint64]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUint64", "uint64", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint64
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint64Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableUint64", "uint64", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint64(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableUint64", "uint64", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint64Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUint64", "uint64", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUint64", "uint64", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUint64 value using JSON format.
+// - If the value is present, it is encoded as uint64.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUint64) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUint64 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUint64)
+//   - uint64: interpreted as a present value (SomeNullableUint64)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint64) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUint64", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint64.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUint64) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint64(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint64", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUint64)
+//   - text representation of uint64: interpreted as a present value (SomeNullableUint64)
+func (o *NullableUint64) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint64]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint64(text)
+	if err != nil {
+		return newDecodeError("NullableUint64", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Uint8) Get() (uint8, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Uint8) MustGet() uint8 {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Uint8) UnwrapOrElse(defaultValue func() uint8) uint8 {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Uint8) All() iter.Seq[uint8] {
+	return func(yield func(uint8) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Uint8s are None, or both contain equal values.
+func (o Uint8) Equal(other Uint8) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneUint8() is less than any value.
+func (o Uint8) Compare(other Uint8) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Uint8 itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneUint8().
+func (o Uint8) Filter(predicate func(uint8) bool) Uint8 {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneUint8()
+}
+
+// Or returns the Uint8 itself if it contains a value.
+// Otherwise, returns other.
+func (o Uint8) Or(other Uint8) Uint8 {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Uint8 itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Uint8) OrElse(fn func() Uint8) Uint8 {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Uint8 value using MessagePack format.
 // - If the value is present, it is encoded as uint8.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Uint8) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Uint8", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Uint8 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint8.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Uint8) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint8(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Uint8", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Uint8 value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneUint8)
 //   - uint8: interpreted as a present value (SomeUint8)
 //
+// In DecodeModeLenient alternative encodings of uint8 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint8 exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on uint8: exists = true, value = decoded value
 func (o *Uint8) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Uint8", err)
+		return newDecodeMsgpackError("Uint8", "uint8", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Uint8", decoder.Skip())
+		return newDecodeMsgpackError("Uint8", "uint8", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeUint8(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint8
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint8Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Uint8", "uint8", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint8(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Uint8", "uint8", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint8Lenient(decoder)
 		if err != nil {
-			return newDecodeError("Uint8", err)
+			return newDecodeMsgpackError("Uint8", "uint8", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Uint8", code)
+		return newDecodeWithCodeError("Uint8", "uint8", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as uint8.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Uint8) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueUint8(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint8", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneUint8)
+//   - uint8: interpreted as a present value (SomeUint8)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into uint8.
+func (o *Uint8) Scan(src any) error {
+	if src == nil {
+		o.value = zero[uint8]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanUint8(src)
+	if err != nil {
+		return newDecodeError("Uint8", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Uint8 value using JSON format.
+// - If the value is present, it is encoded as uint8.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Uint8) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Uint8 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneUint8)
+//   - uint8: interpreted as a present value (SomeUint8)
+//
+// Returns an error if the input can't be decoded as uint8.
+func (o *Uint8) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint8]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Uint8", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint8.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Uint8) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint8(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneUint8)
+//   - text representation of uint8: interpreted as a present value (SomeUint8)
+//
+// Returns an error if the text can't be parsed as uint8.
+func (o *Uint8) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint8]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint8(text)
+	if err != nil {
+		return newDecodeError("Uint8", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Uint8.
+// The Uint8 stays None until the flag is passed, the value is parsed
+// as text representation of uint8. Get of the returned flag.Getter returns the Uint8.
+//
+// Example:
+//
+//	var opt option.Uint8
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Uint8) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextUint8([]byte(text))
+			if err != nil {
+				return newDecodeError("Uint8", err)
+			}
+
+			*o = SomeUint8(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestUint8_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyUint8 := option.NoneUint8()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyUint8.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestUint8_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestUint8_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUint8(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUint8().All()))
+	})
+}
+
+func TestUint8_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUint8 := option.SomeUint8(12)
+	otherUint8 := option.SomeUint8(13)
+	emptyUint8 := option.NoneUint8()
+
+	assert.True(t, someUint8.Equal(option.SomeUint8(12)))
+	assert.True(t, emptyUint8.Equal(option.NoneUint8()))
+	assert.False(t, someUint8.Equal(otherUint8))
+	assert.False(t, someUint8.Equal(emptyUint8))
+	assert.False(t, emptyUint8.Equal(someUint8))
+
+	assert.Zero(t, someUint8.Compare(option.SomeUint8(12)))
+	assert.Zero(t, emptyUint8.Compare(option.NoneUint8()))
+	assert.Equal(t, -otherUint8.Compare(someUint8), someUint8.Compare(otherUint8))
+	assert.NotZero(t, someUint8.Compare(otherUint8))
+	assert.Equal(t, 1, someUint8.Compare(emptyUint8))
+	assert.Equal(t, -1, emptyUint8.Compare(someUint8))
+}
+
+func TestUint8_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		assert.True(t, someUint8.Filter(func(uint8) bool { return true }).IsSome())
+		assert.False(t, someUint8.Filter(func(uint8) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		assert.False(t, emptyUint8.Filter(func(uint8) bool { return true }).IsSome())
+	})
+}
+
+func TestUint8_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		other := option.SomeUint8(13)
+		assert.EqualValues(t, 12, someUint8.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		other := option.SomeUint8(13)
+		assert.EqualValues(t, 13, emptyUint8.Or(other).Unwrap())
+	})
+}
+
+func TestUint8_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		assert.EqualValues(t, 12, someUint8.OrElse(func() option.Uint8 {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		assert.EqualValues(t, 13, emptyUint8.OrElse(func() option.Uint8 {
+			return option.SomeUint8(13)
+		}).Unwrap())
+	})
+}
+
 func TestUint8_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestUint8_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestUint8_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUint8 := option.SomeUint8(12)
+		require.NoError(t, someUint8.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUint8.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUint8().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUint8_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		val, err := someUint8.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Uint8
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		val, err := emptyUint8.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUint8(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUint8_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		data, err := json.Marshal(someUint8)
+		require.NoError(t, err)
+
+		var unmarshaled option.Uint8
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		data, err := json.Marshal(emptyUint8)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUint8(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Uint8
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Uint8", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint8_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint8 := option.SomeUint8(12)
+		data, err := someUint8.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Uint8
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint8 := option.NoneUint8()
+		data, err := emptyUint8.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUint8(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint8_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUint8(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Uint8
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Uint8
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeUint8() {
 	opt := option.SomeUint8(12)
 	if opt.IsSome() {
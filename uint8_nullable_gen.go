@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUint8 represents a nullable value of type uint8 with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUint8 struct {
+	value uint8
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUint8]()
+}
+
+// SomeNullableUint8 creates a NullableUint8 with the given uint8 value.
+func SomeNullableUint8(value uint8) NullableUint8 {
+	return NullableUint8{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUint8 creates a NullableUint8, that is explicitly set to null.
+func NullNullableUint8() NullableUint8 {
+	return NullableUint8{
+		value: zero[uint8](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUint8 creates a NullableUint8, that is not set.
+// It is equal to the zero value of NullableUint8.
+func UnsetNullableUint8() NullableUint8 {
+	return NullableUint8{
+		value: zero[uint8](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUint8 contains a value.
+func (o NullableUint8) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUint8 is explicitly set to null.
+func (o NullableUint8) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUint8 is either null or contains a value.
+func (o NullableUint8) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUint8 is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUint8) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uint8, false).
+func (o NullableUint8) Get() (uint8, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUint8) MustGet() uint8 {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uint8.
+func (o NullableUint8) Unwrap() uint8 {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUint8) UnwrapOr(defaultValue uint8) uint8 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUint8) UnwrapOrElse(defaultValue func() uint8) uint8 {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUint8 to Uint8. Both Null and Unset are converted to NoneUint8().
+func (o NullableUint8) Option() Uint8 {
+	if o.state == nullableSome {
+		return SomeUint8(o.value)
+	}
+
+	return NoneUint8()
+}
+
+// Equal returns true if both NullableUint8s have the same state and, if present, equal values.
+func (o NullableUint8) Equal(other NullableUint8) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUint8) Compare(other NullableUint8) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUint8 value using MessagePack format.
+// - If the value is present, it is encoded as uint8.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUint8) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUint8", encodeUint8(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUint8", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUint8 value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint8.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUint8) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint8(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUint8", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUint8 value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUint8)
+//   - uint8: interpreted as a present value (SomeNullableUint8)
+//
+// In DecodeModeLenient alternative encodings of uint8 are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint8 exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint8) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUint8", "uint8", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uint8]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUint8", "uint8", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint8
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUint8Strict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableUint8", "uint8", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint8(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableUint8", "uint8", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUint8Lenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUint8", "uint8", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUint8", "uint8", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUint8 value using JSON format.
+// - If the value is present, it is encoded as uint8.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUint8) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUint8 value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUint8)
+//   - uint8: interpreted as a present value (SomeNullableUint8)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint8) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint8]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUint8", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint8.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUint8) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint8(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint8", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUint8)
+//   - text representation of uint8: interpreted as a present value (SomeNullableUint8)
+func (o *NullableUint8) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint8]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint8(text)
+	if err != nil {
+		return newDecodeError("NullableUint8", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
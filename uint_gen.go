@@ -3,6 +3,10 @@
 package option
 
 import (
+	"database/sql/driver"
+	"flag"
+	"iter"
+
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/vmihailenco/msgpack/v5/msgpcode"
 )
@@ -72,10 +76,10 @@ func (o Uint) Get() (uint, bool) {
 //
 // Use with caution — only when you are certain the value exists.
 //
-// Panics with: "optional value is not set" if no value is set.
+// Panics with ErrNone if no value is set.
 func (o Uint) MustGet() uint {
 	if !o.exists {
-		panic("optional value is not set")
+		panic(ErrNone)
 	}
 
 	return o.value
@@ -111,6 +115,68 @@ func (o Uint) UnwrapOrElse(defaultValue func() uint) uint {
 	return defaultValue()
 }
 
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o Uint) All() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both Uints are None, or both contain equal values.
+func (o Uint) Equal(other Uint) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneUint() is less than any value.
+func (o Uint) Compare(other Uint) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareOrdered(o.value, other.value)
+	})
+}
+
+// Filter returns the Uint itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneUint().
+func (o Uint) Filter(predicate func(uint) bool) Uint {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneUint()
+}
+
+// Or returns the Uint itself if it contains a value.
+// Otherwise, returns other.
+func (o Uint) Or(other Uint) Uint {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the Uint itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o Uint) OrElse(fn func() Uint) Uint {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
 // EncodeMsgpack encodes the Uint value using MessagePack format.
 // - If the value is present, it is encoded as uint.
 // - If the value is absent (None), it is encoded as nil.
@@ -124,36 +190,243 @@ func (o Uint) EncodeMsgpack(encoder *msgpack.Encoder) error {
 	return newEncodeError("Uint", encoder.EncodeNil())
 }
 
+// AppendMsgpack appends the Uint value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o Uint) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("Uint", err)
+	}
+
+	return out, nil
+}
+
 // DecodeMsgpack decodes a Uint value from MessagePack format.
 // Supports two input types:
 //   - nil: interpreted as no value (NoneUint)
 //   - uint: interpreted as a present value (SomeUint)
 //
+// In DecodeModeLenient alternative encodings of uint are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint exactly, are rejected.
+//
 // Returns an error if the input type is unsupported or decoding fails.
 //
 // After successful decoding:
 //   - on nil: exists = false, value = default zero value
 //   - on uint: exists = true, value = decoded value
 func (o *Uint) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
 	code, err := decoder.PeekCode()
 	if err != nil {
-		return newDecodeError("Uint", err)
+		return newDecodeMsgpackError("Uint", "uint", offset, err)
 	}
 
 	switch {
 	case code == msgpcode.Nil:
 		o.exists = false
 
-		return newDecodeError("Uint", decoder.Skip())
+		return newDecodeMsgpackError("Uint", "uint", offset, decoder.Skip())
 	case checkNumber(code):
-		o.value, err = decodeUint(decoder)
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUintStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("Uint", "uint", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("Uint", "uint", offset, err)
+			}
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUintLenient(decoder)
 		if err != nil {
-			return newDecodeError("Uint", err)
+			return newDecodeMsgpackError("Uint", "uint", offset, err)
 		}
+
+		o.value = val
 		o.exists = true
 
-		return err
+		return nil
 	default:
-		return newDecodeWithCodeError("Uint", code)
+		return newDecodeWithCodeError("Uint", "uint", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as uint.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o Uint) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueUint(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneUint)
+//   - uint: interpreted as a present value (SomeUint)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into uint.
+func (o *Uint) Scan(src any) error {
+	if src == nil {
+		o.value = zero[uint]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanUint(src)
+	if err != nil {
+		return newDecodeError("Uint", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the Uint value using JSON format.
+// - If the value is present, it is encoded as uint.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o Uint) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a Uint value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneUint)
+//   - uint: interpreted as a present value (SomeUint)
+//
+// Returns an error if the input can't be decoded as uint.
+func (o *Uint) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("Uint", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o Uint) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint(o.value)
+	if err != nil {
+		return nil, newEncodeError("Uint", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneUint)
+//   - text representation of uint: interpreted as a present value (SomeUint)
+//
+// Returns an error if the text can't be parsed as uint.
+func (o *Uint) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint(text)
+	if err != nil {
+		return newDecodeError("Uint", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the Uint.
+// The Uint stays None until the flag is passed, the value is parsed
+// as text representation of uint. Get of the returned flag.Getter returns the Uint.
+//
+// Example:
+//
+//	var opt option.Uint
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *Uint) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextUint([]byte(text))
+			if err != nil {
+				return newDecodeError("Uint", err)
+			}
+
+			*o = SomeUint(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
 	}
 }
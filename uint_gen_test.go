@@ -4,12 +4,17 @@ package option_test
 
 import (
 	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
 
 	"github.com/tarantool/go-option"
 )
@@ -103,7 +108,7 @@ func TestUint_MustGet(t *testing.T) {
 		t.Parallel()
 
 		emptyUint := option.NoneUint()
-		assert.Panics(t, func() {
+		assert.PanicsWithValue(t, option.ErrNone, func() {
 			emptyUint.MustGet()
 		})
 	})
@@ -169,6 +174,106 @@ func TestUint_UnwrapOrElse(t *testing.T) {
 	})
 }
 
+func TestUint_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUint(12).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, 12, values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUint().All()))
+	})
+}
+
+func TestUint_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUint := option.SomeUint(12)
+	otherUint := option.SomeUint(13)
+	emptyUint := option.NoneUint()
+
+	assert.True(t, someUint.Equal(option.SomeUint(12)))
+	assert.True(t, emptyUint.Equal(option.NoneUint()))
+	assert.False(t, someUint.Equal(otherUint))
+	assert.False(t, someUint.Equal(emptyUint))
+	assert.False(t, emptyUint.Equal(someUint))
+
+	assert.Zero(t, someUint.Compare(option.SomeUint(12)))
+	assert.Zero(t, emptyUint.Compare(option.NoneUint()))
+	assert.Equal(t, -otherUint.Compare(someUint), someUint.Compare(otherUint))
+	assert.NotZero(t, someUint.Compare(otherUint))
+	assert.Equal(t, 1, someUint.Compare(emptyUint))
+	assert.Equal(t, -1, emptyUint.Compare(someUint))
+}
+
+func TestUint_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		assert.True(t, someUint.Filter(func(uint) bool { return true }).IsSome())
+		assert.False(t, someUint.Filter(func(uint) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		assert.False(t, emptyUint.Filter(func(uint) bool { return true }).IsSome())
+	})
+}
+
+func TestUint_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		other := option.SomeUint(13)
+		assert.EqualValues(t, 12, someUint.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		other := option.SomeUint(13)
+		assert.EqualValues(t, 13, emptyUint.Or(other).Unwrap())
+	})
+}
+
+func TestUint_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		assert.EqualValues(t, 12, someUint.OrElse(func() option.Uint {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		assert.EqualValues(t, 13, emptyUint.OrElse(func() option.Uint {
+			return option.SomeUint(13)
+		}).Unwrap())
+	})
+}
+
 func TestUint_EncodeDecodeMsgpack(t *testing.T) {
 	t.Parallel()
 
@@ -211,6 +316,175 @@ func TestUint_EncodeDecodeMsgpack(t *testing.T) {
 	})
 }
 
+func TestUint_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUint := option.SomeUint(12)
+		require.NoError(t, someUint.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUint.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUint().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUint_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		val, err := someUint.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.Uint
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, 12, scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		val, err := emptyUint.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUint(12)
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUint_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		data, err := json.Marshal(someUint)
+		require.NoError(t, err)
+
+		var unmarshaled option.Uint
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		data, err := json.Marshal(emptyUint)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUint(12)
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.Uint
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "Uint", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUint := option.SomeUint(12)
+		data, err := someUint.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.Uint
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, 12, unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUint := option.NoneUint()
+		data, err := emptyUint.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUint(12)
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUint_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUint(12).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.Uint
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, 12, opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.Uint
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
 func ExampleSomeUint() {
 	opt := option.SomeUint(12)
 	if opt.IsSome() {
@@ -0,0 +1,319 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUint represents a nullable value of type uint with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUint struct {
+	value uint
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUint]()
+}
+
+// SomeNullableUint creates a NullableUint with the given uint value.
+func SomeNullableUint(value uint) NullableUint {
+	return NullableUint{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUint creates a NullableUint, that is explicitly set to null.
+func NullNullableUint() NullableUint {
+	return NullableUint{
+		value: zero[uint](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUint creates a NullableUint, that is not set.
+// It is equal to the zero value of NullableUint.
+func UnsetNullableUint() NullableUint {
+	return NullableUint{
+		value: zero[uint](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUint contains a value.
+func (o NullableUint) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUint is explicitly set to null.
+func (o NullableUint) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUint is either null or contains a value.
+func (o NullableUint) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUint is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUint) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uint, false).
+func (o NullableUint) Get() (uint, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUint) MustGet() uint {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uint.
+func (o NullableUint) Unwrap() uint {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUint) UnwrapOr(defaultValue uint) uint {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUint) UnwrapOrElse(defaultValue func() uint) uint {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUint to Uint. Both Null and Unset are converted to NoneUint().
+func (o NullableUint) Option() Uint {
+	if o.state == nullableSome {
+		return SomeUint(o.value)
+	}
+
+	return NoneUint()
+}
+
+// Equal returns true if both NullableUints have the same state and, if present, equal values.
+func (o NullableUint) Equal(other NullableUint) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalOrdered(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUint) Compare(other NullableUint) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareOrdered(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUint value using MessagePack format.
+// - If the value is present, it is encoded as uint.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUint) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUint", encodeUint(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUint", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUint value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uint.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUint) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUint(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUint", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUint value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUint)
+//   - uint: interpreted as a present value (SomeNullableUint)
+//
+// In DecodeModeLenient alternative encodings of uint are accepted as well.
+//
+// In DecodeModeStrict values, that can't be represented as uint exactly, are rejected.
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUint", "uint", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uint]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUint", "uint", offset, decoder.Skip())
+	case checkNumber(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uint
+
+		if getDecodeMode(decoder) == DecodeModeStrict {
+			val, err = decodeUintStrict(decoder, code)
+			if err != nil {
+				return newDecodeStrictError("NullableUint", "uint", offset, code, err)
+			}
+		} else {
+			val, err = decodeUint(decoder)
+			if err != nil {
+				return newDecodeMsgpackError("NullableUint", "uint", offset, err)
+			}
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	case checkNumberLenient(code) && getDecodeMode(decoder) == DecodeModeLenient:
+		val, err := decodeUintLenient(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUint", "uint", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUint", "uint", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUint value using JSON format.
+// - If the value is present, it is encoded as uint.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUint) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUint value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUint)
+//   - uint: interpreted as a present value (SomeNullableUint)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUint) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uint]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUint", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uint.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUint) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUint(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUint", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUint)
+//   - text representation of uint: interpreted as a present value (SomeNullableUint)
+func (o *NullableUint) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uint]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUint(text)
+	if err != nil {
+		return newDecodeError("NullableUint", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
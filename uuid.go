@@ -0,0 +1,28 @@
+package option
+
+// This file implements conversion of uuid.UUID to/from the MessagePack extension format
+// used by Tarantool (MP_EXT type 2): the payload is 16 raw bytes of the UUID.
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// UUIDExtCode is the MessagePack extension code of Tarantool uuid type.
+	UUIDExtCode = 2
+)
+
+func appendUUIDPayload(dst []byte, val uuid.UUID) []byte {
+	return append(dst, val[:]...)
+}
+
+func parseUUIDPayload(payload []byte) (uuid.UUID, error) {
+	val, err := uuid.FromBytes(payload)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid uuid payload: %w", err)
+	}
+
+	return val, nil
+}
@@ -0,0 +1,413 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/google/uuid"
+
+	"database/sql/driver"
+	"flag"
+	"iter"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// UUID represents an optional value of type uuid.UUID.
+// It can either hold a valid uuid.UUID (IsSome == true) or be empty (IsZero == true).
+type UUID struct {
+	value  uuid.UUID
+	exists bool
+}
+
+var _ commonInterface[uuid.UUID] = (*UUID)(nil)
+
+// SomeUUID creates an optional UUID with the given uuid.UUID value.
+// The returned UUID will have IsSome() == true and IsZero() == false.
+func SomeUUID(value uuid.UUID) UUID {
+	return UUID{
+		value:  value,
+		exists: true,
+	}
+}
+
+// NoneUUID creates an empty optional UUID value.
+// The returned UUID will have IsSome() == false and IsZero() == true.
+func NoneUUID() UUID {
+	return UUID{
+		exists: false,
+		value:  zero[uuid.UUID](),
+	}
+}
+
+// IsSome returns true if the UUID contains a value.
+// This indicates the value is explicitly set (not None).
+func (o UUID) IsSome() bool {
+	return o.exists
+}
+
+// IsZero returns true if the UUID does not contain a value.
+// Equivalent to !IsSome(). Useful for consistency with types where
+// zero value (e.g. 0, false, zero struct) is valid and needs to be distinguished.
+func (o UUID) IsZero() bool {
+	return !o.exists
+}
+
+// IsNil is an alias for IsZero.
+//
+// This method is provided for compatibility with the msgpack Encoder interface.
+func (o UUID) IsNil() bool {
+	return o.IsZero()
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// If the value is present, returns (value, true).
+// If the value is absent, returns (zero value of uuid.UUID, false).
+//
+// Recommended usage:
+//
+//	if value, ok := o.Get(); ok {
+//	    // use value
+//	}
+func (o UUID) Get() (uuid.UUID, bool) {
+	return o.value, o.exists
+}
+
+// MustGet returns the stored value if it is present.
+// Panics if the value is absent (i.e., IsZero() == true).
+//
+// Use with caution — only when you are certain the value exists.
+//
+// Panics with ErrNone if no value is set.
+func (o UUID) MustGet() uuid.UUID {
+	if !o.exists {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uuid.UUID.
+//
+// Warning: Does not check presence. Use IsSome() before calling if you need
+// to distinguish between absent value and explicit zero value.
+func (o UUID) Unwrap() uuid.UUID {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o UUID) UnwrapOr(defaultValue uuid.UUID) uuid.UUID {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+// Useful when the default value requires computation or side effects.
+func (o UUID) UnwrapOrElse(defaultValue func() uuid.UUID) uuid.UUID {
+	if o.exists {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// All returns an iterator over the stored value.
+// It yields the value once if it is present, and nothing otherwise.
+func (o UUID) All() iter.Seq[uuid.UUID] {
+	return func(yield func(uuid.UUID) bool) {
+		if o.exists {
+			yield(o.value)
+		}
+	}
+}
+
+// Equal returns true if both UUIDs are None, or both contain equal values.
+func (o UUID) Equal(other UUID) bool {
+	if o.exists != other.exists {
+		return false
+	}
+
+	return !o.exists || equalUUID(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// NoneUUID() is less than any value.
+func (o UUID) Compare(other UUID) int {
+	return compareOptional(o.exists, other.exists, func() int {
+		return compareUUID(o.value, other.value)
+	})
+}
+
+// Filter returns the UUID itself if it contains a value, that satisfies predicate.
+// Otherwise, returns NoneUUID().
+func (o UUID) Filter(predicate func(uuid.UUID) bool) UUID {
+	if o.exists && predicate(o.value) {
+		return o
+	}
+
+	return NoneUUID()
+}
+
+// Or returns the UUID itself if it contains a value.
+// Otherwise, returns other.
+func (o UUID) Or(other UUID) UUID {
+	if o.exists {
+		return o
+	}
+
+	return other
+}
+
+// OrElse returns the UUID itself if it contains a value.
+// Otherwise, calls the provided function and returns its result.
+func (o UUID) OrElse(fn func() UUID) UUID {
+	if o.exists {
+		return o
+	}
+
+	return fn()
+}
+
+// EncodeMsgpack encodes the UUID value using MessagePack format.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails.
+func (o UUID) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.exists {
+		return newEncodeError("UUID", encodeUUID(encoder, o.value))
+	}
+
+	return newEncodeError("UUID", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the UUID value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is absent (None), it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o UUID) AppendMsgpack(dst []byte) ([]byte, error) {
+	if !o.exists {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUUID(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("UUID", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a UUID value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as no value (NoneUUID)
+//   - uuid.UUID: interpreted as a present value (SomeUUID)
+//
+// Returns an error if the input type is unsupported or decoding fails.
+//
+// After successful decoding:
+//   - on nil: exists = false, value = default zero value
+//   - on uuid.UUID: exists = true, value = decoded value
+func (o *UUID) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("UUID", "uuid.UUID", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.exists = false
+
+		return newDecodeMsgpackError("UUID", "uuid.UUID", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uuid.UUID
+
+		val, err = decodeUUID(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("UUID", "uuid.UUID", offset, err)
+		}
+
+		o.value = val
+		o.exists = true
+
+		return nil
+	default:
+		return newDecodeWithCodeError("UUID", "uuid.UUID", offset, code)
+	}
+}
+
+// Value implements the driver.Valuer interface.
+// - If the value is present, it is passed to the database as uuid.UUID.
+// - If the value is absent (None), it is passed to the database as NULL.
+//
+// Returns an error if the value can't be represented as driver.Value.
+func (o UUID) Value() (driver.Value, error) {
+	if !o.exists {
+		return nil, nil //nolint:nilnil
+	}
+
+	val, err := valueUUID(o.value)
+	if err != nil {
+		return nil, newEncodeError("UUID", err)
+	}
+
+	return val, nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Supports two input types:
+//   - NULL: interpreted as no value (NoneUUID)
+//   - uuid.UUID: interpreted as a present value (SomeUUID)
+//
+// Returns an error if the source type is unsupported or the value doesn't fit into uuid.UUID.
+func (o *UUID) Scan(src any) error {
+	if src == nil {
+		o.value = zero[uuid.UUID]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := scanUUID(src)
+	if err != nil {
+		return newDecodeError("UUID", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// MarshalJSON encodes the UUID value using JSON format.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is absent (None), it is encoded as null.
+//
+// Returns an error if encoding fails.
+func (o UUID) MarshalJSON() ([]byte, error) {
+	if !o.exists {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("UUID", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a UUID value from JSON format.
+// Supports two input types:
+//   - null: interpreted as no value (NoneUUID)
+//   - uuid.UUID: interpreted as a present value (SomeUUID)
+//
+// Returns an error if the input can't be decoded as uuid.UUID.
+func (o *UUID) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uuid.UUID]()
+		o.exists = false
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("UUID", err)
+	}
+
+	o.exists = true
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uuid.UUID.
+// - If the value is absent (None), it is encoded as empty text.
+//
+// Returns an error if encoding fails.
+func (o UUID) MarshalText() ([]byte, error) {
+	if !o.exists {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUUID(o.value)
+	if err != nil {
+		return nil, newEncodeError("UUID", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as no value (NoneUUID)
+//   - text representation of uuid.UUID: interpreted as a present value (SomeUUID)
+//
+// Returns an error if the text can't be parsed as uuid.UUID.
+func (o *UUID) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uuid.UUID]()
+		o.exists = false
+
+		return nil
+	}
+
+	val, err := unmarshalTextUUID(text)
+	if err != nil {
+		return newDecodeError("UUID", err)
+	}
+
+	o.value = val
+	o.exists = true
+
+	return nil
+}
+
+// Flag returns a flag.Getter, that stores the flag value into the UUID.
+// The UUID stays None until the flag is passed, the value is parsed
+// as text representation of uuid.UUID. Get of the returned flag.Getter returns the UUID.
+//
+// Example:
+//
+//	var opt option.UUID
+//	flag.Var(opt.Flag(), "name", "usage")
+func (o *UUID) Flag() flag.Getter {
+	return flagValue{
+		set: func(text string) error {
+			val, err := unmarshalTextUUID([]byte(text))
+			if err != nil {
+				return newDecodeError("UUID", err)
+			}
+
+			*o = SomeUUID(val)
+
+			return nil
+		},
+		str: func() string {
+			text, _ := o.MarshalText()
+
+			return string(text)
+		},
+		get: func() any {
+			return *o
+		},
+		isBool: false,
+	}
+}
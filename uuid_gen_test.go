@@ -0,0 +1,599 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option_test
+
+import (
+	"github.com/google/uuid"
+
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/tarantool/go-option"
+)
+
+func TestUUID_IsSome(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.True(t, someUUID.IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.False(t, emptyUUID.IsSome())
+	})
+}
+
+func TestUUID_IsZero(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.False(t, someUUID.IsZero())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.True(t, emptyUUID.IsZero())
+	})
+}
+
+func TestUUID_IsNil(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.False(t, someUUID.IsNil())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.True(t, emptyUUID.IsNil())
+	})
+}
+
+func TestUUID_Get(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		val, ok := someUUID.Get()
+		require.True(t, ok)
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), val)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		_, ok := emptyUUID.Get()
+		require.False(t, ok)
+	})
+}
+
+func TestUUID_MustGet(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.MustGet())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.PanicsWithValue(t, option.ErrNone, func() {
+			emptyUUID.MustGet()
+		})
+	})
+}
+
+func TestUUID_Unwrap(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.NotPanics(t, func() {
+			emptyUUID.Unwrap()
+		})
+	})
+}
+
+func TestUUID_UnwrapOr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.UnwrapOr(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.EqualValues(t, uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"), emptyUUID.UnwrapOr(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")))
+	})
+}
+
+func TestUUID_UnwrapOrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.UnwrapOrElse(func() uuid.UUID {
+			return uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")
+		}))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.EqualValues(t, uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"), emptyUUID.UnwrapOrElse(func() uuid.UUID {
+			return uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")
+		}))
+	})
+}
+
+func TestUUID_All(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		values := slices.Collect(option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")).All())
+		require.Len(t, values, 1)
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), values[0])
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, slices.Collect(option.NoneUUID().All()))
+	})
+}
+
+func TestUUID_EqualCompare(t *testing.T) {
+	t.Parallel()
+
+	someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	otherUUID := option.SomeUUID(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"))
+	emptyUUID := option.NoneUUID()
+
+	assert.True(t, someUUID.Equal(option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))))
+	assert.True(t, emptyUUID.Equal(option.NoneUUID()))
+	assert.False(t, someUUID.Equal(otherUUID))
+	assert.False(t, someUUID.Equal(emptyUUID))
+	assert.False(t, emptyUUID.Equal(someUUID))
+
+	assert.Zero(t, someUUID.Compare(option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))))
+	assert.Zero(t, emptyUUID.Compare(option.NoneUUID()))
+	assert.Equal(t, -otherUUID.Compare(someUUID), someUUID.Compare(otherUUID))
+	assert.NotZero(t, someUUID.Compare(otherUUID))
+	assert.Equal(t, 1, someUUID.Compare(emptyUUID))
+	assert.Equal(t, -1, emptyUUID.Compare(someUUID))
+}
+
+func TestUUID_Filter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.True(t, someUUID.Filter(func(uuid.UUID) bool { return true }).IsSome())
+		assert.False(t, someUUID.Filter(func(uuid.UUID) bool { return false }).IsSome())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.False(t, emptyUUID.Filter(func(uuid.UUID) bool { return true }).IsSome())
+	})
+}
+
+func TestUUID_Or(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		other := option.SomeUUID(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.Or(other).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		other := option.SomeUUID(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"))
+		assert.EqualValues(t, uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"), emptyUUID.Or(other).Unwrap())
+	})
+}
+
+func TestUUID_OrElse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), someUUID.OrElse(func() option.UUID {
+			panic("must not be called")
+		}).Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		assert.EqualValues(t, uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"), emptyUUID.OrElse(func() option.UUID {
+			return option.SomeUUID(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a"))
+		}).Unwrap())
+	})
+}
+
+func TestUUID_EncodeDecodeMsgpack(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		err := someUUID.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.UUID
+		err = unmarshaled.DecodeMsgpack(dec)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		dec := msgpack.NewDecoder(&buf)
+
+		emptyUUID := option.NoneUUID()
+		err := emptyUUID.EncodeMsgpack(enc)
+		require.NoError(t, err)
+
+		var unmarshaled option.UUID
+		err = unmarshaled.DecodeMsgpack(dec)
+
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUUID_AppendMsgpack(t *testing.T) {
+	t.Parallel()
+
+	prefix := []byte{0x93}
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		require.NoError(t, someUUID.EncodeMsgpack(msgpack.NewEncoder(&buf)))
+
+		data, err := someUUID.AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), buf.Bytes()...), data)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := option.NoneUUID().AppendMsgpack(slices.Clone(prefix))
+		require.NoError(t, err)
+		assert.Equal(t, append(slices.Clone(prefix), msgpcode.Nil), data)
+	})
+}
+
+func TestUUID_ValueScan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		val, err := someUUID.Value()
+		require.NoError(t, err)
+		assert.True(t, driver.IsValue(val))
+
+		var scanned option.UUID
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.True(t, scanned.IsSome())
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), scanned.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		val, err := emptyUUID.Value()
+		require.NoError(t, err)
+		assert.Nil(t, val)
+
+		scanned := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		err = scanned.Scan(val)
+		require.NoError(t, err)
+		assert.False(t, scanned.IsSome())
+	})
+}
+
+func TestUUID_MarshalUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		data, err := json.Marshal(someUUID)
+		require.NoError(t, err)
+
+		var unmarshaled option.UUID
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		data, err := json.Marshal(emptyUUID)
+		require.NoError(t, err)
+		assert.JSONEq(t, "null", string(data))
+
+		unmarshaled := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		err = json.Unmarshal(data, &unmarshaled)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		var unmarshaled option.UUID
+		err := unmarshaled.UnmarshalJSON([]byte("["))
+
+		var decodeErr option.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		assert.Equal(t, "UUID", decodeErr.Type)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUUID_MarshalUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	t.Run("some", func(t *testing.T) {
+		t.Parallel()
+
+		someUUID := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		data, err := someUUID.MarshalText()
+		require.NoError(t, err)
+		assert.NotEmpty(t, data)
+
+		var unmarshaled option.UUID
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.True(t, unmarshaled.IsSome())
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), unmarshaled.Unwrap())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		emptyUUID := option.NoneUUID()
+		data, err := emptyUUID.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, data)
+
+		unmarshaled := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+		err = unmarshaled.UnmarshalText(data)
+		require.NoError(t, err)
+		assert.False(t, unmarshaled.IsSome())
+	})
+}
+
+func TestUUID_Flag(t *testing.T) {
+	t.Parallel()
+
+	t.Run("passed", func(t *testing.T) {
+		t.Parallel()
+
+		text, err := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")).MarshalText()
+		require.NoError(t, err)
+
+		var opt option.UUID
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{"-value=" + string(text)}))
+		assert.True(t, opt.IsSome())
+		assert.EqualValues(t, uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"), opt.Unwrap())
+		assert.Equal(t, string(text), flagSet.Lookup("value").Value.String())
+		assert.Equal(t, opt, flagSet.Lookup("value").Value.(flag.Getter).Get())
+	})
+
+	t.Run("not passed", func(t *testing.T) {
+		t.Parallel()
+
+		var opt option.UUID
+
+		flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagSet.Var(opt.Flag(), "value", "usage")
+		require.NoError(t, flagSet.Parse([]string{}))
+		assert.False(t, opt.IsSome())
+	})
+}
+
+func ExampleSomeUUID() {
+	opt := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	if opt.IsSome() {
+		fmt.Println(opt.Unwrap())
+	}
+	// Output: c8f0fa1f-da29-438c-a040-393f1126ad39
+}
+
+func ExampleNoneUUID() {
+	opt := option.NoneUUID()
+	if opt.IsZero() {
+		fmt.Println("value is absent")
+	}
+	// Output: value is absent
+}
+
+func ExampleUUID_IsSome() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.IsSome())
+	fmt.Println(none.IsSome())
+	// Output:
+	// true
+	// false
+}
+
+func ExampleUUID_IsZero() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.IsZero())
+	fmt.Println(none.IsZero())
+	// Output:
+	// false
+	// true
+}
+
+func ExampleUUID_IsNil() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.IsNil() == some.IsZero())
+	fmt.Println(none.IsNil() == none.IsZero())
+	// Output:
+	// true
+	// true
+}
+
+func ExampleUUID_Get() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	val, ok := some.Get()
+	fmt.Println(val, ok)
+	val, ok = none.Get()
+	fmt.Println(val, ok)
+	// Output:
+	// c8f0fa1f-da29-438c-a040-393f1126ad39 true
+	// 00000000-0000-0000-0000-000000000000 false
+}
+
+func ExampleUUID_MustGet() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	fmt.Println(some.MustGet())
+	// Output: c8f0fa1f-da29-438c-a040-393f1126ad39
+}
+
+func ExampleUUID_MustGet_panic() {
+	none := option.NoneUUID()
+	eof := false
+	defer func() {
+		if !eof {
+			fmt.Println("panic!", recover())
+		}
+	}()
+	fmt.Println(none.MustGet())
+	eof = true
+	// Output: panic! optional value is not set
+}
+
+func ExampleUUID_Unwrap() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.Unwrap())
+	fmt.Println(none.Unwrap())
+	// Output:
+	// c8f0fa1f-da29-438c-a040-393f1126ad39
+	// 00000000-0000-0000-0000-000000000000
+}
+
+func ExampleUUID_UnwrapOr() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.UnwrapOr(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")))
+	fmt.Println(none.UnwrapOr(uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")))
+	// Output:
+	// c8f0fa1f-da29-438c-a040-393f1126ad39
+	// 2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a
+}
+
+func ExampleUUID_UnwrapOrElse() {
+	some := option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39"))
+	none := option.NoneUUID()
+	fmt.Println(some.UnwrapOrElse(func() uuid.UUID {
+		return uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")
+	}))
+	fmt.Println(none.UnwrapOrElse(func() uuid.UUID {
+		return uuid.MustParse("2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a")
+	}))
+	// Output:
+	// c8f0fa1f-da29-438c-a040-393f1126ad39
+	// 2b5d8d4e-8e1a-4f43-b8b4-9b3e2d1c0f7a
+}
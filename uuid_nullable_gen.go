@@ -0,0 +1,300 @@
+// Code generated by github.com/tarantool/go-option; DO NOT EDIT.
+
+package option
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// NullableUUID represents a nullable value of type uuid.UUID with three states:
+// Unset (the zero value), Null and Some.
+//
+// IsZero returns true only for Unset values, so Unset fields are skipped by
+// the msgpack `omitempty` tag and by the encoding/json `omitzero` tag,
+// while Null values are encoded as nil (null).
+type NullableUUID struct {
+	value uuid.UUID
+	state nullableState
+}
+
+func init() {
+	registerNullable[NullableUUID]()
+}
+
+// SomeNullableUUID creates a NullableUUID with the given uuid.UUID value.
+func SomeNullableUUID(value uuid.UUID) NullableUUID {
+	return NullableUUID{
+		value: value,
+		state: nullableSome,
+	}
+}
+
+// NullNullableUUID creates a NullableUUID, that is explicitly set to null.
+func NullNullableUUID() NullableUUID {
+	return NullableUUID{
+		value: zero[uuid.UUID](),
+		state: nullableNull,
+	}
+}
+
+// UnsetNullableUUID creates a NullableUUID, that is not set.
+// It is equal to the zero value of NullableUUID.
+func UnsetNullableUUID() NullableUUID {
+	return NullableUUID{
+		value: zero[uuid.UUID](),
+		state: nullableUnset,
+	}
+}
+
+// IsSome returns true if the NullableUUID contains a value.
+func (o NullableUUID) IsSome() bool {
+	return o.state == nullableSome
+}
+
+// IsNull returns true if the NullableUUID is explicitly set to null.
+func (o NullableUUID) IsNull() bool {
+	return o.state == nullableNull
+}
+
+// IsSet returns true if the NullableUUID is either null or contains a value.
+func (o NullableUUID) IsSet() bool {
+	return o.state != nullableUnset
+}
+
+// IsZero returns true if the NullableUUID is not set.
+//
+// This method is used by msgpack `omitempty` and encoding/json `omitzero` tags
+// to skip Unset values.
+func (o NullableUUID) IsZero() bool {
+	return o.state == nullableUnset
+}
+
+// Get returns the stored value and a boolean flag indicating its presence.
+// For Null and Unset values returns (zero value of uuid.UUID, false).
+func (o NullableUUID) Get() (uuid.UUID, bool) {
+	return o.value, o.state == nullableSome
+}
+
+// MustGet returns the stored value if it is present.
+//
+// Panics with ErrNone for Null and Unset values.
+func (o NullableUUID) MustGet() uuid.UUID {
+	if o.state != nullableSome {
+		panic(ErrNone)
+	}
+
+	return o.value
+}
+
+// Unwrap returns the stored value regardless of presence.
+// If no value is set, returns the zero value for uuid.UUID.
+func (o NullableUUID) Unwrap() uuid.UUID {
+	return o.value
+}
+
+// UnwrapOr returns the stored value if present.
+// Otherwise, returns the provided default value.
+func (o NullableUUID) UnwrapOr(defaultValue uuid.UUID) uuid.UUID {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue
+}
+
+// UnwrapOrElse returns the stored value if present.
+// Otherwise, calls the provided function and returns its result.
+func (o NullableUUID) UnwrapOrElse(defaultValue func() uuid.UUID) uuid.UUID {
+	if o.state == nullableSome {
+		return o.value
+	}
+
+	return defaultValue()
+}
+
+// Option converts the NullableUUID to UUID. Both Null and Unset are converted to NoneUUID().
+func (o NullableUUID) Option() UUID {
+	if o.state == nullableSome {
+		return SomeUUID(o.value)
+	}
+
+	return NoneUUID()
+}
+
+// Equal returns true if both NullableUUIDs have the same state and, if present, equal values.
+func (o NullableUUID) Equal(other NullableUUID) bool {
+	if o.state != other.state {
+		return false
+	}
+
+	return o.state != nullableSome || equalUUID(o.value, other.value)
+}
+
+// Compare returns
+//
+//	-1 if o is less than other,
+//	 0 if o equals other,
+//	+1 if o is greater than other.
+//
+// Unset is less than Null, that is less than any value.
+func (o NullableUUID) Compare(other NullableUUID) int {
+	if o.state != nullableSome || other.state != nullableSome {
+		return compareNullableState(o.state, other.state)
+	}
+
+	return compareUUID(o.value, other.value)
+}
+
+// EncodeMsgpack encodes the NullableUUID value using MessagePack format.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Use the `omitempty` tag to skip Unset values.
+func (o NullableUUID) EncodeMsgpack(encoder *msgpack.Encoder) error {
+	if o.state == nullableSome {
+		return newEncodeError("NullableUUID", encodeUUID(encoder, o.value))
+	}
+
+	return newEncodeError("NullableUUID", encoder.EncodeNil())
+}
+
+// AppendMsgpack appends the NullableUUID value in MessagePack format to dst and returns the extended buffer.
+// The result is the same, as written by EncodeMsgpack with the default msgpack.Encoder options.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is Null or Unset, it is encoded as nil.
+//
+// Returns an error if encoding fails, dst is returned unchanged in this case.
+func (o NullableUUID) AppendMsgpack(dst []byte) ([]byte, error) {
+	if o.state != nullableSome {
+		return appendNil(dst), nil
+	}
+
+	out, err := appendUUID(dst, o.value)
+	if err != nil {
+		return dst, newEncodeError("NullableUUID", err)
+	}
+
+	return out, nil
+}
+
+// DecodeMsgpack decodes a NullableUUID value from MessagePack format.
+// Supports two input types:
+//   - nil: interpreted as Null (NullNullableUUID)
+//   - uuid.UUID: interpreted as a present value (SomeNullableUUID)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUUID) DecodeMsgpack(decoder *msgpack.Decoder) error {
+	offset := DecoderOffset(decoder)
+
+	code, err := decoder.PeekCode()
+	if err != nil {
+		return newDecodeMsgpackError("NullableUUID", "uuid.UUID", offset, err)
+	}
+
+	switch {
+	case code == msgpcode.Nil:
+		o.value = zero[uuid.UUID]()
+		o.state = nullableNull
+
+		return newDecodeMsgpackError("NullableUUID", "uuid.UUID", offset, decoder.Skip())
+	case checkExt(code):
+		// The value is decoded into a local variable, so the optional is not changed on errors.
+		var val uuid.UUID
+
+		val, err = decodeUUID(decoder)
+		if err != nil {
+			return newDecodeMsgpackError("NullableUUID", "uuid.UUID", offset, err)
+		}
+
+		o.value = val
+		o.state = nullableSome
+
+		return nil
+	default:
+		return newDecodeWithCodeError("NullableUUID", "uuid.UUID", offset, code)
+	}
+}
+
+// MarshalJSON encodes the NullableUUID value using JSON format.
+// - If the value is present, it is encoded as uuid.UUID.
+// - If the value is Null or Unset, it is encoded as null.
+//
+// Use the `omitzero` tag to skip Unset values.
+func (o NullableUUID) MarshalJSON() ([]byte, error) {
+	if o.state != nullableSome {
+		return jsonNull(), nil
+	}
+
+	data, err := marshalJSON(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUUID", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes a NullableUUID value from JSON format.
+// Supports two input types:
+//   - null: interpreted as Null (NullNullableUUID)
+//   - uuid.UUID: interpreted as a present value (SomeNullableUUID)
+//
+// A missing field is not decoded at all, so it stays Unset.
+func (o *NullableUUID) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		o.value = zero[uuid.UUID]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	err := unmarshalJSON(data, &o.value)
+	if err != nil {
+		return newDecodeError("NullableUUID", err)
+	}
+
+	o.state = nullableSome
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// - If the value is present, it is encoded as text representation of uuid.UUID.
+// - If the value is Null or Unset, it is encoded as empty text.
+func (o NullableUUID) MarshalText() ([]byte, error) {
+	if o.state != nullableSome {
+		return emptyText(), nil
+	}
+
+	data, err := marshalTextUUID(o.value)
+	if err != nil {
+		return nil, newEncodeError("NullableUUID", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Supports two input types:
+//   - empty text: interpreted as Null (NullNullableUUID)
+//   - text representation of uuid.UUID: interpreted as a present value (SomeNullableUUID)
+func (o *NullableUUID) UnmarshalText(text []byte) error {
+	if isEmptyText(text) {
+		o.value = zero[uuid.UUID]()
+		o.state = nullableNull
+
+		return nil
+	}
+
+	val, err := unmarshalTextUUID(text)
+	if err != nil {
+		return newDecodeError("NullableUUID", err)
+	}
+
+	o.value = val
+	o.state = nullableSome
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/tarantool/go-option"
+)
+
+// uuidFixture is c8f0fa1f-da29-438c-a040-393f1126ad39 encoded by Tarantool.
+var uuidFixture = []byte{
+	0xd8, 0x02,
+	0xc8, 0xf0, 0xfa, 0x1f, 0xda, 0x29, 0x43, 0x8c,
+	0xa0, 0x40, 0x39, 0x3f, 0x11, 0x26, 0xad, 0x39,
+}
+
+func TestUUID_EncodeFixture(t *testing.T) {
+	t.Parallel()
+
+	data, err := msgpack.Marshal(option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")))
+	require.NoError(t, err)
+	assert.Equal(t, uuidFixture, data)
+}
+
+func TestUUID_DecodeFixture(t *testing.T) {
+	t.Parallel()
+
+	var opt option.UUID
+
+	err := msgpack.Unmarshal(uuidFixture, &opt)
+	require.NoError(t, err)
+	assert.Equal(t, option.SomeUUID(uuid.MustParse("c8f0fa1f-da29-438c-a040-393f1126ad39")), opt)
+}
+
+func TestUUID_DecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"wrong ext code", append([]byte{0xd8, 0x03}, uuidFixture[2:]...)},
+		{"short payload", []byte{0xd7, 0x02, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{"string", []byte{0xa1, 0x31}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opt option.UUID
+
+			err := msgpack.Unmarshal(tc.data, &opt)
+
+			var decodeErr option.DecodeError
+			require.ErrorAs(t, err, &decodeErr)
+			assert.Equal(t, "UUID", decodeErr.Type)
+		})
+	}
+}